@@ -34,3 +34,41 @@ function add_privileges()
     --
     s:insert{nil, 'privilege_read', 'Чтение справочника привилегий', ''}
 end
+
+--- privilege added after the initial release, granted to the admin role
+function add_admin_privilege(code, name)
+    local s = box.space.privilege
+    --
+    local privilege = s.index.secondary:get({code})
+    if not privilege then
+        privilege = s:insert{nil, code, name, ''}
+    end
+    --
+    local admin = box.space.role.index.secondary:get({'admin'})
+    if admin then
+        box.space.role_privilege:replace{admin.id, privilege.id, true}
+    end
+end
+
+function add_signing_key_privileges()
+    add_admin_privilege('signing_key_read', 'Чтение ключей подписи токенов')
+    add_admin_privilege('signing_key_rotate', 'Ротация ключа подписи токенов')
+end
+
+function add_token_introspect_privilege()
+    add_admin_privilege('token_introspect', 'Интроспекция токенов')
+end
+
+function add_session_authorize_privilege()
+    add_admin_privilege('session_authorize', 'Проверка доступа сессии к привилегиям')
+end
+
+function add_user_unlock_privilege()
+    add_admin_privilege('user_unlock', 'Снятие блокировки входа пользователя')
+end
+
+function add_user_mfa_privileges()
+    add_admin_privilege('user_mfa_update', 'Подключение второго фактора')
+    add_admin_privilege('user_mfa_delete', 'Отключение второго фактора пользователя')
+    add_admin_privilege('user_mfa_read', 'Чтение ключей второго фактора пользователя')
+end
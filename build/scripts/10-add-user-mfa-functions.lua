@@ -0,0 +1,46 @@
+#!/usr/bin/tarantool
+
+-- Атомарное использование кодов второго фактора. Проверка и изменение выполняются
+-- в одной функции без передачи управления другим запросам, поэтому один и тот же
+-- код не может быть принят дважды параллельными запросами.
+-- Функции не сохраняются в базе и объявляются при каждом запуске
+
+local CONFIRMED = 3
+local LAST_STEP = 4
+local RECOVERY_CODES = 5
+
+-- Принятие шага времени TOTP, только если он новее последнего принятого
+function user_mfa_use_step(user_id, step)
+    local t = box.space.user_mfa:get(user_id)
+    if t == nil or not t[CONFIRMED] or step <= t[LAST_STEP] then
+        return false
+    end
+    --
+    box.space.user_mfa:update(user_id, {{'=', LAST_STEP, step}})
+    return true
+end
+
+-- Удаление хеша кода восстановления, только если он еще не использован
+function user_mfa_use_recovery_code(user_id, hash)
+    local t = box.space.user_mfa:get(user_id)
+    if t == nil or not t[CONFIRMED] then
+        return false
+    end
+    --
+    local codes = {}
+    local found = false
+    for _, code in ipairs(t[RECOVERY_CODES]) do
+        if not found and code == hash then
+            found = true
+        else
+            table.insert(codes, code)
+        end
+    end
+    --
+    if not found then
+        return false
+    end
+    --
+    box.space.user_mfa:update(user_id, {{'=', RECOVERY_CODES, codes}})
+    return true
+end
@@ -0,0 +1,30 @@
+#!/usr/bin/tarantool
+
+function add_user_mfa()
+    -- user-mfa
+    if not box.space.user_mfa then
+        local s = box.schema.space.create('user_mfa')
+        --
+        s:format({{
+            name = 'user_id',
+            type = 'unsigned'
+        }, {
+            name = 'secret',
+            type = 'string'
+        }, {
+            name = 'confirmed',
+            type = 'boolean'
+        }, {
+            name = 'last_step',
+            type = 'unsigned'
+        }, {
+            name = 'recovery_codes',
+            type = 'array'
+        }})
+        --
+        s:create_index('pk', {
+            type = 'tree',
+            parts = {'user_id'}
+        })
+    end
+end
@@ -0,0 +1,41 @@
+#!/usr/bin/tarantool
+
+function add_user_webauthn()
+    -- user-webauthn
+    if not box.space.user_webauthn then
+        local s = box.schema.space.create('user_webauthn')
+        --
+        s:format({{
+            name = 'id',
+            type = 'string'
+        }, {
+            name = 'user_id',
+            type = 'unsigned'
+        }, {
+            name = 'name',
+            type = 'string'
+        }, {
+            name = 'public_key',
+            type = 'string'
+        }, {
+            name = 'sign_count',
+            type = 'unsigned'
+        }, {
+            name = 'transports',
+            type = 'array'
+        }, {
+            name = 'created_at',
+            type = 'unsigned'
+        }})
+        --
+        s:create_index('pk', {
+            type = 'tree',
+            parts = {'id'}
+        })
+        s:create_index('secondary', {
+            type = 'tree',
+            unique = false,
+            parts = {'user_id'}
+        })
+    end
+end
@@ -0,0 +1,24 @@
+#!/usr/bin/tarantool
+
+function add_user_password()
+    -- user-password
+    if not box.space.user_password then
+        local s = box.schema.space.create('user_password')
+        --
+        s:format({{
+            name = 'user_id',
+            type = 'unsigned'
+        }, {
+            name = 'history',
+            type = 'array'
+        }, {
+            name = 'changed_at',
+            type = 'unsigned'
+        }})
+        --
+        s:create_index('pk', {
+            type = 'tree',
+            parts = {'user_id'}
+        })
+    end
+end
@@ -0,0 +1,20 @@
+#!/usr/bin/tarantool
+
+function add_user_email()
+    -- user email, необязательное поле существующего пространства
+    local s = box.space.user
+    local format = s:format()
+    --
+    for _, field in ipairs(format) do
+        if field.name == 'email' then
+            return
+        end
+    end
+    --
+    table.insert(format, {
+        name = 'email',
+        type = 'string',
+        is_nullable = true
+    })
+    s:format(format)
+end
@@ -6,6 +6,11 @@ require "3-add-users"
 require "1-add-privileges"
 require "5-add-role-users"
 require "4-add-role-privileges"
+require "6-add-user-mfa"
+require "7-add-user-webauthn"
+require "8-add-user-password"
+require "9-add-user-email"
+require "10-add-user-mfa-functions"
 
 box.cfg {
     listen = '0.0.0.0:33011',
@@ -23,3 +28,41 @@ box.once('init', function()
     add_role_users()
     add_role_privileges()
 end)
+
+--- spaces added after the initial release
+box.once('user_mfa', function()
+    add_user_mfa()
+end)
+
+box.once('user_webauthn', function()
+    add_user_webauthn()
+end)
+
+box.once('user_password', function()
+    add_user_password()
+end)
+
+box.once('user_email', function()
+    add_user_email()
+end)
+
+--- privileges added after the initial release
+box.once('signing_key_privileges', function()
+    add_signing_key_privileges()
+end)
+
+box.once('token_introspect_privilege', function()
+    add_token_introspect_privilege()
+end)
+
+box.once('session_authorize_privilege', function()
+    add_session_authorize_privilege()
+end)
+
+box.once('user_unlock_privilege', function()
+    add_user_unlock_privilege()
+end)
+
+box.once('user_mfa_privileges', function()
+    add_user_mfa_privileges()
+end)
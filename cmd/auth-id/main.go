@@ -2,8 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"runtime"
@@ -12,6 +16,7 @@ import (
 	"github.com/labstack/echo/v4"
 	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
 	"github.com/vtievsky/auth-id/internal/conf"
+	"github.com/vtievsky/auth-id/internal/grpctransport"
 	"github.com/vtievsky/auth-id/internal/httptransport"
 	otelclient "github.com/vtievsky/auth-id/internal/otel/client"
 	otelmetrics "github.com/vtievsky/auth-id/internal/otel/metrics"
@@ -20,22 +25,41 @@ import (
 	tarantoolprivileges "github.com/vtievsky/auth-id/internal/repositories/db/privileges"
 	tarantoolroles "github.com/vtievsky/auth-id/internal/repositories/db/roles"
 	tarantoolusers "github.com/vtievsky/auth-id/internal/repositories/db/users"
+	repoceremonies "github.com/vtievsky/auth-id/internal/repositories/sessions/ceremonies"
+	repochallenges "github.com/vtievsky/auth-id/internal/repositories/sessions/challenges"
 	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+	repoinvalidation "github.com/vtievsky/auth-id/internal/repositories/sessions/invalidation"
+	repolockouts "github.com/vtievsky/auth-id/internal/repositories/sessions/lockouts"
+	repopassresets "github.com/vtievsky/auth-id/internal/repositories/sessions/passresets"
+	reporatelimits "github.com/vtievsky/auth-id/internal/repositories/sessions/ratelimits"
 	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	reposigningkeys "github.com/vtievsky/auth-id/internal/repositories/sessions/signingkeys"
+	"github.com/vtievsky/auth-id/internal/routetable"
 	"github.com/vtievsky/auth-id/internal/services"
+	mfasvc "github.com/vtievsky/auth-id/internal/services/mfa"
+	passresetsvc "github.com/vtievsky/auth-id/internal/services/pass-resets"
 	privilegesvc "github.com/vtievsky/auth-id/internal/services/privileges"
+	propagationsvc "github.com/vtievsky/auth-id/internal/services/propagation"
 	roleprivilegesvc "github.com/vtievsky/auth-id/internal/services/role-privileges"
 	roleusersvc "github.com/vtievsky/auth-id/internal/services/role-users"
 	rolesvc "github.com/vtievsky/auth-id/internal/services/roles"
 	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	signingkeysvc "github.com/vtievsky/auth-id/internal/services/signing-keys"
 	userprivilegesvc "github.com/vtievsky/auth-id/internal/services/user-privileges"
 	userrolesvc "github.com/vtievsky/auth-id/internal/services/user-roles"
 	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"github.com/vtievsky/auth-id/pkg/notifier"
+	"github.com/vtievsky/auth-id/pkg/passhash"
+	"github.com/vtievsky/auth-id/pkg/passpolicy"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
 	"github.com/vtievsky/golibs/runtime/logger"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -74,6 +98,41 @@ func main() {
 		log.Fatal(err)
 	}
 
+	keyRing, err := newKeyRing(&conf.Session)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mfaCipher, err := newCipher("mfa", conf.MFA.EncryptionKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	signingKeyCipher, err := newCipher("signing key", conf.Session.SigningKeyEncryptionKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	passwordPolicy, err := newPasswordPolicy(&conf.Password)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	webAuthnDecoyKey, err := base64.StdEncoding.DecodeString(conf.WebAuthn.DecoyKey)
+	if err != nil {
+		log.Fatalf("failed to decode webauthn decoy key | %v", err)
+	}
+
+	passwordHasher, err := newPasswordHasher(&conf.PassHash)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	userNotifier, err := newNotifier(&conf.Notifier, logger.Named("notifier"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	dbClient, err := clienttarantool.New(&clienttarantool.ClientOpts{
 		URL:       conf.DB.URL,
 		RateLimit: 25, //nolint:mnd
@@ -89,6 +148,11 @@ func main() {
 		log.Fatal(err)
 	}
 
+	cacheBus := repoinvalidation.New(&repoinvalidation.BusOpts{
+		Logger: logger.Named("cache-bus"),
+		Client: sessionClient,
+	})
+
 	// repos
 	usersRepo := tarantoolusers.New(&tarantoolusers.UsersOpts{
 		Client: dbClient,
@@ -107,15 +171,43 @@ func main() {
 		Client: sessionClient,
 	})
 
+	lockoutsRepo := repolockouts.New(&repolockouts.LockoutsOpts{
+		Client: sessionClient,
+	})
+
+	challengesRepo := repochallenges.New(&repochallenges.ChallengesOpts{
+		Client: sessionClient,
+	})
+
+	ceremoniesRepo := repoceremonies.New(&repoceremonies.CeremoniesOpts{
+		Client: sessionClient,
+	})
+
+	rateLimitsRepo := reporatelimits.New(&reporatelimits.RateLimitsOpts{
+		Client: sessionClient,
+	})
+
+	passResetsRepo := repopassresets.New(&repopassresets.PassResetsOpts{
+		Client: sessionClient,
+	})
+
+	signingKeysRepo := reposigningkeys.New(&reposigningkeys.SigningKeysOpts{
+		Client: sessionClient,
+	})
+
 	// services
 	userService := usersvc.New(&usersvc.UserSvcOpts{
-		Logger:  logger.Named("user"),
-		Storage: usersRepo,
+		Logger:   logger.Named("user"),
+		Storage:  usersRepo,
+		CacheBus: cacheBus,
+		Policy:   passwordPolicy,
+		Hasher:   passwordHasher,
 	})
 
 	roleService := rolesvc.New(&rolesvc.RoleSvcOpts{
-		Logger:  logger.Named("role"),
-		Storage: rolesRepo,
+		Logger:   logger.Named("role"),
+		Storage:  rolesRepo,
+		CacheBus: cacheBus,
 	})
 
 	userRoleService := userrolesvc.New(&userrolesvc.UserRoleSvcOpts{
@@ -132,8 +224,9 @@ func main() {
 	})
 
 	privilegeService := privilegesvc.New(&privilegesvc.PrivilegeSvcOpts{
-		Logger:  logger.Named("privilege"),
-		Storage: privilegesRepo,
+		Logger:   logger.Named("privilege"),
+		Storage:  privilegesRepo,
+		CacheBus: cacheBus,
 	})
 
 	rolePrivilegeService := roleprivilegesvc.New(&roleprivilegesvc.RolePrivilegeSvcOpts{
@@ -149,17 +242,83 @@ func main() {
 		RolePrivilegeSvc: rolePrivilegeService,
 	})
 
+	mfaService := mfasvc.New(&mfasvc.MFASvcOpts{
+		Logger:  logger.Named("mfa"),
+		Storage: usersRepo,
+		UserSvc: userService,
+		Issuer:  conf.MFA.Issuer,
+		Cipher:  mfaCipher,
+		Skew:    0,
+	})
+
+	webAuthnService := webauthnsvc.New(&webauthnsvc.WebAuthnSvcOpts{
+		Logger:       logger.Named("webauthn"),
+		Storage:      usersRepo,
+		Ceremonies:   ceremoniesRepo,
+		UserSvc:      userService,
+		RelyingParty: newRelyingParty(&conf.WebAuthn),
+		Timeout:      conf.WebAuthn.Timeout,
+		DecoyKey:     webAuthnDecoyKey,
+	})
+
 	sessionService := sessionsvc.New(&sessionsvc.SessionSvcOpts{
-		Logger:           logger.Named("session"),
-		Storage:          sessionsRepo,
+		Logger:   logger.Named("session"),
+		Storage:  sessionsRepo,
+		CacheBus: cacheBus,
+		Lockouts: lockoutsRepo,
+		Lockout: sessionsvc.LockoutPolicy{
+			Threshold:          conf.Lockout.Threshold,
+			Duration:           conf.Lockout.Duration,
+			BaseDelay:          conf.Lockout.BaseDelay,
+			MaxDelay:           conf.Lockout.MaxDelay,
+			Window:             conf.Lockout.Window,
+			PermanentThreshold: conf.Lockout.PermanentThreshold,
+		},
+		Challenges: challengesRepo,
+		MFA: sessionsvc.MFAPolicy{
+			ChallengeTTL:      conf.MFA.ChallengeTTL,
+			ChallengeAttempts: conf.MFA.ChallengeAttempts,
+		},
+		MFASvc:           mfaService,
+		WebAuthnSvc:      webAuthnService,
 		UserSvc:          userService,
 		UserPrivilegeSvc: userPrivilegeService,
 		SessionTTL:       conf.Session.SessionTTL,
 		AccessTokenTTL:   conf.Session.AccessTokenTTL,
 		RefreshTokenTTL:  conf.Session.RefreshTokenTTL,
-		SigningKey:       conf.Session.SigningKey,
+		LastSeenInterval: conf.Session.LastSeenInterval,
+		KeyRing:          keyRing,
+	})
+
+	passResetService := passresetsvc.New(&passresetsvc.PassResetSvcOpts{
+		Logger:   logger.Named("pass-reset"),
+		Storage:  passResetsRepo,
+		Notifier: userNotifier,
+		UserSvc:  userService,
+		TTL:      conf.PassReset.TTL,
+		URL:      conf.PassReset.URL,
+	})
+
+	signingKeyService := signingkeysvc.New(&signingkeysvc.SigningKeySvcOpts{
+		Logger:       logger.Named("signing-key"),
+		Storage:      signingKeysRepo,
+		KeyRing:      keyRing,
+		Cipher:       signingKeyCipher,
+		SyncInterval: conf.Session.SigningKeySyncInterval,
+		Retention:    conf.Session.RefreshTokenTTL,
 	})
 
+	propagationService := propagationsvc.New(&propagationsvc.PropagationSvcOpts{
+		Logger:      logger.Named("propagation"),
+		RoleUserSvc: roleUserService,
+		SessionSvc:  sessionService,
+	})
+
+	userService.SetPropagation(propagationService)
+	roleService.SetPropagation(propagationService)
+	roleUserService.SetPropagation(propagationService)
+	rolePrivilegeService.SetPropagation(propagationService)
+
 	serverCtx, cancel := context.WithCancel(ctx)
 	services := &services.SvcLayer{
 		UserSvc:          userService,
@@ -170,19 +329,69 @@ func main() {
 		RolePrivilegeSvc: rolePrivilegeService,
 		PrivilegeSvc:     privilegeService,
 		SessionSvc:       sessionService,
+		MFASvc:           mfaService,
+		WebAuthnSvc:      webAuthnService,
+		PassResetSvc:     passResetService,
+		SigningKeySvc:    signingKeyService,
+	}
+
+	ipExtractor, err := httptransport.NewIPExtractor(conf.Proxy.TrustedNets)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var rateLimitRoutes map[string]httptransport.RateLimitRule
+
+	if conf.RateLimit.RoutesFile != "" {
+		rateLimitRoutes, err = httptransport.LoadRateLimitRoutes(conf.RateLimit.RoutesFile)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	httpSrv := echo.New()
 	httpSrv.HideBanner = true
+	httpSrv.IPExtractor = ipExtractor
+	rateLimiter := httptransport.NewRateLimiter(&httptransport.RateLimiterOpts{
+		Logger:  logger.Named("rate-limit"),
+		Storage: rateLimitsRepo,
+		Conf:    &conf.RateLimit,
+		Routes:  rateLimitRoutes,
+	})
+
 	httpSrv.Use(
 		httptransport.TracerMiddleware(),
 		httptransport.LoggerMiddleware(logger),
+		rateLimiter.ByClient(),
 		httptransport.AuthorizationMiddleware(
 			sessionService,
-			conf.Session.SigningKey,
+			keyRing,
 		),
+		rateLimiter.BySession(),
+	)
+
+	// Внешний авторизатор Envoy и forward-auth работают только при наличии таблицы маршрутов
+	var (
+		routes  *routetable.Table
+		grpcSrv *grpc.Server
 	)
 
+	if conf.RoutesFile != "" {
+		routes, err = routetable.Load(conf.RoutesFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		grpcSrv = grpc.NewServer()
+
+		grpctransport.New(&grpctransport.TransportOpts{
+			Logger:     logger.Named("ext-authz"),
+			SessionSvc: sessionService,
+			Keys:       keyRing,
+			Routes:     routes,
+		}).Register(grpcSrv)
+	}
+
 	signalChannel := make(chan os.Signal, 1)
 	signal.Notify(signalChannel, syscall.SIGINT, syscall.SIGTERM)
 
@@ -191,10 +400,25 @@ func main() {
 		ctx,
 		logger,
 		httpSrv,
+		grpcSrv,
 		shutdownMeterProvider,
 		shutdownTracerProvider,
 	)
 
+	go signingKeyService.Run(serverCtx)
+
+	// Без подписки локальные кеши экземпляра сбрасываются только по сроку годности
+	go cacheBus.Run(serverCtx)
+
+	if grpcSrv != nil {
+		go startExtAuthz(
+			cancel,
+			logger,
+			grpcSrv,
+			conf.ExtAuthz.Port,
+		)
+	}
+
 	go startApp(
 		cancel,
 		logger,
@@ -202,6 +426,7 @@ func main() {
 		httptransport.New(
 			conf,
 			services,
+			routes,
 		),
 		conf.Port,
 	)
@@ -218,10 +443,230 @@ func main() {
 	}
 }
 
+// Ключ подписи из PEM-файла имеет приоритет над общим секретом
+func newKeyRing(sessionConf *conf.SessionConfig) (*authidjwt.KeyRing, error) {
+	var active *authidjwt.Key
+
+	switch {
+	case sessionConf.SigningKeyFile != "":
+		key, err := authidjwt.LoadPrivateKeyFile(sessionConf.SigningKeyID, sessionConf.SigningKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing key | %w", err)
+		}
+
+		active = key
+	case sessionConf.SigningKey != "":
+		active = authidjwt.NewHMACKey(sessionConf.SigningKeyID, []byte(sessionConf.SigningKey))
+	default:
+		return nil, fmt.Errorf("signing key is not configured")
+	}
+
+	retired := make([]*authidjwt.Key, 0, len(sessionConf.RetiredSigningKeys)+len(sessionConf.RetiredSigningKeyFiles))
+
+	for kid, encoded := range sessionConf.RetiredSigningKeys {
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode retired signing key %q | %w", kid, err)
+		}
+
+		if len(secret) < 1 {
+			return nil, fmt.Errorf("retired signing key %q is empty", kid)
+		}
+
+		retired = append(retired, authidjwt.NewHMACKey(kid, secret))
+	}
+
+	for _, path := range sessionConf.RetiredSigningKeyFiles {
+		key, err := authidjwt.LoadPrivateKeyFile("", path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load retired signing key | %w", err)
+		}
+
+		retired = append(retired, key)
+	}
+
+	return authidjwt.NewKeyRing(active, retired...), nil
+}
+
+// Шифрование секретов в хранилище ключом AES-256 в base64.
+// Без ключа функции, хранящие секреты (второй фактор, создание ключей подписи), недоступны
+func newCipher(name, encoded string) (cipher.AEAD, error) {
+	if encoded == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s encryption key | %w", name, err)
+	}
+
+	if len(key) != 32 { //nolint:mnd
+		return nil, fmt.Errorf("%s encryption key must be 32 bytes, got %d", name, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s cipher | %w", name, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s cipher | %w", name, err)
+	}
+
+	return aead, nil
+}
+
+// Без идентификатора проверяющей стороны вход ключами недоступен
+func newRelyingParty(webAuthnConf *conf.WebAuthnConfig) *webauthn.RelyingParty {
+	if webAuthnConf.RPID == "" {
+		return nil
+	}
+
+	origins := webAuthnConf.Origins
+
+	if len(origins) < 1 {
+		origins = []string{"https://" + webAuthnConf.RPID}
+	}
+
+	return &webauthn.RelyingParty{
+		ID:      webAuthnConf.RPID,
+		Name:    webAuthnConf.RPName,
+		Origins: origins,
+	}
+}
+
+func newPasswordPolicy(passwordConf *conf.PasswordConfig) (usersvc.PasswordPolicy, error) {
+	denylist := passpolicy.NewDenylist()
+
+	if passwordConf.DenylistFile != "" {
+		loaded, err := passpolicy.LoadDenylist(passwordConf.DenylistFile)
+		if err != nil {
+			return usersvc.PasswordPolicy{}, err //nolint:exhaustruct,wrapcheck
+		}
+
+		denylist = loaded
+	}
+
+	return usersvc.PasswordPolicy{
+		Rules: passpolicy.Policy{
+			MinLength:      passwordConf.MinLength,
+			MaxLength:      passwordConf.MaxLength,
+			RequireLower:   passwordConf.RequireLower,
+			RequireUpper:   passwordConf.RequireUpper,
+			RequireDigit:   passwordConf.RequireDigit,
+			RequireSpecial: passwordConf.RequireSpecial,
+			RejectPersonal: passwordConf.RejectPersonal,
+			Denylist:       denylist,
+		},
+		HistorySize: passwordConf.HistorySize,
+		MaxAge:      passwordConf.MaxAge,
+	}, nil
+}
+
+func newPasswordHasher(hashConf *conf.PasswordHashConfig) (*passhash.Hasher, error) {
+	var algorithm passhash.Algorithm
+
+	switch hashConf.Algorithm {
+	case "argon2id":
+		algorithm = &passhash.Argon2id{
+			Memory:  hashConf.Argon2Memory,
+			Time:    hashConf.Argon2Time,
+			Threads: hashConf.Argon2Threads,
+			SaltLen: passhash.DefaultArgon2id().SaltLen,
+			KeyLen:  passhash.DefaultArgon2id().KeyLen,
+		}
+	case "scrypt":
+		algorithm = &passhash.Scrypt{
+			LogN:    hashConf.ScryptLogN,
+			R:       hashConf.ScryptR,
+			P:       hashConf.ScryptP,
+			SaltLen: passhash.DefaultScrypt().SaltLen,
+			KeyLen:  passhash.DefaultScrypt().KeyLen,
+		}
+	case "bcrypt":
+		algorithm = &passhash.Bcrypt{
+			Cost: hashConf.BcryptCost,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", hashConf.Algorithm)
+	}
+
+	// Недопустимые параметры приводили бы к панике или ошибке при первом хешировании
+	if err := algorithm.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to configure password hashing | %w", err)
+	}
+
+	peppers := make(map[string][]byte, len(hashConf.RetiredPeppers)+1)
+
+	for pepperID, encoded := range hashConf.RetiredPeppers {
+		pepper, err := decodePepper(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode retired password pepper %q | %w", pepperID, err)
+		}
+
+		peppers[pepperID] = pepper
+	}
+
+	pepperID := ""
+
+	if hashConf.Pepper != "" {
+		if hashConf.PepperID == "" {
+			return nil, fmt.Errorf("password pepper id is not configured")
+		}
+
+		pepper, err := decodePepper(hashConf.Pepper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode password pepper | %w", err)
+		}
+
+		pepperID = hashConf.PepperID
+		peppers[pepperID] = pepper
+	}
+
+	return passhash.New(&passhash.Opts{
+		Algorithm: algorithm,
+		PepperID:  pepperID,
+		Peppers:   peppers,
+	}), nil
+}
+
+func decodePepper(encoded string) ([]byte, error) {
+	pepper, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if len(pepper) < 1 {
+		return nil, fmt.Errorf("password pepper is empty")
+	}
+
+	return pepper, nil
+}
+
+func newNotifier(notifierConf *conf.NotifierConfig, logger *zap.Logger) (notifier.Notifier, error) {
+	switch notifierConf.Kind {
+	case "log":
+		return notifier.NewLog(logger), nil
+	case "smtp":
+		return notifier.NewSMTP(&notifier.SMTPOpts{ //nolint:wrapcheck
+			Addr:     notifierConf.SMTPAddr,
+			Username: notifierConf.SMTPUsername,
+			Password: notifierConf.SMTPPassword,
+			From:     notifierConf.SMTPFrom,
+			TLS:      notifierConf.SMTPTLS,
+			Timeout:  notifierConf.SMTPTimeout,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported notifier %q", notifierConf.Kind)
+	}
+}
+
 func stopApp(
 	ctx context.Context,
 	logger *zap.Logger,
 	httpSrv *echo.Echo,
+	grpcSrv *grpc.Server,
 	meterShutdown otelmetrics.MeterShutdown,
 	tracerShutdown oteltracing.TracerShutdown,
 ) {
@@ -238,6 +683,10 @@ func stopApp(
 		)
 	}
 
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
+	}
+
 	g := errgroup.Group{}
 
 	g.Go(func() error {
@@ -283,3 +732,27 @@ func startApp(
 		)
 	}
 }
+
+func startExtAuthz(
+	cancel context.CancelFunc,
+	logger *zap.Logger,
+	grpcSrv *grpc.Server,
+	port int,
+) {
+	defer cancel()
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		logger.Error("error while listen grpc server",
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if err = grpcSrv.Serve(listener); err != nil {
+		logger.Error("error while serve grpc server",
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,9438 @@
+// Package clienthttp provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by unknown module path version unknown version DO NOT EDIT.
+package clienthttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AuthorizeDecisionReason.
+const (
+	PrivilegeNotFound AuthorizeDecisionReason = "privilege_not_found"
+	SessionNotFound   AuthorizeDecisionReason = "session_not_found"
+	TokenExpired      AuthorizeDecisionReason = "token_expired"
+	TokenInvalid      AuthorizeDecisionReason = "token_invalid"
+)
+
+// Defines values for LoginLockoutReason.
+const (
+	LoginLocked    LoginLockoutReason = "login_locked"
+	LoginThrottled LoginLockoutReason = "login_throttled"
+	UserBlocked    LoginLockoutReason = "user_blocked"
+)
+
+// Defines values for ResponseStatusErrorCode.
+const (
+	Error ResponseStatusErrorCode = "error"
+)
+
+// Defines values for ResponseStatusOkCode.
+const (
+	Ok ResponseStatusOkCode = "ok"
+)
+
+// Defines values for SessionMethod.
+const (
+	SessionMethodMfa            SessionMethod = "mfa"
+	SessionMethodPassword       SessionMethod = "password"
+	SessionMethodPasswordChange SessionMethod = "password_change"
+	SessionMethodWebauthn       SessionMethod = "webauthn"
+)
+
+// AddRolePrivilegeRequest defines model for AddRolePrivilegeRequest.
+type AddRolePrivilegeRequest struct {
+	Allowed bool `json:"allowed"`
+}
+
+// AddRolePrivilegeResponse200 defines model for AddRolePrivilegeResponse200.
+type AddRolePrivilegeResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// AddRolePrivilegeResponse500 defines model for AddRolePrivilegeResponse500.
+type AddRolePrivilegeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// AddRoleUserRequest defines model for AddRoleUserRequest.
+type AddRoleUserRequest struct {
+	DateIn  openapi_types.Date `json:"date_in"`
+	DateOut openapi_types.Date `json:"date_out"`
+}
+
+// AddRoleUserResponse200 defines model for AddRoleUserResponse200.
+type AddRoleUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// AddRoleUserResponse500 defines model for AddRoleUserResponse500.
+type AddRoleUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// AuthorizeDecision defines model for AuthorizeDecision.
+type AuthorizeDecision struct {
+	// Allowed Доступ разрешен
+	Allowed bool `json:"allowed"`
+
+	// Privilege Код привилегии
+	Privilege string `json:"privilege"`
+
+	// Reason Причина отказа
+	Reason *AuthorizeDecisionReason `json:"reason,omitempty"`
+}
+
+// AuthorizeDecisionReason Причина отказа
+type AuthorizeDecisionReason string
+
+// AuthorizeRequest defines model for AuthorizeRequest.
+type AuthorizeRequest struct {
+	// Privileges Коды проверяемых привилегий
+	Privileges []string `json:"privileges"`
+
+	// Token Access-токен проверяемой сессии
+	Token string `json:"token"`
+}
+
+// AuthorizeResponse200 defines model for AuthorizeResponse200.
+type AuthorizeResponse200 struct {
+	Data   []AuthorizeDecision `json:"data"`
+	Status ResponseStatusOk    `json:"status"`
+}
+
+// AuthorizeResponse500 defines model for AuthorizeResponse500.
+type AuthorizeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// BeginWebAuthnLoginRequest defines model for BeginWebAuthnLoginRequest.
+type BeginWebAuthnLoginRequest struct {
+	// MfaChallenge Токен незавершенного входа по паролю
+	MfaChallenge *string `json:"mfa_challenge,omitempty"`
+}
+
+// BeginWebAuthnLoginResponse200 defines model for BeginWebAuthnLoginResponse200.
+type BeginWebAuthnLoginResponse200 struct {
+	Data   WebAuthnAssertionStart `json:"data"`
+	Status ResponseStatusOk       `json:"status"`
+}
+
+// BeginWebAuthnLoginResponse500 defines model for BeginWebAuthnLoginResponse500.
+type BeginWebAuthnLoginResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// BeginWebAuthnRegistrationResponse200 defines model for BeginWebAuthnRegistrationResponse200.
+type BeginWebAuthnRegistrationResponse200 struct {
+	Data   WebAuthnRegistration `json:"data"`
+	Status ResponseStatusOk     `json:"status"`
+}
+
+// BeginWebAuthnRegistrationResponse500 defines model for BeginWebAuthnRegistrationResponse500.
+type BeginWebAuthnRegistrationResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ChangePassRequest defines model for ChangePassRequest.
+type ChangePassRequest struct {
+	// Changed Новый пароль
+	Changed string `json:"changed"`
+
+	// Current Текущий пароль
+	Current string `json:"current"`
+}
+
+// ChangePassResponse200 defines model for ChangePassResponse200.
+type ChangePassResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// ChangePassResponse422 defines model for ChangePassResponse422.
+type ChangePassResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// ChangePassResponse500 defines model for ChangePassResponse500.
+type ChangePassResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFARequest defines model for CompleteMFARequest.
+type CompleteMFARequest struct {
+	// Challenge Токен незавершенного входа
+	Challenge string `json:"challenge"`
+
+	// Code Код TOTP или одноразовый код восстановления
+	Code string `json:"code"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+}
+
+// CompleteMFAResponse200 defines model for CompleteMFAResponse200.
+type CompleteMFAResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompleteMFAResponse403 defines model for CompleteMFAResponse403.
+type CompleteMFAResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse423 defines model for CompleteMFAResponse423.
+type CompleteMFAResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse429 defines model for CompleteMFAResponse429.
+type CompleteMFAResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse500 defines model for CompleteMFAResponse500.
+type CompleteMFAResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePassResetRequest defines model for CompletePassResetRequest.
+type CompletePassResetRequest struct {
+	// Changed Новый пароль
+	Changed string `json:"changed"`
+
+	// Token Одноразовый токен сброса пароля из письма
+	Token string `json:"token"`
+}
+
+// CompletePassResetResponse200 defines model for CompletePassResetResponse200.
+type CompletePassResetResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompletePassResetResponse422 defines model for CompletePassResetResponse422.
+type CompletePassResetResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePassResetResponse500 defines model for CompletePassResetResponse500.
+type CompletePassResetResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePasswordChangeRequest defines model for CompletePasswordChangeRequest.
+type CompletePasswordChangeRequest struct {
+	// Challenge Токен незавершенного входа
+	Challenge string `json:"challenge"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
+	// Password Новый пароль
+	Password string `json:"password"`
+}
+
+// CompletePasswordChangeResponse200 defines model for CompletePasswordChangeResponse200.
+type CompletePasswordChangeResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompletePasswordChangeResponse422 defines model for CompletePasswordChangeResponse422.
+type CompletePasswordChangeResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePasswordChangeResponse500 defines model for CompletePasswordChangeResponse500.
+type CompletePasswordChangeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ConfirmTOTPRequest defines model for ConfirmTOTPRequest.
+type ConfirmTOTPRequest struct {
+	// Code Код из приложения-аутентификатора
+	Code string `json:"code"`
+}
+
+// ConfirmTOTPResponse200 defines model for ConfirmTOTPResponse200.
+type ConfirmTOTPResponse200 struct {
+	Data   TOTPRecoveryCodes `json:"data"`
+	Status ResponseStatusOk  `json:"status"`
+}
+
+// ConfirmTOTPResponse500 defines model for ConfirmTOTPResponse500.
+type ConfirmTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CreateRoleRequest defines model for CreateRoleRequest.
+type CreateRoleRequest struct {
+	Blocked bool `json:"blocked"`
+
+	// Description Описание роли
+	Description string `json:"description"`
+
+	// Name Название роли
+	Name string `json:"name"`
+}
+
+// CreateRoleResponse200 defines model for CreateRoleResponse200.
+type CreateRoleResponse200 struct {
+	Data   Role             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CreateRoleResponse500 defines model for CreateRoleResponse500.
+type CreateRoleResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	Blocked bool `json:"blocked"`
+
+	// Email Адрес электронной почты для сброса пароля
+	Email *string `json:"email,omitempty"`
+
+	// Login login пользователя
+	Login string `json:"login"`
+
+	// Name Полное имя пользователя
+	Name string `json:"name"`
+
+	// Password Пароль пользователя
+	Password string `json:"password"`
+}
+
+// CreateUserResponse200 defines model for CreateUserResponse200.
+type CreateUserResponse200 struct {
+	Data   User             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CreateUserResponse422 defines model for CreateUserResponse422.
+type CreateUserResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CreateUserResponse500 defines model for CreateUserResponse500.
+type CreateUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CreateWebAuthnCredentialRequest defines model for CreateWebAuthnCredentialRequest.
+type CreateWebAuthnCredentialRequest struct {
+	// AttestationObject response.attestationObject в base64url
+	AttestationObject string `json:"attestation_object"`
+
+	// Ceremony Идентификатор церемонии
+	Ceremony string `json:"ceremony"`
+
+	// ClientDataJson response.clientDataJSON в base64url
+	ClientDataJson string `json:"client_data_json"`
+
+	// Name Название ключа
+	Name *string `json:"name,omitempty"`
+
+	// Transports response.getTransports()
+	Transports *[]string `json:"transports,omitempty"`
+}
+
+// CreateWebAuthnCredentialResponse200 defines model for CreateWebAuthnCredentialResponse200.
+type CreateWebAuthnCredentialResponse200 struct {
+	Data   WebAuthnCredential `json:"data"`
+	Status ResponseStatusOk   `json:"status"`
+}
+
+// CreateWebAuthnCredentialResponse500 defines model for CreateWebAuthnCredentialResponse500.
+type CreateWebAuthnCredentialResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteCurrentSessionResponse200 defines model for DeleteCurrentSessionResponse200.
+type DeleteCurrentSessionResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteCurrentSessionResponse500 defines model for DeleteCurrentSessionResponse500.
+type DeleteCurrentSessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteMySessionResponse200 defines model for DeleteMySessionResponse200.
+type DeleteMySessionResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteMySessionResponse500 defines model for DeleteMySessionResponse500.
+type DeleteMySessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteRolePrivilegeResponse200 defines model for DeleteRolePrivilegeResponse200.
+type DeleteRolePrivilegeResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteRolePrivilegeResponse500 defines model for DeleteRolePrivilegeResponse500.
+type DeleteRolePrivilegeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteRoleResponse200 defines model for DeleteRoleResponse200.
+type DeleteRoleResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteRoleResponse500 defines model for DeleteRoleResponse500.
+type DeleteRoleResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteRoleUserResponse200 defines model for DeleteRoleUserResponse200.
+type DeleteRoleUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteRoleUserResponse500 defines model for DeleteRoleUserResponse500.
+type DeleteRoleUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteUserResponse200 defines model for DeleteUserResponse200.
+type DeleteUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteUserResponse500 defines model for DeleteUserResponse500.
+type DeleteUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteUserSessionResponse200 defines model for DeleteUserSessionResponse200.
+type DeleteUserSessionResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteUserSessionResponse500 defines model for DeleteUserSessionResponse500.
+type DeleteUserSessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteUserSessionsResponse200 defines model for DeleteUserSessionsResponse200.
+type DeleteUserSessionsResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteUserSessionsResponse500 defines model for DeleteUserSessionsResponse500.
+type DeleteUserSessionsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteWebAuthnCredentialResponse200 defines model for DeleteWebAuthnCredentialResponse200.
+type DeleteWebAuthnCredentialResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteWebAuthnCredentialResponse500 defines model for DeleteWebAuthnCredentialResponse500.
+type DeleteWebAuthnCredentialResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DisableTOTPResponse200 defines model for DisableTOTPResponse200.
+type DisableTOTPResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DisableTOTPResponse500 defines model for DisableTOTPResponse500.
+type DisableTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// EnrollTOTPResponse200 defines model for EnrollTOTPResponse200.
+type EnrollTOTPResponse200 struct {
+	Data   TOTPEnrollment   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// EnrollTOTPResponse500 defines model for EnrollTOTPResponse500.
+type EnrollTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ForwardAuthResponse200 defines model for ForwardAuthResponse200.
+type ForwardAuthResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// ForwardAuthResponseError defines model for ForwardAuthResponseError.
+type ForwardAuthResponseError struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GenerateSigningKeyResponse200 defines model for GenerateSigningKeyResponse200.
+type GenerateSigningKeyResponse200 struct {
+	Data   SigningKey       `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GenerateSigningKeyResponse500 defines model for GenerateSigningKeyResponse500.
+type GenerateSigningKeyResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetMeResponse200 defines model for GetMeResponse200.
+type GetMeResponse200 struct {
+	Data   User             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetMeResponse500 defines model for GetMeResponse500.
+type GetMeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetMySessionsResponse200 defines model for GetMySessionsResponse200.
+type GetMySessionsResponse200 struct {
+	Data   []Session        `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetMySessionsResponse500 defines model for GetMySessionsResponse500.
+type GetMySessionsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetPrivilegesResponse200 defines model for GetPrivilegesResponse200.
+type GetPrivilegesResponse200 struct {
+	Data   []Privilege      `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetPrivilegesResponse500 defines model for GetPrivilegesResponse500.
+type GetPrivilegesResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetRolePrivilegesResponse200 defines model for GetRolePrivilegesResponse200.
+type GetRolePrivilegesResponse200 struct {
+	Data   []RolePrivilege  `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetRolePrivilegesResponse500 defines model for GetRolePrivilegesResponse500.
+type GetRolePrivilegesResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetRoleResponse200 defines model for GetRoleResponse200.
+type GetRoleResponse200 struct {
+	Data   Role             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetRoleResponse500 defines model for GetRoleResponse500.
+type GetRoleResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetRoleUsersResponse200 defines model for GetRoleUsersResponse200.
+type GetRoleUsersResponse200 struct {
+	Data   []RoleUser       `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetRoleUsersResponse500 defines model for GetRoleUsersResponse500.
+type GetRoleUsersResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetRolesResponse200 defines model for GetRolesResponse200.
+type GetRolesResponse200 struct {
+	Data   []Role           `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetRolesResponse500 defines model for GetRolesResponse500.
+type GetRolesResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetSigningKeysResponse200 defines model for GetSigningKeysResponse200.
+type GetSigningKeysResponse200 struct {
+	Data   []SigningKey     `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetSigningKeysResponse500 defines model for GetSigningKeysResponse500.
+type GetSigningKeysResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetUserPrivilegesResponse200 defines model for GetUserPrivilegesResponse200.
+type GetUserPrivilegesResponse200 struct {
+	Data   []UserPrivilege  `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetUserPrivilegesResponse500 defines model for GetUserPrivilegesResponse500.
+type GetUserPrivilegesResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetUserResponse200 defines model for GetUserResponse200.
+type GetUserResponse200 struct {
+	Data   User             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetUserResponse500 defines model for GetUserResponse500.
+type GetUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetUserRolesResponse200 defines model for GetUserRolesResponse200.
+type GetUserRolesResponse200 struct {
+	Data   []UserRole       `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetUserRolesResponse500 defines model for GetUserRolesResponse500.
+type GetUserRolesResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetUserSessionsResponse200 defines model for GetUserSessionsResponse200.
+type GetUserSessionsResponse200 struct {
+	Data   []Session        `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetUserSessionsResponse500 defines model for GetUserSessionsResponse500.
+type GetUserSessionsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetUsersResponse200 defines model for GetUsersResponse200.
+type GetUsersResponse200 struct {
+	Data   []User           `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetUsersResponse500 defines model for GetUsersResponse500.
+type GetUsersResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetWebAuthnCredentialsResponse200 defines model for GetWebAuthnCredentialsResponse200.
+type GetWebAuthnCredentialsResponse200 struct {
+	Data   []WebAuthnCredential `json:"data"`
+	Status ResponseStatusOk     `json:"status"`
+}
+
+// GetWebAuthnCredentialsResponse500 defines model for GetWebAuthnCredentialsResponse500.
+type GetWebAuthnCredentialsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// IntrospectTokenRequest defines model for IntrospectTokenRequest.
+type IntrospectTokenRequest struct {
+	// Token Проверяемый токен
+	Token string `json:"token"`
+
+	// TokenTypeHint Тип токена
+	TokenTypeHint *string `json:"token_type_hint,omitempty"`
+}
+
+// IntrospectTokenResponse200 defines model for IntrospectTokenResponse200.
+type IntrospectTokenResponse200 struct {
+	// Active Токен действителен
+	Active bool `json:"active"`
+
+	// Exp Время окончания срока действия токена
+	Exp *int64 `json:"exp,omitempty"`
+
+	// Iat Время выпуска токена
+	Iat *int64 `json:"iat,omitempty"`
+
+	// Scope Привилегии сессии через пробел
+	Scope *string `json:"scope,omitempty"`
+
+	// Sid Идентификатор сессии
+	Sid *string `json:"sid,omitempty"`
+
+	// Sub Логин пользователя
+	Sub *string `json:"sub,omitempty"`
+}
+
+// IntrospectTokenResponse500 defines model for IntrospectTokenResponse500.
+type IntrospectTokenResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// JWK defines model for JWK.
+type JWK struct {
+	Alg *string `json:"alg,omitempty"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+
+	// Kid Идентификатор ключа
+	Kid string `json:"kid"`
+
+	// Kty Тип ключа
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use *string `json:"use,omitempty"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoginLockout defines model for LoginLockout.
+type LoginLockout struct {
+	// LockedUntil Момент, после которого вход снова возможен. Отсутствует при блокировке пользователя
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+
+	// Reason Причина отказа во входе
+	Reason LoginLockoutReason `json:"reason"`
+}
+
+// LoginLockoutReason Причина отказа во входе
+type LoginLockoutReason string
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
+	// Password Пароль
+	Password string `json:"password"`
+}
+
+// LoginResponse200 defines model for LoginResponse200.
+type LoginResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// LoginResponse202 defines model for LoginResponse202.
+type LoginResponse202 struct {
+	Data   MFAChallenge     `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// LoginResponse403 defines model for LoginResponse403.
+type LoginResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginResponse423 defines model for LoginResponse423.
+type LoginResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginResponse429 defines model for LoginResponse429.
+type LoginResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginResponse500 defines model for LoginResponse500.
+type LoginResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnRequest defines model for LoginWebAuthnRequest.
+type LoginWebAuthnRequest struct {
+	// AuthenticatorData response.authenticatorData в base64url
+	AuthenticatorData string `json:"authenticator_data"`
+
+	// Ceremony Идентификатор церемонии
+	Ceremony string `json:"ceremony"`
+
+	// ClientDataJson response.clientDataJSON в base64url
+	ClientDataJson string `json:"client_data_json"`
+
+	// CredentialId rawId в base64url
+	CredentialId string `json:"credential_id"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
+	// Signature response.signature в base64url
+	Signature string `json:"signature"`
+
+	// UserHandle response.userHandle в base64url
+	UserHandle *string `json:"user_handle,omitempty"`
+}
+
+// LoginWebAuthnResponse200 defines model for LoginWebAuthnResponse200.
+type LoginWebAuthnResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// LoginWebAuthnResponse403 defines model for LoginWebAuthnResponse403.
+type LoginWebAuthnResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse423 defines model for LoginWebAuthnResponse423.
+type LoginWebAuthnResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse429 defines model for LoginWebAuthnResponse429.
+type LoginWebAuthnResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse500 defines model for LoginWebAuthnResponse500.
+type LoginWebAuthnResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// MFAChallenge defines model for MFAChallenge.
+type MFAChallenge struct {
+	// Challenge Токен незавершенного входа для передачи вместе с кодом
+	Challenge string `json:"challenge"`
+}
+
+// PasswordChange defines model for PasswordChange.
+type PasswordChange struct {
+	// Challenge Токен незавершенного входа для передачи вместе с новым паролем
+	Challenge string `json:"challenge"`
+}
+
+// PasswordViolation Нарушение правил выбора пароля
+type PasswordViolation struct {
+	// Code Код нарушения: too_short, too_long, no_lower, no_upper, no_digit, no_special, contains_personal, common, reused
+	Code string `json:"code"`
+
+	// Message Описание нарушения
+	Message string `json:"message"`
+}
+
+// Privilege defines model for Privilege.
+type Privilege struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// RefreshSessionRequest defines model for RefreshSessionRequest.
+type RefreshSessionRequest struct {
+	// RefreshToken Refresh-токен
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshSessionResponse200 defines model for RefreshSessionResponse200.
+type RefreshSessionResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RefreshSessionResponse500 defines model for RefreshSessionResponse500.
+type RefreshSessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// RequestPassResetResponse200 defines model for RequestPassResetResponse200.
+type RequestPassResetResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RequestPassResetResponse500 defines model for RequestPassResetResponse500.
+type RequestPassResetResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ResetPassRequest defines model for ResetPassRequest.
+type ResetPassRequest struct {
+	// Changed Новый пароль
+	Changed string `json:"changed"`
+}
+
+// ResetPassResponse200 defines model for ResetPassResponse200.
+type ResetPassResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// ResetPassResponse422 defines model for ResetPassResponse422.
+type ResetPassResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// ResetPassResponse500 defines model for ResetPassResponse500.
+type ResetPassResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ResponseAccess defines model for ResponseAccess.
+type ResponseAccess struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ResponseStatusError defines model for ResponseStatusError.
+type ResponseStatusError struct {
+	Code        ResponseStatusErrorCode `json:"code"`
+	Description string                  `json:"description"`
+}
+
+// ResponseStatusErrorCode defines model for ResponseStatusError.Code.
+type ResponseStatusErrorCode string
+
+// ResponseStatusOk defines model for ResponseStatusOk.
+type ResponseStatusOk struct {
+	Code        ResponseStatusOkCode `json:"code"`
+	Description string               `json:"description"`
+}
+
+// ResponseStatusOkCode defines model for ResponseStatusOk.Code.
+type ResponseStatusOkCode string
+
+// Role defines model for Role.
+type Role struct {
+	Blocked     bool   `json:"blocked"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// RolePrivilege defines model for RolePrivilege.
+type RolePrivilege struct {
+	Allowed     bool   `json:"allowed"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// RoleUser defines model for RoleUser.
+type RoleUser struct {
+	DateIn  openapi_types.Date `json:"date_in"`
+	DateOut openapi_types.Date `json:"date_out"`
+	Login   string             `json:"login"`
+	Name    string             `json:"name"`
+}
+
+// RotateSigningKeyResponse200 defines model for RotateSigningKeyResponse200.
+type RotateSigningKeyResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RotateSigningKeyResponse500 defines model for RotateSigningKeyResponse500.
+type RotateSigningKeyResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// CreatedAt Время создания сессии
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current Сессия, от имени которой выполнен запрос
+	Current *bool `json:"current,omitempty"`
+
+	// Device Название устройства, указанное клиентом при входе
+	Device *string `json:"device,omitempty"`
+
+	// ExpiredAt Время истечения срока действия сессии
+	ExpiredAt time.Time `json:"expired_at"`
+
+	// Id Идентификатор сессии
+	Id string `json:"id"`
+
+	// Ip Адрес клиента, открывшего сессию
+	Ip *string `json:"ip,omitempty"`
+
+	// LastSeenAt Время последнего обращения, записывается не чаще интервала AUTH_SESSION_LAST_SEEN_INTERVAL
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+
+	// Method Способ входа
+	Method *SessionMethod `json:"method,omitempty"`
+
+	// UserAgent User-Agent клиента, открывшего сессию
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// SessionMethod Способ входа
+type SessionMethod string
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	// Active Ключ используется для подписи новых токенов
+	Active bool `json:"active"`
+
+	// Alg Алгоритм подписи
+	Alg string `json:"alg"`
+
+	// Kid Идентификатор ключа
+	Kid string `json:"kid"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	// Secret Секрет в base32 для ручного ввода
+	Secret string `json:"secret"`
+
+	// Uri Ссылка otpauth:// для QR-кода
+	Uri string `json:"uri"`
+}
+
+// TOTPRecoveryCodes defines model for TOTPRecoveryCodes.
+type TOTPRecoveryCodes struct {
+	// RecoveryCodes Одноразовые коды восстановления, показываются один раз
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// UnlockUserResponse200 defines model for UnlockUserResponse200.
+type UnlockUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UnlockUserResponse500 defines model for UnlockUserResponse500.
+type UnlockUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// UpdateRolePrivilegeRequest defines model for UpdateRolePrivilegeRequest.
+type UpdateRolePrivilegeRequest struct {
+	Allowed bool `json:"allowed"`
+}
+
+// UpdateRolePrivilegeResponse200 defines model for UpdateRolePrivilegeResponse200.
+type UpdateRolePrivilegeResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UpdateRolePrivilegeResponse500 defines model for UpdateRolePrivilegeResponse500.
+type UpdateRolePrivilegeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// UpdateRoleRequest defines model for UpdateRoleRequest.
+type UpdateRoleRequest struct {
+	Blocked bool `json:"blocked"`
+
+	// Description Описание роли
+	Description string `json:"description"`
+
+	// Name Название роли
+	Name string `json:"name"`
+}
+
+// UpdateRoleResponse200 defines model for UpdateRoleResponse200.
+type UpdateRoleResponse200 struct {
+	Data   Role             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UpdateRoleResponse500 defines model for UpdateRoleResponse500.
+type UpdateRoleResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// UpdateRoleUserRequest defines model for UpdateRoleUserRequest.
+type UpdateRoleUserRequest struct {
+	DateIn  openapi_types.Date `json:"date_in"`
+	DateOut openapi_types.Date `json:"date_out"`
+}
+
+// UpdateRoleUserResponse200 defines model for UpdateRoleUserResponse200.
+type UpdateRoleUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UpdateRoleUserResponse500 defines model for UpdateRoleUserResponse500.
+type UpdateRoleUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	Blocked bool `json:"blocked"`
+
+	// Email Адрес электронной почты для сброса пароля, без поля не изменяется
+	Email *string `json:"email,omitempty"`
+
+	// Name Полное имя пользователя
+	Name string `json:"name"`
+}
+
+// UpdateUserResponse200 defines model for UpdateUserResponse200.
+type UpdateUserResponse200 struct {
+	Data   User             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UpdateUserResponse500 defines model for UpdateUserResponse500.
+type UpdateUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// User defines model for User.
+type User struct {
+	Blocked bool `json:"blocked"`
+
+	// Email Адрес электронной почты для сброса пароля
+	Email *string `json:"email,omitempty"`
+	Login string  `json:"login"`
+	Name  string  `json:"name"`
+}
+
+// UserPrivilege defines model for UserPrivilege.
+type UserPrivilege struct {
+	Code        string             `json:"code"`
+	DateIn      openapi_types.Date `json:"date_in"`
+	DateOut     openapi_types.Date `json:"date_out"`
+	Description string             `json:"description"`
+	Name        string             `json:"name"`
+}
+
+// UserRole defines model for UserRole.
+type UserRole struct {
+	Code        string             `json:"code"`
+	DateIn      openapi_types.Date `json:"date_in"`
+	DateOut     openapi_types.Date `json:"date_out"`
+	Description string             `json:"description"`
+	Name        string             `json:"name"`
+}
+
+// WebAuthnAssertionStart defines model for WebAuthnAssertionStart.
+type WebAuthnAssertionStart struct {
+	// Ceremony Идентификатор церемонии для завершения входа
+	Ceremony string `json:"ceremony"`
+
+	// Options Параметры в формате PublicKeyCredentialRequestOptionsJSON
+	Options WebAuthnRequestOptions `json:"options"`
+}
+
+// WebAuthnAuthenticatorSelection defines model for WebAuthnAuthenticatorSelection.
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnCreationOptions Параметры в формате PublicKeyCredentialCreationOptionsJSON
+type WebAuthnCreationOptions struct {
+	Attestation            string                         `json:"attestation"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+
+	// Challenge Вызов в base64url
+	Challenge          string                         `json:"challenge"`
+	ExcludeCredentials []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	PubKeyCredParams   []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Rp                 WebAuthnRelyingParty           `json:"rp"`
+
+	// Timeout Время на выполнение церемонии в миллисекундах
+	Timeout int64        `json:"timeout"`
+	User    WebAuthnUser `json:"user"`
+}
+
+// WebAuthnCredential defines model for WebAuthnCredential.
+type WebAuthnCredential struct {
+	// CreatedAt Время регистрации ключа
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Идентификатор ключа в base64url
+	Id string `json:"id"`
+
+	// Name Название ключа
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+}
+
+// WebAuthnCredentialDescriptor defines model for WebAuthnCredentialDescriptor.
+type WebAuthnCredentialDescriptor struct {
+	// Id Идентификатор ключа в base64url
+	Id         string    `json:"id"`
+	Transports *[]string `json:"transports,omitempty"`
+	Type       string    `json:"type"`
+}
+
+// WebAuthnCredentialParameter defines model for WebAuthnCredentialParameter.
+type WebAuthnCredentialParameter struct {
+	// Alg Алгоритм COSE
+	Alg  int64  `json:"alg"`
+	Type string `json:"type"`
+}
+
+// WebAuthnRegistration defines model for WebAuthnRegistration.
+type WebAuthnRegistration struct {
+	// Ceremony Идентификатор церемонии для завершения регистрации
+	Ceremony string `json:"ceremony"`
+
+	// Options Параметры в формате PublicKeyCredentialCreationOptionsJSON
+	Options WebAuthnCreationOptions `json:"options"`
+}
+
+// WebAuthnRelyingParty defines model for WebAuthnRelyingParty.
+type WebAuthnRelyingParty struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// WebAuthnRequestOptions Параметры в формате PublicKeyCredentialRequestOptionsJSON
+type WebAuthnRequestOptions struct {
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+
+	// Challenge Вызов в base64url
+	Challenge string `json:"challenge"`
+	RpId      string `json:"rpId"`
+
+	// Timeout Время на выполнение церемонии в миллисекундах
+	Timeout          int64  `json:"timeout"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnUser defines model for WebAuthnUser.
+type WebAuthnUser struct {
+	DisplayName string `json:"displayName"`
+
+	// Id Идентификатор пользователя в base64url
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Conflict defines model for Conflict.
+type Conflict = ResponseError
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ResponseError
+
+// ForwardAuthParams defines parameters for ForwardAuth.
+type ForwardAuthParams struct {
+	// Upstream Защищаемый сервис. По умолчанию используется заголовок X-Forwarded-Host
+	Upstream *string `form:"upstream,omitempty" json:"upstream,omitempty"`
+
+	// XForwardedMethod Метод исходного запроса
+	XForwardedMethod *string `json:"X-Forwarded-Method,omitempty"`
+
+	// XForwardedUri Путь исходного запроса. Без заголовка запрос отклоняется с кодом 400
+	XForwardedUri *string `json:"X-Forwarded-Uri,omitempty"`
+
+	// XForwardedHost Хост исходного запроса
+	XForwardedHost *string `json:"X-Forwarded-Host,omitempty"`
+}
+
+// GetMySessionsParams defines parameters for GetMySessions.
+type GetMySessionsParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// ChangePassParams defines parameters for ChangePass.
+type ChangePassParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
+// ResetPassParams defines parameters for ResetPass.
+type ResetPassParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
+// GetPrivilegesParams defines parameters for GetPrivileges.
+type GetPrivilegesParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetRolesParams defines parameters for GetRoles.
+type GetRolesParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetRolePrivilegesParams defines parameters for GetRolePrivileges.
+type GetRolePrivilegesParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetRoleUsersParams defines parameters for GetRoleUsers.
+type GetRoleUsersParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetUserPrivilegesParams defines parameters for GetUserPrivileges.
+type GetUserPrivilegesParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// GetUserRolesParams defines parameters for GetUserRoles.
+type GetUserRolesParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// DeleteUserSessionsParams defines parameters for DeleteUserSessions.
+type DeleteUserSessionsParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
+// GetUserSessionsParams defines parameters for GetUserSessions.
+type GetUserSessionsParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// AuthorizeJSONRequestBody defines body for Authorize for application/json ContentType.
+type AuthorizeJSONRequestBody = AuthorizeRequest
+
+// IntrospectTokenFormdataRequestBody defines body for IntrospectToken for application/x-www-form-urlencoded ContentType.
+type IntrospectTokenFormdataRequestBody = IntrospectTokenRequest
+
+// ChangePassJSONRequestBody defines body for ChangePass for application/json ContentType.
+type ChangePassJSONRequestBody = ChangePassRequest
+
+// ResetPassJSONRequestBody defines body for ResetPass for application/json ContentType.
+type ResetPassJSONRequestBody = ResetPassRequest
+
+// CompletePassResetJSONRequestBody defines body for CompletePassReset for application/json ContentType.
+type CompletePassResetJSONRequestBody = CompletePassResetRequest
+
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = CreateRoleRequest
+
+// UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
+type UpdateRoleJSONRequestBody = UpdateRoleRequest
+
+// AddRolePrivilegeJSONRequestBody defines body for AddRolePrivilege for application/json ContentType.
+type AddRolePrivilegeJSONRequestBody = AddRolePrivilegeRequest
+
+// UpdateRolePrivilegeJSONRequestBody defines body for UpdateRolePrivilege for application/json ContentType.
+type UpdateRolePrivilegeJSONRequestBody = UpdateRolePrivilegeRequest
+
+// AddRoleUserJSONRequestBody defines body for AddRoleUser for application/json ContentType.
+type AddRoleUserJSONRequestBody = AddRoleUserRequest
+
+// UpdateRoleUserJSONRequestBody defines body for UpdateRoleUser for application/json ContentType.
+type UpdateRoleUserJSONRequestBody = UpdateRoleUserRequest
+
+// CompleteMFAJSONRequestBody defines body for CompleteMFA for application/json ContentType.
+type CompleteMFAJSONRequestBody = CompleteMFARequest
+
+// CompletePasswordChangeJSONRequestBody defines body for CompletePasswordChange for application/json ContentType.
+type CompletePasswordChangeJSONRequestBody = CompletePasswordChangeRequest
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionRequest
+
+// LoginWebAuthnJSONRequestBody defines body for LoginWebAuthn for application/json ContentType.
+type LoginWebAuthnJSONRequestBody = LoginWebAuthnRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUserRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
+// ConfirmTOTPJSONRequestBody defines body for ConfirmTOTP for application/json ContentType.
+type ConfirmTOTPJSONRequestBody = ConfirmTOTPRequest
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
+
+// BeginWebAuthnLoginJSONRequestBody defines body for BeginWebAuthnLogin for application/json ContentType.
+type BeginWebAuthnLoginJSONRequestBody = BeginWebAuthnLoginRequest
+
+// CreateWebAuthnCredentialJSONRequestBody defines body for CreateWebAuthnCredential for application/json ContentType.
+type CreateWebAuthnCredentialJSONRequestBody = CreateWebAuthnCredentialRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetJWKS request
+	GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthorizeWithBody request with any body
+	AuthorizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Authorize(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ForwardAuth request
+	ForwardAuth(ctx context.Context, params *ForwardAuthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IntrospectTokenWithBody request with any body
+	IntrospectTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	IntrospectTokenWithFormdataBody(ctx context.Context, body IntrospectTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMe request
+	GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMySessions request
+	GetMySessions(ctx context.Context, params *GetMySessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCurrentSession request
+	DeleteCurrentSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMySession request
+	DeleteMySession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChangePassWithBody request with any body
+	ChangePassWithBody(ctx context.Context, login string, params *ChangePassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePass(ctx context.Context, login string, params *ChangePassParams, body ChangePassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestPassReset request
+	RequestPassReset(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetPassWithBody request with any body
+	ResetPassWithBody(ctx context.Context, login string, params *ResetPassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResetPass(ctx context.Context, login string, params *ResetPassParams, body ResetPassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompletePassResetWithBody request with any body
+	CompletePassResetWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompletePassReset(ctx context.Context, login string, body CompletePassResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPrivileges request
+	GetPrivileges(ctx context.Context, params *GetPrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoles request
+	GetRoles(ctx context.Context, params *GetRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRoleWithBody request with any body
+	CreateRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRole(ctx context.Context, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRole request
+	DeleteRole(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRole request
+	GetRole(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRoleWithBody request with any body
+	UpdateRoleWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRole(ctx context.Context, code string, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRolePrivileges request
+	GetRolePrivileges(ctx context.Context, code string, params *GetRolePrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRolePrivilege request
+	DeleteRolePrivilege(ctx context.Context, code string, privilegeCode string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddRolePrivilegeWithBody request with any body
+	AddRolePrivilegeWithBody(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddRolePrivilege(ctx context.Context, code string, privilegeCode string, body AddRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRolePrivilegeWithBody request with any body
+	UpdateRolePrivilegeWithBody(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRolePrivilege(ctx context.Context, code string, privilegeCode string, body UpdateRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoleUsers request
+	GetRoleUsers(ctx context.Context, code string, params *GetRoleUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRoleUser request
+	DeleteRoleUser(ctx context.Context, code string, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddRoleUserWithBody request with any body
+	AddRoleUserWithBody(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddRoleUser(ctx context.Context, code string, login string, body AddRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRoleUserWithBody request with any body
+	UpdateRoleUserWithBody(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRoleUser(ctx context.Context, code string, login string, body UpdateRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteMFAWithBody request with any body
+	CompleteMFAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompleteMFA(ctx context.Context, body CompleteMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompletePasswordChangeWithBody request with any body
+	CompletePasswordChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompletePasswordChange(ctx context.Context, body CompletePasswordChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshSessionWithBody request with any body
+	RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWebAuthnWithBody request with any body
+	LoginWebAuthnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginWebAuthn(ctx context.Context, body LoginWebAuthnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSigningKeys request
+	GetSigningKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GenerateSigningKey request
+	GenerateSigningKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateSigningKey request
+	RotateSigningKey(ctx context.Context, kid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsers request
+	GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserWithBody request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUser request
+	DeleteUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserWithBody request with any body
+	UpdateUserWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUser(ctx context.Context, login string, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockUser request
+	UnlockUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTOTP request
+	DisableTOTP(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollTOTP request
+	EnrollTOTP(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmTOTPWithBody request with any body
+	ConfirmTOTPWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmTOTP(ctx context.Context, login string, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserPrivileges request
+	GetUserPrivileges(ctx context.Context, login string, params *GetUserPrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserRoles request
+	GetUserRoles(ctx context.Context, login string, params *GetUserRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSessions request
+	DeleteUserSessions(ctx context.Context, login string, params *DeleteUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSessions request
+	GetUserSessions(ctx context.Context, login string, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, login string, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSession request
+	DeleteUserSession(ctx context.Context, login string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BeginWebAuthnLoginWithBody request with any body
+	BeginWebAuthnLoginWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BeginWebAuthnLogin(ctx context.Context, login string, body BeginWebAuthnLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebAuthnCredentials request
+	GetWebAuthnCredentials(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebAuthnCredentialWithBody request with any body
+	CreateWebAuthnCredentialWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebAuthnCredential(ctx context.Context, login string, body CreateWebAuthnCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebAuthnCredential request
+	DeleteWebAuthnCredential(ctx context.Context, login string, credentialId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BeginWebAuthnRegistration request
+	BeginWebAuthnRegistration(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJWKSRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthorizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Authorize(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ForwardAuth(ctx context.Context, params *ForwardAuthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForwardAuthRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IntrospectTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIntrospectTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IntrospectTokenWithFormdataBody(ctx context.Context, body IntrospectTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIntrospectTokenRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMySessions(ctx context.Context, params *GetMySessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMySessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCurrentSession(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCurrentSessionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMySession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMySessionRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePassWithBody(ctx context.Context, login string, params *ChangePassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePassRequestWithBody(c.Server, login, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ChangePass(ctx context.Context, login string, params *ChangePassParams, body ChangePassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePassRequest(c.Server, login, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RequestPassReset(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestPassResetRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPassWithBody(ctx context.Context, login string, params *ResetPassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPassRequestWithBody(c.Server, login, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResetPass(ctx context.Context, login string, params *ResetPassParams, body ResetPassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetPassRequest(c.Server, login, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePassResetWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePassResetRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePassReset(ctx context.Context, login string, body CompletePassResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePassResetRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPrivileges(ctx context.Context, params *GetPrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPrivilegesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoles(ctx context.Context, params *GetRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRolesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRole(ctx context.Context, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRole(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleRequest(c.Server, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRole(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleRequest(c.Server, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequestWithBody(c.Server, code, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRole(ctx context.Context, code string, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequest(c.Server, code, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRolePrivileges(ctx context.Context, code string, params *GetRolePrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRolePrivilegesRequest(c.Server, code, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRolePrivilege(ctx context.Context, code string, privilegeCode string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRolePrivilegeRequest(c.Server, code, privilegeCode)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddRolePrivilegeWithBody(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddRolePrivilegeRequestWithBody(c.Server, code, privilegeCode, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddRolePrivilege(ctx context.Context, code string, privilegeCode string, body AddRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddRolePrivilegeRequest(c.Server, code, privilegeCode, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRolePrivilegeWithBody(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRolePrivilegeRequestWithBody(c.Server, code, privilegeCode, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRolePrivilege(ctx context.Context, code string, privilegeCode string, body UpdateRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRolePrivilegeRequest(c.Server, code, privilegeCode, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoleUsers(ctx context.Context, code string, params *GetRoleUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleUsersRequest(c.Server, code, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRoleUser(ctx context.Context, code string, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleUserRequest(c.Server, code, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddRoleUserWithBody(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddRoleUserRequestWithBody(c.Server, code, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddRoleUser(ctx context.Context, code string, login string, body AddRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddRoleUserRequest(c.Server, code, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleUserWithBody(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleUserRequestWithBody(c.Server, code, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleUser(ctx context.Context, code string, login string, body UpdateRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleUserRequest(c.Server, code, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteMFAWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteMFARequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteMFA(ctx context.Context, body CompleteMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteMFARequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePasswordChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePasswordChangeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePasswordChange(ctx context.Context, body CompletePasswordChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePasswordChangeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWebAuthnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginWebAuthnRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWebAuthn(ctx context.Context, body LoginWebAuthnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginWebAuthnRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSigningKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSigningKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GenerateSigningKey(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateSigningKeyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateSigningKey(ctx context.Context, kid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateSigningKeyRequest(c.Server, kid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, login string, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnlockUser(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockUserRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisableTOTP(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnrollTOTP(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollTOTPRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTOTPWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmTOTP(ctx context.Context, login string, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmTOTPRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserPrivileges(ctx context.Context, login string, params *GetUserPrivilegesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserPrivilegesRequest(c.Server, login, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserRoles(ctx context.Context, login string, params *GetUserRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRolesRequest(c.Server, login, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUserSessions(ctx context.Context, login string, params *DeleteUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserSessionsRequest(c.Server, login, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserSessions(ctx context.Context, login string, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSessionsRequest(c.Server, login, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, login string, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUserSession(ctx context.Context, login string, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserSessionRequest(c.Server, login, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BeginWebAuthnLoginWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBeginWebAuthnLoginRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BeginWebAuthnLogin(ctx context.Context, login string, body BeginWebAuthnLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBeginWebAuthnLoginRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebAuthnCredentials(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebAuthnCredentialsRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebAuthnCredentialWithBody(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebAuthnCredentialRequestWithBody(c.Server, login, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebAuthnCredential(ctx context.Context, login string, body CreateWebAuthnCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebAuthnCredentialRequest(c.Server, login, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebAuthnCredential(ctx context.Context, login string, credentialId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebAuthnCredentialRequest(c.Server, login, credentialId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BeginWebAuthnRegistration(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBeginWebAuthnRegistrationRequest(c.Server, login)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetJWKSRequest generates requests for GetJWKS
+func NewGetJWKSRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthorizeRequest calls the generic Authorize builder with application/json body
+func NewAuthorizeRequest(server string, body AuthorizeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthorizeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthorizeRequestWithBody generates requests for Authorize with any type of body
+func NewAuthorizeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewForwardAuthRequest generates requests for ForwardAuth
+func NewForwardAuthRequest(server string, params *ForwardAuthParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/forward-auth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Upstream != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "upstream", runtime.ParamLocationQuery, *params.Upstream); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XForwardedMethod != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Forwarded-Method", runtime.ParamLocationHeader, *params.XForwardedMethod)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Forwarded-Method", headerParam0)
+		}
+
+		if params.XForwardedUri != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Forwarded-Uri", runtime.ParamLocationHeader, *params.XForwardedUri)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Forwarded-Uri", headerParam1)
+		}
+
+		if params.XForwardedHost != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-Forwarded-Host", runtime.ParamLocationHeader, *params.XForwardedHost)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Forwarded-Host", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewIntrospectTokenRequestWithFormdataBody calls the generic IntrospectToken builder with application/x-www-form-urlencoded body
+func NewIntrospectTokenRequestWithFormdataBody(server string, body IntrospectTokenFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewIntrospectTokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewIntrospectTokenRequestWithBody generates requests for IntrospectToken with any type of body
+func NewIntrospectTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/introspect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeRequest generates requests for GetMe
+func NewGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMySessionsRequest generates requests for GetMySessions
+func NewGetMySessionsRequest(server string, params *GetMySessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCurrentSessionRequest generates requests for DeleteCurrentSession
+func NewDeleteCurrentSessionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/me/sessions/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMySessionRequest generates requests for DeleteMySession
+func NewDeleteMySessionRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChangePassRequest calls the generic ChangePass builder with application/json body
+func NewChangePassRequest(server string, login string, params *ChangePassParams, body ChangePassJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePassRequestWithBody(server, login, params, "application/json", bodyReader)
+}
+
+// NewChangePassRequestWithBody generates requests for ChangePass with any type of body
+func NewChangePassRequestWithBody(server string, login string, params *ChangePassParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/passchanges/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeepCurrent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keep_current", runtime.ParamLocationQuery, *params.KeepCurrent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRequestPassResetRequest generates requests for RequestPassReset
+func NewRequestPassResetRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/passresets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResetPassRequest calls the generic ResetPass builder with application/json body
+func NewResetPassRequest(server string, login string, params *ResetPassParams, body ResetPassJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResetPassRequestWithBody(server, login, params, "application/json", bodyReader)
+}
+
+// NewResetPassRequestWithBody generates requests for ResetPass with any type of body
+func NewResetPassRequestWithBody(server string, login string, params *ResetPassParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/passresets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeepCurrent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keep_current", runtime.ParamLocationQuery, *params.KeepCurrent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompletePassResetRequest calls the generic CompletePassReset builder with application/json body
+func NewCompletePassResetRequest(server string, login string, body CompletePassResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompletePassResetRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewCompletePassResetRequestWithBody generates requests for CompletePassReset with any type of body
+func NewCompletePassResetRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/passresets/%s/confirmation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPrivilegesRequest generates requests for GetPrivileges
+func NewGetPrivilegesRequest(server string, params *GetPrivilegesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/privileges")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRolesRequest generates requests for GetRoles
+func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
+func NewCreateRoleRequest(server string, body CreateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
+func NewCreateRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRoleRequest generates requests for DeleteRole
+func NewDeleteRoleRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRoleRequest generates requests for GetRole
+func NewGetRoleRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
+func NewUpdateRoleRequest(server string, code string, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleRequestWithBody(server, code, "application/json", bodyReader)
+}
+
+// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
+func NewUpdateRoleRequestWithBody(server string, code string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRolePrivilegesRequest generates requests for GetRolePrivileges
+func NewGetRolePrivilegesRequest(server string, code string, params *GetRolePrivilegesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/privileges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteRolePrivilegeRequest generates requests for DeleteRolePrivilege
+func NewDeleteRolePrivilegeRequest(server string, code string, privilegeCode string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "privilege_code", runtime.ParamLocationPath, privilegeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/privileges/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddRolePrivilegeRequest calls the generic AddRolePrivilege builder with application/json body
+func NewAddRolePrivilegeRequest(server string, code string, privilegeCode string, body AddRolePrivilegeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddRolePrivilegeRequestWithBody(server, code, privilegeCode, "application/json", bodyReader)
+}
+
+// NewAddRolePrivilegeRequestWithBody generates requests for AddRolePrivilege with any type of body
+func NewAddRolePrivilegeRequestWithBody(server string, code string, privilegeCode string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "privilege_code", runtime.ParamLocationPath, privilegeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/privileges/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateRolePrivilegeRequest calls the generic UpdateRolePrivilege builder with application/json body
+func NewUpdateRolePrivilegeRequest(server string, code string, privilegeCode string, body UpdateRolePrivilegeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRolePrivilegeRequestWithBody(server, code, privilegeCode, "application/json", bodyReader)
+}
+
+// NewUpdateRolePrivilegeRequestWithBody generates requests for UpdateRolePrivilege with any type of body
+func NewUpdateRolePrivilegeRequestWithBody(server string, code string, privilegeCode string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "privilege_code", runtime.ParamLocationPath, privilegeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/privileges/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRoleUsersRequest generates requests for GetRoleUsers
+func NewGetRoleUsersRequest(server string, code string, params *GetRoleUsersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/users", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteRoleUserRequest generates requests for DeleteRoleUser
+func NewDeleteRoleUserRequest(server string, code string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddRoleUserRequest calls the generic AddRoleUser builder with application/json body
+func NewAddRoleUserRequest(server string, code string, login string, body AddRoleUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddRoleUserRequestWithBody(server, code, login, "application/json", bodyReader)
+}
+
+// NewAddRoleUserRequestWithBody generates requests for AddRoleUser with any type of body
+func NewAddRoleUserRequestWithBody(server string, code string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateRoleUserRequest calls the generic UpdateRoleUser builder with application/json body
+func NewUpdateRoleUserRequest(server string, code string, login string, body UpdateRoleUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleUserRequestWithBody(server, code, login, "application/json", bodyReader)
+}
+
+// NewUpdateRoleUserRequestWithBody generates requests for UpdateRoleUser with any type of body
+func NewUpdateRoleUserRequestWithBody(server string, code string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/roles/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompleteMFARequest calls the generic CompleteMFA builder with application/json body
+func NewCompleteMFARequest(server string, body CompleteMFAJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompleteMFARequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCompleteMFARequestWithBody generates requests for CompleteMFA with any type of body
+func NewCompleteMFARequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompletePasswordChangeRequest calls the generic CompletePasswordChange builder with application/json body
+func NewCompletePasswordChangeRequest(server string, body CompletePasswordChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompletePasswordChangeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCompletePasswordChangeRequestWithBody generates requests for CompletePasswordChange with any type of body
+func NewCompletePasswordChangeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshSessionRequest calls the generic RefreshSession builder with application/json body
+func NewRefreshSessionRequest(server string, body RefreshSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshSessionRequestWithBody generates requests for RefreshSession with any type of body
+func NewRefreshSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginWebAuthnRequest calls the generic LoginWebAuthn builder with application/json body
+func NewLoginWebAuthnRequest(server string, body LoginWebAuthnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginWebAuthnRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginWebAuthnRequestWithBody generates requests for LoginWebAuthn with any type of body
+func NewLoginWebAuthnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions/webauthn")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSigningKeysRequest generates requests for GetSigningKeys
+func NewGetSigningKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/signing-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGenerateSigningKeyRequest generates requests for GenerateSigningKey
+func NewGenerateSigningKeyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/signing-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateSigningKeyRequest generates requests for RotateSigningKey
+func NewRotateSigningKeyRequest(server string, kid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kid", runtime.ParamLocationPath, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/signing-keys/%s/activation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string, params *GetUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
+func NewUpdateUserRequest(server string, login string, body UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewUpdateUserRequestWithBody generates requests for UpdateUser with any type of body
+func NewUpdateUserRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnlockUserRequest generates requests for UnlockUser
+func NewUnlockUserRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/lockout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisableTOTPRequest generates requests for DisableTOTP
+func NewDisableTOTPRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/mfa/totp", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnrollTOTPRequest generates requests for EnrollTOTP
+func NewEnrollTOTPRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/mfa/totp", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmTOTPRequest calls the generic ConfirmTOTP builder with application/json body
+func NewConfirmTOTPRequest(server string, login string, body ConfirmTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmTOTPRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewConfirmTOTPRequestWithBody generates requests for ConfirmTOTP with any type of body
+func NewConfirmTOTPRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/mfa/totp/confirmation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserPrivilegesRequest generates requests for GetUserPrivileges
+func NewGetUserPrivilegesRequest(server string, login string, params *GetUserPrivilegesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/privileges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserRolesRequest generates requests for GetUserRoles
+func NewGetUserRolesRequest(server string, login string, params *GetUserRolesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserSessionsRequest generates requests for DeleteUserSessions
+func NewDeleteUserSessionsRequest(server string, login string, params *DeleteUserSessionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeepCurrent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keep_current", runtime.ParamLocationQuery, *params.KeepCurrent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserSessionsRequest generates requests for GetUserSessions
+func NewGetUserSessionsRequest(server string, login string, params *GetUserSessionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, login string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserSessionRequest generates requests for DeleteUserSession
+func NewDeleteUserSessionRequest(server string, login string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/sessions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBeginWebAuthnLoginRequest calls the generic BeginWebAuthnLogin builder with application/json body
+func NewBeginWebAuthnLoginRequest(server string, login string, body BeginWebAuthnLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBeginWebAuthnLoginRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewBeginWebAuthnLoginRequestWithBody generates requests for BeginWebAuthnLogin with any type of body
+func NewBeginWebAuthnLoginRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/webauthn/assertions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebAuthnCredentialsRequest generates requests for GetWebAuthnCredentials
+func NewGetWebAuthnCredentialsRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/webauthn/credentials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebAuthnCredentialRequest calls the generic CreateWebAuthnCredential builder with application/json body
+func NewCreateWebAuthnCredentialRequest(server string, login string, body CreateWebAuthnCredentialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebAuthnCredentialRequestWithBody(server, login, "application/json", bodyReader)
+}
+
+// NewCreateWebAuthnCredentialRequestWithBody generates requests for CreateWebAuthnCredential with any type of body
+func NewCreateWebAuthnCredentialRequestWithBody(server string, login string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/webauthn/credentials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebAuthnCredentialRequest generates requests for DeleteWebAuthnCredential
+func NewDeleteWebAuthnCredentialRequest(server string, login string, credentialId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "credential_id", runtime.ParamLocationPath, credentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/webauthn/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBeginWebAuthnRegistrationRequest generates requests for BeginWebAuthnRegistration
+func NewBeginWebAuthnRegistrationRequest(server string, login string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "login", runtime.ParamLocationPath, login)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/webauthn/registrations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJWKSWithResponse request
+	GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error)
+
+	// AuthorizeWithBodyWithResponse request with any body
+	AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+
+	AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+
+	// ForwardAuthWithResponse request
+	ForwardAuthWithResponse(ctx context.Context, params *ForwardAuthParams, reqEditors ...RequestEditorFn) (*ForwardAuthResponse, error)
+
+	// IntrospectTokenWithBodyWithResponse request with any body
+	IntrospectTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IntrospectTokenResponse, error)
+
+	IntrospectTokenWithFormdataBodyWithResponse(ctx context.Context, body IntrospectTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*IntrospectTokenResponse, error)
+
+	// GetMeWithResponse request
+	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+
+	// GetMySessionsWithResponse request
+	GetMySessionsWithResponse(ctx context.Context, params *GetMySessionsParams, reqEditors ...RequestEditorFn) (*GetMySessionsResponse, error)
+
+	// DeleteCurrentSessionWithResponse request
+	DeleteCurrentSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentSessionResponse, error)
+
+	// DeleteMySessionWithResponse request
+	DeleteMySessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*DeleteMySessionResponse, error)
+
+	// ChangePassWithBodyWithResponse request with any body
+	ChangePassWithBodyWithResponse(ctx context.Context, login string, params *ChangePassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePassResponse, error)
+
+	ChangePassWithResponse(ctx context.Context, login string, params *ChangePassParams, body ChangePassJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePassResponse, error)
+
+	// RequestPassResetWithResponse request
+	RequestPassResetWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*RequestPassResetResponse, error)
+
+	// ResetPassWithBodyWithResponse request with any body
+	ResetPassWithBodyWithResponse(ctx context.Context, login string, params *ResetPassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPassResponse, error)
+
+	ResetPassWithResponse(ctx context.Context, login string, params *ResetPassParams, body ResetPassJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPassResponse, error)
+
+	// CompletePassResetWithBodyWithResponse request with any body
+	CompletePassResetWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePassResetResponse, error)
+
+	CompletePassResetWithResponse(ctx context.Context, login string, body CompletePassResetJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePassResetResponse, error)
+
+	// GetPrivilegesWithResponse request
+	GetPrivilegesWithResponse(ctx context.Context, params *GetPrivilegesParams, reqEditors ...RequestEditorFn) (*GetPrivilegesResponse, error)
+
+	// GetRolesWithResponse request
+	GetRolesWithResponse(ctx context.Context, params *GetRolesParams, reqEditors ...RequestEditorFn) (*GetRolesResponse, error)
+
+	// CreateRoleWithBodyWithResponse request with any body
+	CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	// DeleteRoleWithResponse request
+	DeleteRoleWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error)
+
+	// GetRoleWithResponse request
+	GetRoleWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetRoleResponse, error)
+
+	// UpdateRoleWithBodyWithResponse request with any body
+	UpdateRoleWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	UpdateRoleWithResponse(ctx context.Context, code string, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	// GetRolePrivilegesWithResponse request
+	GetRolePrivilegesWithResponse(ctx context.Context, code string, params *GetRolePrivilegesParams, reqEditors ...RequestEditorFn) (*GetRolePrivilegesResponse, error)
+
+	// DeleteRolePrivilegeWithResponse request
+	DeleteRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, reqEditors ...RequestEditorFn) (*DeleteRolePrivilegeResponse, error)
+
+	// AddRolePrivilegeWithBodyWithResponse request with any body
+	AddRolePrivilegeWithBodyWithResponse(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRolePrivilegeResponse, error)
+
+	AddRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, body AddRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRolePrivilegeResponse, error)
+
+	// UpdateRolePrivilegeWithBodyWithResponse request with any body
+	UpdateRolePrivilegeWithBodyWithResponse(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRolePrivilegeResponse, error)
+
+	UpdateRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, body UpdateRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRolePrivilegeResponse, error)
+
+	// GetRoleUsersWithResponse request
+	GetRoleUsersWithResponse(ctx context.Context, code string, params *GetRoleUsersParams, reqEditors ...RequestEditorFn) (*GetRoleUsersResponse, error)
+
+	// DeleteRoleUserWithResponse request
+	DeleteRoleUserWithResponse(ctx context.Context, code string, login string, reqEditors ...RequestEditorFn) (*DeleteRoleUserResponse, error)
+
+	// AddRoleUserWithBodyWithResponse request with any body
+	AddRoleUserWithBodyWithResponse(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRoleUserResponse, error)
+
+	AddRoleUserWithResponse(ctx context.Context, code string, login string, body AddRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRoleUserResponse, error)
+
+	// UpdateRoleUserWithBodyWithResponse request with any body
+	UpdateRoleUserWithBodyWithResponse(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleUserResponse, error)
+
+	UpdateRoleUserWithResponse(ctx context.Context, code string, login string, body UpdateRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleUserResponse, error)
+
+	// CompleteMFAWithBodyWithResponse request with any body
+	CompleteMFAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteMFAResponse, error)
+
+	CompleteMFAWithResponse(ctx context.Context, body CompleteMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteMFAResponse, error)
+
+	// CompletePasswordChangeWithBodyWithResponse request with any body
+	CompletePasswordChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePasswordChangeResponse, error)
+
+	CompletePasswordChangeWithResponse(ctx context.Context, body CompletePasswordChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePasswordChangeResponse, error)
+
+	// RefreshSessionWithBodyWithResponse request with any body
+	RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	// LoginWebAuthnWithBodyWithResponse request with any body
+	LoginWebAuthnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginWebAuthnResponse, error)
+
+	LoginWebAuthnWithResponse(ctx context.Context, body LoginWebAuthnJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginWebAuthnResponse, error)
+
+	// GetSigningKeysWithResponse request
+	GetSigningKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSigningKeysResponse, error)
+
+	// GenerateSigningKeyWithResponse request
+	GenerateSigningKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GenerateSigningKeyResponse, error)
+
+	// RotateSigningKeyWithResponse request
+	RotateSigningKeyWithResponse(ctx context.Context, kid string, reqEditors ...RequestEditorFn) (*RotateSigningKeyResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// DeleteUserWithResponse request
+	DeleteUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+
+	// UpdateUserWithBodyWithResponse request with any body
+	UpdateUserWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	UpdateUserWithResponse(ctx context.Context, login string, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error)
+
+	// UnlockUserWithResponse request
+	UnlockUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*UnlockUserResponse, error)
+
+	// DisableTOTPWithResponse request
+	DisableTOTPWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	// EnrollTOTPWithResponse request
+	EnrollTOTPWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error)
+
+	// ConfirmTOTPWithBodyWithResponse request with any body
+	ConfirmTOTPWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	ConfirmTOTPWithResponse(ctx context.Context, login string, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error)
+
+	// GetUserPrivilegesWithResponse request
+	GetUserPrivilegesWithResponse(ctx context.Context, login string, params *GetUserPrivilegesParams, reqEditors ...RequestEditorFn) (*GetUserPrivilegesResponse, error)
+
+	// GetUserRolesWithResponse request
+	GetUserRolesWithResponse(ctx context.Context, login string, params *GetUserRolesParams, reqEditors ...RequestEditorFn) (*GetUserRolesResponse, error)
+
+	// DeleteUserSessionsWithResponse request
+	DeleteUserSessionsWithResponse(ctx context.Context, login string, params *DeleteUserSessionsParams, reqEditors ...RequestEditorFn) (*DeleteUserSessionsResponse, error)
+
+	// GetUserSessionsWithResponse request
+	GetUserSessionsWithResponse(ctx context.Context, login string, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*GetUserSessionsResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, login string, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// DeleteUserSessionWithResponse request
+	DeleteUserSessionWithResponse(ctx context.Context, login string, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUserSessionResponse, error)
+
+	// BeginWebAuthnLoginWithBodyWithResponse request with any body
+	BeginWebAuthnLoginWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BeginWebAuthnLoginResponse, error)
+
+	BeginWebAuthnLoginWithResponse(ctx context.Context, login string, body BeginWebAuthnLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*BeginWebAuthnLoginResponse, error)
+
+	// GetWebAuthnCredentialsWithResponse request
+	GetWebAuthnCredentialsWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*GetWebAuthnCredentialsResponse, error)
+
+	// CreateWebAuthnCredentialWithBodyWithResponse request with any body
+	CreateWebAuthnCredentialWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebAuthnCredentialResponse, error)
+
+	CreateWebAuthnCredentialWithResponse(ctx context.Context, login string, body CreateWebAuthnCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebAuthnCredentialResponse, error)
+
+	// DeleteWebAuthnCredentialWithResponse request
+	DeleteWebAuthnCredentialWithResponse(ctx context.Context, login string, credentialId string, reqEditors ...RequestEditorFn) (*DeleteWebAuthnCredentialResponse, error)
+
+	// BeginWebAuthnRegistrationWithResponse request
+	BeginWebAuthnRegistrationWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*BeginWebAuthnRegistrationResponse, error)
+}
+
+type GetJWKSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJWKSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJWKSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizeResponse200
+	JSON500      *AuthorizeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForwardAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ForwardAuthResponse200
+	JSON400      *ForwardAuthResponseError
+	JSON401      *ForwardAuthResponseError
+	JSON403      *ForwardAuthResponseError
+	JSON500      *ForwardAuthResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r ForwardAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForwardAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IntrospectTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IntrospectTokenResponse200
+	JSON500      *IntrospectTokenResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r IntrospectTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IntrospectTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetMeResponse200
+	JSON500      *GetMeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMySessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetMySessionsResponse200
+	JSON500      *GetMySessionsResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMySessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMySessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCurrentSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteCurrentSessionResponse200
+	JSON500      *DeleteCurrentSessionResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCurrentSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCurrentSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMySessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteMySessionResponse200
+	JSON404      *NotFound
+	JSON500      *DeleteMySessionResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMySessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMySessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePassResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChangePassResponse200
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON422      *ChangePassResponse422
+	JSON500      *ChangePassResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePassResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePassResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestPassResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RequestPassResetResponse200
+	JSON500      *RequestPassResetResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestPassResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestPassResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetPassResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResetPassResponse200
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON422      *ResetPassResponse422
+	JSON500      *ResetPassResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetPassResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetPassResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompletePassResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletePassResetResponse200
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON422      *CompletePassResetResponse422
+	JSON500      *CompletePassResetResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CompletePassResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompletePassResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPrivilegesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetPrivilegesResponse200
+	JSON500      *GetPrivilegesResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRolesResponse200
+	JSON500      *GetRolesResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateRoleResponse200
+	JSON409      *Conflict
+	JSON500      *CreateRoleResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteRoleResponse200
+	JSON404      *NotFound
+	JSON500      *DeleteRoleResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRoleResponse200
+	JSON404      *NotFound
+	JSON500      *GetRoleResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateRoleResponse200
+	JSON404      *NotFound
+	JSON500      *UpdateRoleResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRolePrivilegesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRolePrivilegesResponse200
+	JSON500      *GetRolePrivilegesResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRolePrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRolePrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRolePrivilegeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteRolePrivilegeResponse200
+	JSON404      *NotFound
+	JSON500      *DeleteRolePrivilegeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRolePrivilegeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRolePrivilegeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddRolePrivilegeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AddRolePrivilegeResponse200
+	JSON404      *NotFound
+	JSON500      *AddRolePrivilegeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r AddRolePrivilegeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddRolePrivilegeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRolePrivilegeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateRolePrivilegeResponse200
+	JSON404      *NotFound
+	JSON500      *UpdateRolePrivilegeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRolePrivilegeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRolePrivilegeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoleUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRoleUsersResponse200
+	JSON500      *GetRoleUsersResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoleUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoleUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRoleUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteRoleUserResponse200
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *DeleteRoleUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRoleUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRoleUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddRoleUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AddRoleUserResponse200
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *AddRoleUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r AddRoleUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddRoleUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRoleUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateRoleUserResponse200
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON500      *UpdateRoleUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRoleUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRoleUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteMFAResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompleteMFAResponse200
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *CompleteMFAResponse403
+	JSON423      *CompleteMFAResponse423
+	JSON429      *CompleteMFAResponse429
+	JSON500      *CompleteMFAResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteMFAResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteMFAResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompletePasswordChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletePasswordChangeResponse200
+	JSON400      *BadRequest
+	JSON422      *CompletePasswordChangeResponse422
+	JSON500      *CompletePasswordChangeResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CompletePasswordChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompletePasswordChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RefreshSessionResponse200
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *RefreshSessionResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginWebAuthnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginWebAuthnResponse200
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *LoginWebAuthnResponse403
+	JSON423      *LoginWebAuthnResponse423
+	JSON429      *LoginWebAuthnResponse429
+	JSON500      *LoginWebAuthnResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginWebAuthnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginWebAuthnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSigningKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSigningKeysResponse200
+	JSON500      *GetSigningKeysResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSigningKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSigningKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GenerateSigningKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GenerateSigningKeyResponse200
+	JSON500      *GenerateSigningKeyResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GenerateSigningKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GenerateSigningKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateSigningKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RotateSigningKeyResponse200
+	JSON404      *NotFound
+	JSON500      *RotateSigningKeyResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateSigningKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateSigningKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUsersResponse200
+	JSON500      *GetUsersResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateUserResponse200
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON422      *CreateUserResponse422
+	JSON500      *CreateUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteUserResponse200
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *DeleteUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserResponse200
+	JSON404      *NotFound
+	JSON500      *GetUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateUserResponse200
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *UpdateUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnlockUserResponse200
+	JSON500      *UnlockUserResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DisableTOTPResponse200
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *DisableTOTPResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnrollTOTPResponse200
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *EnrollTOTPResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfirmTOTPResponse200
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON500      *ConfirmTOTPResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPrivilegesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserPrivilegesResponse200
+	JSON500      *GetUserPrivilegesResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserRolesResponse200
+	JSON500      *GetUserRolesResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteUserSessionsResponse200
+	JSON500      *DeleteUserSessionsResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserSessionsResponse200
+	JSON500      *GetUserSessionsResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse200
+	JSON202      *LoginResponse202
+	JSON401      *Unauthorized
+	JSON403      *LoginResponse403
+	JSON423      *LoginResponse423
+	JSON429      *LoginResponse429
+	JSON500      *LoginResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteUserSessionResponse200
+	JSON404      *NotFound
+	JSON500      *DeleteUserSessionResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BeginWebAuthnLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeginWebAuthnLoginResponse200
+	JSON404      *NotFound
+	JSON500      *BeginWebAuthnLoginResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r BeginWebAuthnLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BeginWebAuthnLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebAuthnCredentialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetWebAuthnCredentialsResponse200
+	JSON404      *NotFound
+	JSON500      *GetWebAuthnCredentialsResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebAuthnCredentialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebAuthnCredentialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebAuthnCredentialResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateWebAuthnCredentialResponse200
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON409      *Conflict
+	JSON500      *CreateWebAuthnCredentialResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebAuthnCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebAuthnCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebAuthnCredentialResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteWebAuthnCredentialResponse200
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *DeleteWebAuthnCredentialResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebAuthnCredentialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebAuthnCredentialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BeginWebAuthnRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeginWebAuthnRegistrationResponse200
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON500      *BeginWebAuthnRegistrationResponse500
+}
+
+// Status returns HTTPResponse.Status
+func (r BeginWebAuthnRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BeginWebAuthnRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJWKSWithResponse request returning *GetJWKSResponse
+func (c *ClientWithResponses) GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error) {
+	rsp, err := c.GetJWKS(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJWKSResponse(rsp)
+}
+
+// AuthorizeWithBodyWithResponse request with arbitrary body returning *AuthorizeResponse
+func (c *ClientWithResponses) AuthorizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
+	rsp, err := c.AuthorizeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthorizeWithResponse(ctx context.Context, body AuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
+	rsp, err := c.Authorize(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeResponse(rsp)
+}
+
+// ForwardAuthWithResponse request returning *ForwardAuthResponse
+func (c *ClientWithResponses) ForwardAuthWithResponse(ctx context.Context, params *ForwardAuthParams, reqEditors ...RequestEditorFn) (*ForwardAuthResponse, error) {
+	rsp, err := c.ForwardAuth(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForwardAuthResponse(rsp)
+}
+
+// IntrospectTokenWithBodyWithResponse request with arbitrary body returning *IntrospectTokenResponse
+func (c *ClientWithResponses) IntrospectTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IntrospectTokenResponse, error) {
+	rsp, err := c.IntrospectTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIntrospectTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) IntrospectTokenWithFormdataBodyWithResponse(ctx context.Context, body IntrospectTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*IntrospectTokenResponse, error) {
+	rsp, err := c.IntrospectTokenWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIntrospectTokenResponse(rsp)
+}
+
+// GetMeWithResponse request returning *GetMeResponse
+func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
+	rsp, err := c.GetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeResponse(rsp)
+}
+
+// GetMySessionsWithResponse request returning *GetMySessionsResponse
+func (c *ClientWithResponses) GetMySessionsWithResponse(ctx context.Context, params *GetMySessionsParams, reqEditors ...RequestEditorFn) (*GetMySessionsResponse, error) {
+	rsp, err := c.GetMySessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMySessionsResponse(rsp)
+}
+
+// DeleteCurrentSessionWithResponse request returning *DeleteCurrentSessionResponse
+func (c *ClientWithResponses) DeleteCurrentSessionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCurrentSessionResponse, error) {
+	rsp, err := c.DeleteCurrentSession(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCurrentSessionResponse(rsp)
+}
+
+// DeleteMySessionWithResponse request returning *DeleteMySessionResponse
+func (c *ClientWithResponses) DeleteMySessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*DeleteMySessionResponse, error) {
+	rsp, err := c.DeleteMySession(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMySessionResponse(rsp)
+}
+
+// ChangePassWithBodyWithResponse request with arbitrary body returning *ChangePassResponse
+func (c *ClientWithResponses) ChangePassWithBodyWithResponse(ctx context.Context, login string, params *ChangePassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePassResponse, error) {
+	rsp, err := c.ChangePassWithBody(ctx, login, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePassResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePassWithResponse(ctx context.Context, login string, params *ChangePassParams, body ChangePassJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePassResponse, error) {
+	rsp, err := c.ChangePass(ctx, login, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePassResponse(rsp)
+}
+
+// RequestPassResetWithResponse request returning *RequestPassResetResponse
+func (c *ClientWithResponses) RequestPassResetWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*RequestPassResetResponse, error) {
+	rsp, err := c.RequestPassReset(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestPassResetResponse(rsp)
+}
+
+// ResetPassWithBodyWithResponse request with arbitrary body returning *ResetPassResponse
+func (c *ClientWithResponses) ResetPassWithBodyWithResponse(ctx context.Context, login string, params *ResetPassParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetPassResponse, error) {
+	rsp, err := c.ResetPassWithBody(ctx, login, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPassResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetPassWithResponse(ctx context.Context, login string, params *ResetPassParams, body ResetPassJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetPassResponse, error) {
+	rsp, err := c.ResetPass(ctx, login, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetPassResponse(rsp)
+}
+
+// CompletePassResetWithBodyWithResponse request with arbitrary body returning *CompletePassResetResponse
+func (c *ClientWithResponses) CompletePassResetWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePassResetResponse, error) {
+	rsp, err := c.CompletePassResetWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePassResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompletePassResetWithResponse(ctx context.Context, login string, body CompletePassResetJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePassResetResponse, error) {
+	rsp, err := c.CompletePassReset(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePassResetResponse(rsp)
+}
+
+// GetPrivilegesWithResponse request returning *GetPrivilegesResponse
+func (c *ClientWithResponses) GetPrivilegesWithResponse(ctx context.Context, params *GetPrivilegesParams, reqEditors ...RequestEditorFn) (*GetPrivilegesResponse, error) {
+	rsp, err := c.GetPrivileges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPrivilegesResponse(rsp)
+}
+
+// GetRolesWithResponse request returning *GetRolesResponse
+func (c *ClientWithResponses) GetRolesWithResponse(ctx context.Context, params *GetRolesParams, reqEditors ...RequestEditorFn) (*GetRolesResponse, error) {
+	rsp, err := c.GetRoles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRolesResponse(rsp)
+}
+
+// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
+func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRoleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRole(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleResponse(rsp)
+}
+
+// DeleteRoleWithResponse request returning *DeleteRoleResponse
+func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
+	rsp, err := c.DeleteRole(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRoleResponse(rsp)
+}
+
+// GetRoleWithResponse request returning *GetRoleResponse
+func (c *ClientWithResponses) GetRoleWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
+	rsp, err := c.GetRole(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleResponse(rsp)
+}
+
+// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
+func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRoleWithBody(ctx, code, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, code string, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRole(ctx, code, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleResponse(rsp)
+}
+
+// GetRolePrivilegesWithResponse request returning *GetRolePrivilegesResponse
+func (c *ClientWithResponses) GetRolePrivilegesWithResponse(ctx context.Context, code string, params *GetRolePrivilegesParams, reqEditors ...RequestEditorFn) (*GetRolePrivilegesResponse, error) {
+	rsp, err := c.GetRolePrivileges(ctx, code, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRolePrivilegesResponse(rsp)
+}
+
+// DeleteRolePrivilegeWithResponse request returning *DeleteRolePrivilegeResponse
+func (c *ClientWithResponses) DeleteRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, reqEditors ...RequestEditorFn) (*DeleteRolePrivilegeResponse, error) {
+	rsp, err := c.DeleteRolePrivilege(ctx, code, privilegeCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRolePrivilegeResponse(rsp)
+}
+
+// AddRolePrivilegeWithBodyWithResponse request with arbitrary body returning *AddRolePrivilegeResponse
+func (c *ClientWithResponses) AddRolePrivilegeWithBodyWithResponse(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRolePrivilegeResponse, error) {
+	rsp, err := c.AddRolePrivilegeWithBody(ctx, code, privilegeCode, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddRolePrivilegeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, body AddRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRolePrivilegeResponse, error) {
+	rsp, err := c.AddRolePrivilege(ctx, code, privilegeCode, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddRolePrivilegeResponse(rsp)
+}
+
+// UpdateRolePrivilegeWithBodyWithResponse request with arbitrary body returning *UpdateRolePrivilegeResponse
+func (c *ClientWithResponses) UpdateRolePrivilegeWithBodyWithResponse(ctx context.Context, code string, privilegeCode string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRolePrivilegeResponse, error) {
+	rsp, err := c.UpdateRolePrivilegeWithBody(ctx, code, privilegeCode, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRolePrivilegeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRolePrivilegeWithResponse(ctx context.Context, code string, privilegeCode string, body UpdateRolePrivilegeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRolePrivilegeResponse, error) {
+	rsp, err := c.UpdateRolePrivilege(ctx, code, privilegeCode, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRolePrivilegeResponse(rsp)
+}
+
+// GetRoleUsersWithResponse request returning *GetRoleUsersResponse
+func (c *ClientWithResponses) GetRoleUsersWithResponse(ctx context.Context, code string, params *GetRoleUsersParams, reqEditors ...RequestEditorFn) (*GetRoleUsersResponse, error) {
+	rsp, err := c.GetRoleUsers(ctx, code, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleUsersResponse(rsp)
+}
+
+// DeleteRoleUserWithResponse request returning *DeleteRoleUserResponse
+func (c *ClientWithResponses) DeleteRoleUserWithResponse(ctx context.Context, code string, login string, reqEditors ...RequestEditorFn) (*DeleteRoleUserResponse, error) {
+	rsp, err := c.DeleteRoleUser(ctx, code, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRoleUserResponse(rsp)
+}
+
+// AddRoleUserWithBodyWithResponse request with arbitrary body returning *AddRoleUserResponse
+func (c *ClientWithResponses) AddRoleUserWithBodyWithResponse(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRoleUserResponse, error) {
+	rsp, err := c.AddRoleUserWithBody(ctx, code, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddRoleUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddRoleUserWithResponse(ctx context.Context, code string, login string, body AddRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRoleUserResponse, error) {
+	rsp, err := c.AddRoleUser(ctx, code, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddRoleUserResponse(rsp)
+}
+
+// UpdateRoleUserWithBodyWithResponse request with arbitrary body returning *UpdateRoleUserResponse
+func (c *ClientWithResponses) UpdateRoleUserWithBodyWithResponse(ctx context.Context, code string, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleUserResponse, error) {
+	rsp, err := c.UpdateRoleUserWithBody(ctx, code, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRoleUserWithResponse(ctx context.Context, code string, login string, body UpdateRoleUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleUserResponse, error) {
+	rsp, err := c.UpdateRoleUser(ctx, code, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleUserResponse(rsp)
+}
+
+// CompleteMFAWithBodyWithResponse request with arbitrary body returning *CompleteMFAResponse
+func (c *ClientWithResponses) CompleteMFAWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteMFAResponse, error) {
+	rsp, err := c.CompleteMFAWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteMFAResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompleteMFAWithResponse(ctx context.Context, body CompleteMFAJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteMFAResponse, error) {
+	rsp, err := c.CompleteMFA(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteMFAResponse(rsp)
+}
+
+// CompletePasswordChangeWithBodyWithResponse request with arbitrary body returning *CompletePasswordChangeResponse
+func (c *ClientWithResponses) CompletePasswordChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePasswordChangeResponse, error) {
+	rsp, err := c.CompletePasswordChangeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePasswordChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompletePasswordChangeWithResponse(ctx context.Context, body CompletePasswordChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePasswordChangeResponse, error) {
+	rsp, err := c.CompletePasswordChange(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePasswordChangeResponse(rsp)
+}
+
+// RefreshSessionWithBodyWithResponse request with arbitrary body returning *RefreshSessionResponse
+func (c *ClientWithResponses) RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+// LoginWebAuthnWithBodyWithResponse request with arbitrary body returning *LoginWebAuthnResponse
+func (c *ClientWithResponses) LoginWebAuthnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginWebAuthnResponse, error) {
+	rsp, err := c.LoginWebAuthnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginWebAuthnResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWebAuthnWithResponse(ctx context.Context, body LoginWebAuthnJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginWebAuthnResponse, error) {
+	rsp, err := c.LoginWebAuthn(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginWebAuthnResponse(rsp)
+}
+
+// GetSigningKeysWithResponse request returning *GetSigningKeysResponse
+func (c *ClientWithResponses) GetSigningKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSigningKeysResponse, error) {
+	rsp, err := c.GetSigningKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSigningKeysResponse(rsp)
+}
+
+// GenerateSigningKeyWithResponse request returning *GenerateSigningKeyResponse
+func (c *ClientWithResponses) GenerateSigningKeyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GenerateSigningKeyResponse, error) {
+	rsp, err := c.GenerateSigningKey(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateSigningKeyResponse(rsp)
+}
+
+// RotateSigningKeyWithResponse request returning *RotateSigningKeyResponse
+func (c *ClientWithResponses) RotateSigningKeyWithResponse(ctx context.Context, kid string, reqEditors ...RequestEditorFn) (*RotateSigningKeyResponse, error) {
+	rsp, err := c.RotateSigningKey(ctx, kid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateSigningKeyResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// DeleteUserWithResponse request returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// UpdateUserWithBodyWithResponse request with arbitrary body returning *UpdateUserResponse
+func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUserWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, login string, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUser(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+// UnlockUserWithResponse request returning *UnlockUserResponse
+func (c *ClientWithResponses) UnlockUserWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*UnlockUserResponse, error) {
+	rsp, err := c.UnlockUser(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockUserResponse(rsp)
+}
+
+// DisableTOTPWithResponse request returning *DisableTOTPResponse
+func (c *ClientWithResponses) DisableTOTPWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTP(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+// EnrollTOTPWithResponse request returning *EnrollTOTPResponse
+func (c *ClientWithResponses) EnrollTOTPWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*EnrollTOTPResponse, error) {
+	rsp, err := c.EnrollTOTP(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollTOTPResponse(rsp)
+}
+
+// ConfirmTOTPWithBodyWithResponse request with arbitrary body returning *ConfirmTOTPResponse
+func (c *ClientWithResponses) ConfirmTOTPWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTPWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmTOTPWithResponse(ctx context.Context, login string, body ConfirmTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmTOTPResponse, error) {
+	rsp, err := c.ConfirmTOTP(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmTOTPResponse(rsp)
+}
+
+// GetUserPrivilegesWithResponse request returning *GetUserPrivilegesResponse
+func (c *ClientWithResponses) GetUserPrivilegesWithResponse(ctx context.Context, login string, params *GetUserPrivilegesParams, reqEditors ...RequestEditorFn) (*GetUserPrivilegesResponse, error) {
+	rsp, err := c.GetUserPrivileges(ctx, login, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserPrivilegesResponse(rsp)
+}
+
+// GetUserRolesWithResponse request returning *GetUserRolesResponse
+func (c *ClientWithResponses) GetUserRolesWithResponse(ctx context.Context, login string, params *GetUserRolesParams, reqEditors ...RequestEditorFn) (*GetUserRolesResponse, error) {
+	rsp, err := c.GetUserRoles(ctx, login, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserRolesResponse(rsp)
+}
+
+// DeleteUserSessionsWithResponse request returning *DeleteUserSessionsResponse
+func (c *ClientWithResponses) DeleteUserSessionsWithResponse(ctx context.Context, login string, params *DeleteUserSessionsParams, reqEditors ...RequestEditorFn) (*DeleteUserSessionsResponse, error) {
+	rsp, err := c.DeleteUserSessions(ctx, login, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserSessionsResponse(rsp)
+}
+
+// GetUserSessionsWithResponse request returning *GetUserSessionsResponse
+func (c *ClientWithResponses) GetUserSessionsWithResponse(ctx context.Context, login string, params *GetUserSessionsParams, reqEditors ...RequestEditorFn) (*GetUserSessionsResponse, error) {
+	rsp, err := c.GetUserSessions(ctx, login, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSessionsResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, login string, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// DeleteUserSessionWithResponse request returning *DeleteUserSessionResponse
+func (c *ClientWithResponses) DeleteUserSessionWithResponse(ctx context.Context, login string, sessionId string, reqEditors ...RequestEditorFn) (*DeleteUserSessionResponse, error) {
+	rsp, err := c.DeleteUserSession(ctx, login, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserSessionResponse(rsp)
+}
+
+// BeginWebAuthnLoginWithBodyWithResponse request with arbitrary body returning *BeginWebAuthnLoginResponse
+func (c *ClientWithResponses) BeginWebAuthnLoginWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BeginWebAuthnLoginResponse, error) {
+	rsp, err := c.BeginWebAuthnLoginWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBeginWebAuthnLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) BeginWebAuthnLoginWithResponse(ctx context.Context, login string, body BeginWebAuthnLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*BeginWebAuthnLoginResponse, error) {
+	rsp, err := c.BeginWebAuthnLogin(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBeginWebAuthnLoginResponse(rsp)
+}
+
+// GetWebAuthnCredentialsWithResponse request returning *GetWebAuthnCredentialsResponse
+func (c *ClientWithResponses) GetWebAuthnCredentialsWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*GetWebAuthnCredentialsResponse, error) {
+	rsp, err := c.GetWebAuthnCredentials(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebAuthnCredentialsResponse(rsp)
+}
+
+// CreateWebAuthnCredentialWithBodyWithResponse request with arbitrary body returning *CreateWebAuthnCredentialResponse
+func (c *ClientWithResponses) CreateWebAuthnCredentialWithBodyWithResponse(ctx context.Context, login string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebAuthnCredentialResponse, error) {
+	rsp, err := c.CreateWebAuthnCredentialWithBody(ctx, login, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebAuthnCredentialResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebAuthnCredentialWithResponse(ctx context.Context, login string, body CreateWebAuthnCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebAuthnCredentialResponse, error) {
+	rsp, err := c.CreateWebAuthnCredential(ctx, login, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebAuthnCredentialResponse(rsp)
+}
+
+// DeleteWebAuthnCredentialWithResponse request returning *DeleteWebAuthnCredentialResponse
+func (c *ClientWithResponses) DeleteWebAuthnCredentialWithResponse(ctx context.Context, login string, credentialId string, reqEditors ...RequestEditorFn) (*DeleteWebAuthnCredentialResponse, error) {
+	rsp, err := c.DeleteWebAuthnCredential(ctx, login, credentialId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebAuthnCredentialResponse(rsp)
+}
+
+// BeginWebAuthnRegistrationWithResponse request returning *BeginWebAuthnRegistrationResponse
+func (c *ClientWithResponses) BeginWebAuthnRegistrationWithResponse(ctx context.Context, login string, reqEditors ...RequestEditorFn) (*BeginWebAuthnRegistrationResponse, error) {
+	rsp, err := c.BeginWebAuthnRegistration(ctx, login, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBeginWebAuthnRegistrationResponse(rsp)
+}
+
+// ParseGetJWKSResponse parses an HTTP response from a GetJWKSWithResponse call
+func ParseGetJWKSResponse(rsp *http.Response) (*GetJWKSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJWKSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthorizeResponse parses an HTTP response from a AuthorizeWithResponse call
+func ParseAuthorizeResponse(rsp *http.Response) (*AuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest AuthorizeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseForwardAuthResponse parses an HTTP response from a ForwardAuthWithResponse call
+func ParseForwardAuthResponse(rsp *http.Response) (*ForwardAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ForwardAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ForwardAuthResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ForwardAuthResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ForwardAuthResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ForwardAuthResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ForwardAuthResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseIntrospectTokenResponse parses an HTTP response from a IntrospectTokenWithResponse call
+func ParseIntrospectTokenResponse(rsp *http.Response) (*IntrospectTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IntrospectTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntrospectTokenResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest IntrospectTokenResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetMeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetMeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMySessionsResponse parses an HTTP response from a GetMySessionsWithResponse call
+func ParseGetMySessionsResponse(rsp *http.Response) (*GetMySessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMySessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetMySessionsResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetMySessionsResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCurrentSessionResponse parses an HTTP response from a DeleteCurrentSessionWithResponse call
+func ParseDeleteCurrentSessionResponse(rsp *http.Response) (*DeleteCurrentSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCurrentSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteCurrentSessionResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteCurrentSessionResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMySessionResponse parses an HTTP response from a DeleteMySessionWithResponse call
+func ParseDeleteMySessionResponse(rsp *http.Response) (*DeleteMySessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMySessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteMySessionResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteMySessionResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePassResponse parses an HTTP response from a ChangePassWithResponse call
+func ParseChangePassResponse(rsp *http.Response) (*ChangePassResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePassResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChangePassResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ChangePassResponse422
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ChangePassResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestPassResetResponse parses an HTTP response from a RequestPassResetWithResponse call
+func ParseRequestPassResetResponse(rsp *http.Response) (*RequestPassResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestPassResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RequestPassResetResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest RequestPassResetResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetPassResponse parses an HTTP response from a ResetPassWithResponse call
+func ParseResetPassResponse(rsp *http.Response) (*ResetPassResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetPassResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResetPassResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResetPassResponse422
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResetPassResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompletePassResetResponse parses an HTTP response from a CompletePassResetWithResponse call
+func ParseCompletePassResetResponse(rsp *http.Response) (*CompletePassResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompletePassResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletePassResetResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest CompletePassResetResponse422
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CompletePassResetResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPrivilegesResponse parses an HTTP response from a GetPrivilegesWithResponse call
+func ParseGetPrivilegesResponse(rsp *http.Response) (*GetPrivilegesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPrivilegesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPrivilegesResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetPrivilegesResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRolesResponse parses an HTTP response from a GetRolesWithResponse call
+func ParseGetRolesResponse(rsp *http.Response) (*GetRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRolesResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetRolesResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRoleResponse parses an HTTP response from a CreateRoleWithResponse call
+func ParseCreateRoleResponse(rsp *http.Response) (*CreateRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateRoleResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CreateRoleResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRoleResponse parses an HTTP response from a DeleteRoleWithResponse call
+func ParseDeleteRoleResponse(rsp *http.Response) (*DeleteRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteRoleResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteRoleResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoleResponse parses an HTTP response from a GetRoleWithResponse call
+func ParseGetRoleResponse(rsp *http.Response) (*GetRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRoleResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetRoleResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRoleResponse parses an HTTP response from a UpdateRoleWithResponse call
+func ParseUpdateRoleResponse(rsp *http.Response) (*UpdateRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateRoleResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest UpdateRoleResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRolePrivilegesResponse parses an HTTP response from a GetRolePrivilegesWithResponse call
+func ParseGetRolePrivilegesResponse(rsp *http.Response) (*GetRolePrivilegesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRolePrivilegesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRolePrivilegesResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetRolePrivilegesResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRolePrivilegeResponse parses an HTTP response from a DeleteRolePrivilegeWithResponse call
+func ParseDeleteRolePrivilegeResponse(rsp *http.Response) (*DeleteRolePrivilegeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRolePrivilegeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteRolePrivilegeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteRolePrivilegeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddRolePrivilegeResponse parses an HTTP response from a AddRolePrivilegeWithResponse call
+func ParseAddRolePrivilegeResponse(rsp *http.Response) (*AddRolePrivilegeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddRolePrivilegeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AddRolePrivilegeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest AddRolePrivilegeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRolePrivilegeResponse parses an HTTP response from a UpdateRolePrivilegeWithResponse call
+func ParseUpdateRolePrivilegeResponse(rsp *http.Response) (*UpdateRolePrivilegeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRolePrivilegeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateRolePrivilegeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest UpdateRolePrivilegeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoleUsersResponse parses an HTTP response from a GetRoleUsersWithResponse call
+func ParseGetRoleUsersResponse(rsp *http.Response) (*GetRoleUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoleUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRoleUsersResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetRoleUsersResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRoleUserResponse parses an HTTP response from a DeleteRoleUserWithResponse call
+func ParseDeleteRoleUserResponse(rsp *http.Response) (*DeleteRoleUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRoleUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteRoleUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteRoleUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddRoleUserResponse parses an HTTP response from a AddRoleUserWithResponse call
+func ParseAddRoleUserResponse(rsp *http.Response) (*AddRoleUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddRoleUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AddRoleUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest AddRoleUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRoleUserResponse parses an HTTP response from a UpdateRoleUserWithResponse call
+func ParseUpdateRoleUserResponse(rsp *http.Response) (*UpdateRoleUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRoleUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateRoleUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest UpdateRoleUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteMFAResponse parses an HTTP response from a CompleteMFAWithResponse call
+func ParseCompleteMFAResponse(rsp *http.Response) (*CompleteMFAResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteMFAResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompleteMFAResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest CompleteMFAResponse403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest CompleteMFAResponse423
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest CompleteMFAResponse429
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CompleteMFAResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompletePasswordChangeResponse parses an HTTP response from a CompletePasswordChangeWithResponse call
+func ParseCompletePasswordChangeResponse(rsp *http.Response) (*CompletePasswordChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompletePasswordChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletePasswordChangeResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest CompletePasswordChangeResponse422
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CompletePasswordChangeResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshSessionResponse parses an HTTP response from a RefreshSessionWithResponse call
+func ParseRefreshSessionResponse(rsp *http.Response) (*RefreshSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshSessionResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest RefreshSessionResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginWebAuthnResponse parses an HTTP response from a LoginWebAuthnWithResponse call
+func ParseLoginWebAuthnResponse(rsp *http.Response) (*LoginWebAuthnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginWebAuthnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginWebAuthnResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest LoginWebAuthnResponse403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest LoginWebAuthnResponse423
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest LoginWebAuthnResponse429
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest LoginWebAuthnResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSigningKeysResponse parses an HTTP response from a GetSigningKeysWithResponse call
+func ParseGetSigningKeysResponse(rsp *http.Response) (*GetSigningKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSigningKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSigningKeysResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetSigningKeysResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGenerateSigningKeyResponse parses an HTTP response from a GenerateSigningKeyWithResponse call
+func ParseGenerateSigningKeyResponse(rsp *http.Response) (*GenerateSigningKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GenerateSigningKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenerateSigningKeyResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GenerateSigningKeyResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateSigningKeyResponse parses an HTTP response from a RotateSigningKeyWithResponse call
+func ParseRotateSigningKeyResponse(rsp *http.Response) (*RotateSigningKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateSigningKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RotateSigningKeyResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest RotateSigningKeyResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUsersResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetUsersResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest CreateUserResponse422
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CreateUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
+func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserResponse parses an HTTP response from a UpdateUserWithResponse call
+func ParseUpdateUserResponse(rsp *http.Response) (*UpdateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest UpdateUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlockUserResponse parses an HTTP response from a UnlockUserWithResponse call
+func ParseUnlockUserResponse(rsp *http.Response) (*UnlockUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlockUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnlockUserResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest UnlockUserResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableTOTPResponse parses an HTTP response from a DisableTOTPWithResponse call
+func ParseDisableTOTPResponse(rsp *http.Response) (*DisableTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisableTOTPResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DisableTOTPResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollTOTPResponse parses an HTTP response from a EnrollTOTPWithResponse call
+func ParseEnrollTOTPResponse(rsp *http.Response) (*EnrollTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnrollTOTPResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest EnrollTOTPResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmTOTPResponse parses an HTTP response from a ConfirmTOTPWithResponse call
+func ParseConfirmTOTPResponse(rsp *http.Response) (*ConfirmTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfirmTOTPResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ConfirmTOTPResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserPrivilegesResponse parses an HTTP response from a GetUserPrivilegesWithResponse call
+func ParseGetUserPrivilegesResponse(rsp *http.Response) (*GetUserPrivilegesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserPrivilegesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserPrivilegesResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetUserPrivilegesResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserRolesResponse parses an HTTP response from a GetUserRolesWithResponse call
+func ParseGetUserRolesResponse(rsp *http.Response) (*GetUserRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserRolesResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetUserRolesResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserSessionsResponse parses an HTTP response from a DeleteUserSessionsWithResponse call
+func ParseDeleteUserSessionsResponse(rsp *http.Response) (*DeleteUserSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteUserSessionsResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteUserSessionsResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserSessionsResponse parses an HTTP response from a GetUserSessionsWithResponse call
+func ParseGetUserSessionsResponse(rsp *http.Response) (*GetUserSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserSessionsResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetUserSessionsResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest LoginResponse202
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest LoginResponse403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest LoginResponse423
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest LoginResponse429
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest LoginResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserSessionResponse parses an HTTP response from a DeleteUserSessionWithResponse call
+func ParseDeleteUserSessionResponse(rsp *http.Response) (*DeleteUserSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteUserSessionResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteUserSessionResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBeginWebAuthnLoginResponse parses an HTTP response from a BeginWebAuthnLoginWithResponse call
+func ParseBeginWebAuthnLoginResponse(rsp *http.Response) (*BeginWebAuthnLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BeginWebAuthnLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeginWebAuthnLoginResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BeginWebAuthnLoginResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebAuthnCredentialsResponse parses an HTTP response from a GetWebAuthnCredentialsWithResponse call
+func ParseGetWebAuthnCredentialsResponse(rsp *http.Response) (*GetWebAuthnCredentialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebAuthnCredentialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetWebAuthnCredentialsResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest GetWebAuthnCredentialsResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebAuthnCredentialResponse parses an HTTP response from a CreateWebAuthnCredentialWithResponse call
+func ParseCreateWebAuthnCredentialResponse(rsp *http.Response) (*CreateWebAuthnCredentialResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebAuthnCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateWebAuthnCredentialResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest CreateWebAuthnCredentialResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebAuthnCredentialResponse parses an HTTP response from a DeleteWebAuthnCredentialWithResponse call
+func ParseDeleteWebAuthnCredentialResponse(rsp *http.Response) (*DeleteWebAuthnCredentialResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebAuthnCredentialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteWebAuthnCredentialResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest DeleteWebAuthnCredentialResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBeginWebAuthnRegistrationResponse parses an HTTP response from a BeginWebAuthnRegistrationWithResponse call
+func ParseBeginWebAuthnRegistrationResponse(rsp *http.Response) (*BeginWebAuthnRegistrationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BeginWebAuthnRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeginWebAuthnRegistrationResponse200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BeginWebAuthnRegistrationResponse500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
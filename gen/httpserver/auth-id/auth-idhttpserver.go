@@ -24,6 +24,21 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuthorizeDecisionReason.
+const (
+	PrivilegeNotFound AuthorizeDecisionReason = "privilege_not_found"
+	SessionNotFound   AuthorizeDecisionReason = "session_not_found"
+	TokenExpired      AuthorizeDecisionReason = "token_expired"
+	TokenInvalid      AuthorizeDecisionReason = "token_invalid"
+)
+
+// Defines values for LoginLockoutReason.
+const (
+	LoginLocked    LoginLockoutReason = "login_locked"
+	LoginThrottled LoginLockoutReason = "login_throttled"
+	UserBlocked    LoginLockoutReason = "user_blocked"
+)
+
 // Defines values for ResponseStatusErrorCode.
 const (
 	Error ResponseStatusErrorCode = "error"
@@ -34,6 +49,14 @@ const (
 	Ok ResponseStatusOkCode = "ok"
 )
 
+// Defines values for SessionMethod.
+const (
+	SessionMethodMfa            SessionMethod = "mfa"
+	SessionMethodPassword       SessionMethod = "password"
+	SessionMethodPasswordChange SessionMethod = "password_change"
+	SessionMethodWebauthn       SessionMethod = "webauthn"
+)
+
 // AddRolePrivilegeRequest defines model for AddRolePrivilegeRequest.
 type AddRolePrivilegeRequest struct {
 	Allowed bool `json:"allowed"`
@@ -65,6 +88,69 @@ type AddRoleUserResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// AuthorizeDecision defines model for AuthorizeDecision.
+type AuthorizeDecision struct {
+	// Allowed Доступ разрешен
+	Allowed bool `json:"allowed"`
+
+	// Privilege Код привилегии
+	Privilege string `json:"privilege"`
+
+	// Reason Причина отказа
+	Reason *AuthorizeDecisionReason `json:"reason,omitempty"`
+}
+
+// AuthorizeDecisionReason Причина отказа
+type AuthorizeDecisionReason string
+
+// AuthorizeRequest defines model for AuthorizeRequest.
+type AuthorizeRequest struct {
+	// Privileges Коды проверяемых привилегий
+	Privileges []string `json:"privileges"`
+
+	// Token Access-токен проверяемой сессии
+	Token string `json:"token"`
+}
+
+// AuthorizeResponse200 defines model for AuthorizeResponse200.
+type AuthorizeResponse200 struct {
+	Data   []AuthorizeDecision `json:"data"`
+	Status ResponseStatusOk    `json:"status"`
+}
+
+// AuthorizeResponse500 defines model for AuthorizeResponse500.
+type AuthorizeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// BeginWebAuthnLoginRequest defines model for BeginWebAuthnLoginRequest.
+type BeginWebAuthnLoginRequest struct {
+	// MfaChallenge Токен незавершенного входа по паролю
+	MfaChallenge *string `json:"mfa_challenge,omitempty"`
+}
+
+// BeginWebAuthnLoginResponse200 defines model for BeginWebAuthnLoginResponse200.
+type BeginWebAuthnLoginResponse200 struct {
+	Data   WebAuthnAssertionStart `json:"data"`
+	Status ResponseStatusOk       `json:"status"`
+}
+
+// BeginWebAuthnLoginResponse500 defines model for BeginWebAuthnLoginResponse500.
+type BeginWebAuthnLoginResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// BeginWebAuthnRegistrationResponse200 defines model for BeginWebAuthnRegistrationResponse200.
+type BeginWebAuthnRegistrationResponse200 struct {
+	Data   WebAuthnRegistration `json:"data"`
+	Status ResponseStatusOk     `json:"status"`
+}
+
+// BeginWebAuthnRegistrationResponse500 defines model for BeginWebAuthnRegistrationResponse500.
+type BeginWebAuthnRegistrationResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // ChangePassRequest defines model for ChangePassRequest.
 type ChangePassRequest struct {
 	// Changed Новый пароль
@@ -79,11 +165,129 @@ type ChangePassResponse200 struct {
 	Status ResponseStatusOk `json:"status"`
 }
 
+// ChangePassResponse422 defines model for ChangePassResponse422.
+type ChangePassResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
 // ChangePassResponse500 defines model for ChangePassResponse500.
 type ChangePassResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// CompleteMFARequest defines model for CompleteMFARequest.
+type CompleteMFARequest struct {
+	// Challenge Токен незавершенного входа
+	Challenge string `json:"challenge"`
+
+	// Code Код TOTP или одноразовый код восстановления
+	Code string `json:"code"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+}
+
+// CompleteMFAResponse200 defines model for CompleteMFAResponse200.
+type CompleteMFAResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompleteMFAResponse403 defines model for CompleteMFAResponse403.
+type CompleteMFAResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse423 defines model for CompleteMFAResponse423.
+type CompleteMFAResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse429 defines model for CompleteMFAResponse429.
+type CompleteMFAResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompleteMFAResponse500 defines model for CompleteMFAResponse500.
+type CompleteMFAResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePassResetRequest defines model for CompletePassResetRequest.
+type CompletePassResetRequest struct {
+	// Changed Новый пароль
+	Changed string `json:"changed"`
+
+	// Token Одноразовый токен сброса пароля из письма
+	Token string `json:"token"`
+}
+
+// CompletePassResetResponse200 defines model for CompletePassResetResponse200.
+type CompletePassResetResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompletePassResetResponse422 defines model for CompletePassResetResponse422.
+type CompletePassResetResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePassResetResponse500 defines model for CompletePassResetResponse500.
+type CompletePassResetResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePasswordChangeRequest defines model for CompletePasswordChangeRequest.
+type CompletePasswordChangeRequest struct {
+	// Challenge Токен незавершенного входа
+	Challenge string `json:"challenge"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
+	// Password Новый пароль
+	Password string `json:"password"`
+}
+
+// CompletePasswordChangeResponse200 defines model for CompletePasswordChangeResponse200.
+type CompletePasswordChangeResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// CompletePasswordChangeResponse422 defines model for CompletePasswordChangeResponse422.
+type CompletePasswordChangeResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// CompletePasswordChangeResponse500 defines model for CompletePasswordChangeResponse500.
+type CompletePasswordChangeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ConfirmTOTPRequest defines model for ConfirmTOTPRequest.
+type ConfirmTOTPRequest struct {
+	// Code Код из приложения-аутентификатора
+	Code string `json:"code"`
+}
+
+// ConfirmTOTPResponse200 defines model for ConfirmTOTPResponse200.
+type ConfirmTOTPResponse200 struct {
+	Data   TOTPRecoveryCodes `json:"data"`
+	Status ResponseStatusOk  `json:"status"`
+}
+
+// ConfirmTOTPResponse500 defines model for ConfirmTOTPResponse500.
+type ConfirmTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // CreateRoleRequest defines model for CreateRoleRequest.
 type CreateRoleRequest struct {
 	Blocked bool `json:"blocked"`
@@ -110,6 +314,9 @@ type CreateRoleResponse500 struct {
 type CreateUserRequest struct {
 	Blocked bool `json:"blocked"`
 
+	// Email Адрес электронной почты для сброса пароля
+	Email *string `json:"email,omitempty"`
+
 	// Login login пользователя
 	Login string `json:"login"`
 
@@ -126,11 +333,66 @@ type CreateUserResponse200 struct {
 	Status ResponseStatusOk `json:"status"`
 }
 
+// CreateUserResponse422 defines model for CreateUserResponse422.
+type CreateUserResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
 // CreateUserResponse500 defines model for CreateUserResponse500.
 type CreateUserResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// CreateWebAuthnCredentialRequest defines model for CreateWebAuthnCredentialRequest.
+type CreateWebAuthnCredentialRequest struct {
+	// AttestationObject response.attestationObject в base64url
+	AttestationObject string `json:"attestation_object"`
+
+	// Ceremony Идентификатор церемонии
+	Ceremony string `json:"ceremony"`
+
+	// ClientDataJson response.clientDataJSON в base64url
+	ClientDataJson string `json:"client_data_json"`
+
+	// Name Название ключа
+	Name *string `json:"name,omitempty"`
+
+	// Transports response.getTransports()
+	Transports *[]string `json:"transports,omitempty"`
+}
+
+// CreateWebAuthnCredentialResponse200 defines model for CreateWebAuthnCredentialResponse200.
+type CreateWebAuthnCredentialResponse200 struct {
+	Data   WebAuthnCredential `json:"data"`
+	Status ResponseStatusOk   `json:"status"`
+}
+
+// CreateWebAuthnCredentialResponse500 defines model for CreateWebAuthnCredentialResponse500.
+type CreateWebAuthnCredentialResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteCurrentSessionResponse200 defines model for DeleteCurrentSessionResponse200.
+type DeleteCurrentSessionResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteCurrentSessionResponse500 defines model for DeleteCurrentSessionResponse500.
+type DeleteCurrentSessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteMySessionResponse200 defines model for DeleteMySessionResponse200.
+type DeleteMySessionResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteMySessionResponse500 defines model for DeleteMySessionResponse500.
+type DeleteMySessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // DeleteRolePrivilegeResponse200 defines model for DeleteRolePrivilegeResponse200.
 type DeleteRolePrivilegeResponse200 struct {
 	Status ResponseStatusOk `json:"status"`
@@ -181,6 +443,90 @@ type DeleteUserSessionResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// DeleteUserSessionsResponse200 defines model for DeleteUserSessionsResponse200.
+type DeleteUserSessionsResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteUserSessionsResponse500 defines model for DeleteUserSessionsResponse500.
+type DeleteUserSessionsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DeleteWebAuthnCredentialResponse200 defines model for DeleteWebAuthnCredentialResponse200.
+type DeleteWebAuthnCredentialResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DeleteWebAuthnCredentialResponse500 defines model for DeleteWebAuthnCredentialResponse500.
+type DeleteWebAuthnCredentialResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// DisableTOTPResponse200 defines model for DisableTOTPResponse200.
+type DisableTOTPResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// DisableTOTPResponse500 defines model for DisableTOTPResponse500.
+type DisableTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// EnrollTOTPResponse200 defines model for EnrollTOTPResponse200.
+type EnrollTOTPResponse200 struct {
+	Data   TOTPEnrollment   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// EnrollTOTPResponse500 defines model for EnrollTOTPResponse500.
+type EnrollTOTPResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// ForwardAuthResponse200 defines model for ForwardAuthResponse200.
+type ForwardAuthResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// ForwardAuthResponseError defines model for ForwardAuthResponseError.
+type ForwardAuthResponseError struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GenerateSigningKeyResponse200 defines model for GenerateSigningKeyResponse200.
+type GenerateSigningKeyResponse200 struct {
+	Data   SigningKey       `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GenerateSigningKeyResponse500 defines model for GenerateSigningKeyResponse500.
+type GenerateSigningKeyResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetMeResponse200 defines model for GetMeResponse200.
+type GetMeResponse200 struct {
+	Data   User             `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetMeResponse500 defines model for GetMeResponse500.
+type GetMeResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// GetMySessionsResponse200 defines model for GetMySessionsResponse200.
+type GetMySessionsResponse200 struct {
+	Data   []Session        `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetMySessionsResponse500 defines model for GetMySessionsResponse500.
+type GetMySessionsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // GetPrivilegesResponse200 defines model for GetPrivilegesResponse200.
 type GetPrivilegesResponse200 struct {
 	Data   []Privilege      `json:"data"`
@@ -236,6 +582,17 @@ type GetRolesResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// GetSigningKeysResponse200 defines model for GetSigningKeysResponse200.
+type GetSigningKeysResponse200 struct {
+	Data   []SigningKey     `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// GetSigningKeysResponse500 defines model for GetSigningKeysResponse500.
+type GetSigningKeysResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // GetUserPrivilegesResponse200 defines model for GetUserPrivilegesResponse200.
 type GetUserPrivilegesResponse200 struct {
 	Data   []UserPrivilege  `json:"data"`
@@ -291,8 +648,91 @@ type GetUsersResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// GetWebAuthnCredentialsResponse200 defines model for GetWebAuthnCredentialsResponse200.
+type GetWebAuthnCredentialsResponse200 struct {
+	Data   []WebAuthnCredential `json:"data"`
+	Status ResponseStatusOk     `json:"status"`
+}
+
+// GetWebAuthnCredentialsResponse500 defines model for GetWebAuthnCredentialsResponse500.
+type GetWebAuthnCredentialsResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// IntrospectTokenRequest defines model for IntrospectTokenRequest.
+type IntrospectTokenRequest struct {
+	// Token Проверяемый токен
+	Token string `json:"token"`
+
+	// TokenTypeHint Тип токена
+	TokenTypeHint *string `json:"token_type_hint,omitempty"`
+}
+
+// IntrospectTokenResponse200 defines model for IntrospectTokenResponse200.
+type IntrospectTokenResponse200 struct {
+	// Active Токен действителен
+	Active bool `json:"active"`
+
+	// Exp Время окончания срока действия токена
+	Exp *int64 `json:"exp,omitempty"`
+
+	// Iat Время выпуска токена
+	Iat *int64 `json:"iat,omitempty"`
+
+	// Scope Привилегии сессии через пробел
+	Scope *string `json:"scope,omitempty"`
+
+	// Sid Идентификатор сессии
+	Sid *string `json:"sid,omitempty"`
+
+	// Sub Логин пользователя
+	Sub *string `json:"sub,omitempty"`
+}
+
+// IntrospectTokenResponse500 defines model for IntrospectTokenResponse500.
+type IntrospectTokenResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// JWK defines model for JWK.
+type JWK struct {
+	Alg *string `json:"alg,omitempty"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+
+	// Kid Идентификатор ключа
+	Kid string `json:"kid"`
+
+	// Kty Тип ключа
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use *string `json:"use,omitempty"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoginLockout defines model for LoginLockout.
+type LoginLockout struct {
+	// LockedUntil Момент, после которого вход снова возможен. Отсутствует при блокировке пользователя
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+
+	// Reason Причина отказа во входе
+	Reason LoginLockoutReason `json:"reason"`
+}
+
+// LoginLockoutReason Причина отказа во входе
+type LoginLockoutReason string
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
 	// Password Пароль
 	Password string `json:"password"`
 }
@@ -303,11 +743,109 @@ type LoginResponse200 struct {
 	Status ResponseStatusOk `json:"status"`
 }
 
+// LoginResponse202 defines model for LoginResponse202.
+type LoginResponse202 struct {
+	Data   MFAChallenge     `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// LoginResponse403 defines model for LoginResponse403.
+type LoginResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginResponse423 defines model for LoginResponse423.
+type LoginResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginResponse429 defines model for LoginResponse429.
+type LoginResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
 // LoginResponse500 defines model for LoginResponse500.
 type LoginResponse500 struct {
 	Status ResponseStatusError `json:"status"`
 }
 
+// LoginWebAuthnRequest defines model for LoginWebAuthnRequest.
+type LoginWebAuthnRequest struct {
+	// AuthenticatorData response.authenticatorData в base64url
+	AuthenticatorData string `json:"authenticator_data"`
+
+	// Ceremony Идентификатор церемонии
+	Ceremony string `json:"ceremony"`
+
+	// ClientDataJson response.clientDataJSON в base64url
+	ClientDataJson string `json:"client_data_json"`
+
+	// CredentialId rawId в base64url
+	CredentialId string `json:"credential_id"`
+
+	// Device Название устройства для списка сессий, например "Рабочий ноутбук"
+	Device *string `json:"device,omitempty"`
+
+	// Signature response.signature в base64url
+	Signature string `json:"signature"`
+
+	// UserHandle response.userHandle в base64url
+	UserHandle *string `json:"user_handle,omitempty"`
+}
+
+// LoginWebAuthnResponse200 defines model for LoginWebAuthnResponse200.
+type LoginWebAuthnResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// LoginWebAuthnResponse403 defines model for LoginWebAuthnResponse403.
+type LoginWebAuthnResponse403 struct {
+	Data   PasswordChange      `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse423 defines model for LoginWebAuthnResponse423.
+type LoginWebAuthnResponse423 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse429 defines model for LoginWebAuthnResponse429.
+type LoginWebAuthnResponse429 struct {
+	Data   LoginLockout        `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
+// LoginWebAuthnResponse500 defines model for LoginWebAuthnResponse500.
+type LoginWebAuthnResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// MFAChallenge defines model for MFAChallenge.
+type MFAChallenge struct {
+	// Challenge Токен незавершенного входа для передачи вместе с кодом
+	Challenge string `json:"challenge"`
+}
+
+// PasswordChange defines model for PasswordChange.
+type PasswordChange struct {
+	// Challenge Токен незавершенного входа для передачи вместе с новым паролем
+	Challenge string `json:"challenge"`
+}
+
+// PasswordViolation Нарушение правил выбора пароля
+type PasswordViolation struct {
+	// Code Код нарушения: too_short, too_long, no_lower, no_upper, no_digit, no_special, contains_personal, common, reused
+	Code string `json:"code"`
+
+	// Message Описание нарушения
+	Message string `json:"message"`
+}
+
 // Privilege defines model for Privilege.
 type Privilege struct {
 	Code        string `json:"code"`
@@ -315,6 +853,33 @@ type Privilege struct {
 	Name        string `json:"name"`
 }
 
+// RefreshSessionRequest defines model for RefreshSessionRequest.
+type RefreshSessionRequest struct {
+	// RefreshToken Refresh-токен
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RefreshSessionResponse200 defines model for RefreshSessionResponse200.
+type RefreshSessionResponse200 struct {
+	Data   ResponseAccess   `json:"data"`
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RefreshSessionResponse500 defines model for RefreshSessionResponse500.
+type RefreshSessionResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
+// RequestPassResetResponse200 defines model for RequestPassResetResponse200.
+type RequestPassResetResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RequestPassResetResponse500 defines model for RequestPassResetResponse500.
+type RequestPassResetResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // ResetPassRequest defines model for ResetPassRequest.
 type ResetPassRequest struct {
 	// Changed Новый пароль
@@ -326,6 +891,12 @@ type ResetPassResponse200 struct {
 	Status ResponseStatusOk `json:"status"`
 }
 
+// ResetPassResponse422 defines model for ResetPassResponse422.
+type ResetPassResponse422 struct {
+	Data   []PasswordViolation `json:"data"`
+	Status ResponseStatusError `json:"status"`
+}
+
 // ResetPassResponse500 defines model for ResetPassResponse500.
 type ResetPassResponse500 struct {
 	Status ResponseStatusError `json:"status"`
@@ -337,6 +908,11 @@ type ResponseAccess struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // ResponseStatusError defines model for ResponseStatusError.
 type ResponseStatusError struct {
 	Code        ResponseStatusErrorCode `json:"code"`
@@ -379,16 +955,84 @@ type RoleUser struct {
 	Name    string             `json:"name"`
 }
 
+// RotateSigningKeyResponse200 defines model for RotateSigningKeyResponse200.
+type RotateSigningKeyResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// RotateSigningKeyResponse500 defines model for RotateSigningKeyResponse500.
+type RotateSigningKeyResponse500 struct {
+	Status ResponseStatusError `json:"status"`
+}
+
 // Session defines model for Session.
 type Session struct {
 	// CreatedAt Время создания сессии
 	CreatedAt time.Time `json:"created_at"`
 
+	// Current Сессия, от имени которой выполнен запрос
+	Current *bool `json:"current,omitempty"`
+
+	// Device Название устройства, указанное клиентом при входе
+	Device *string `json:"device,omitempty"`
+
 	// ExpiredAt Время истечения срока действия сессии
 	ExpiredAt time.Time `json:"expired_at"`
 
 	// Id Идентификатор сессии
 	Id string `json:"id"`
+
+	// Ip Адрес клиента, открывшего сессию
+	Ip *string `json:"ip,omitempty"`
+
+	// LastSeenAt Время последнего обращения, записывается не чаще интервала AUTH_SESSION_LAST_SEEN_INTERVAL
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+
+	// Method Способ входа
+	Method *SessionMethod `json:"method,omitempty"`
+
+	// UserAgent User-Agent клиента, открывшего сессию
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// SessionMethod Способ входа
+type SessionMethod string
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	// Active Ключ используется для подписи новых токенов
+	Active bool `json:"active"`
+
+	// Alg Алгоритм подписи
+	Alg string `json:"alg"`
+
+	// Kid Идентификатор ключа
+	Kid string `json:"kid"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	// Secret Секрет в base32 для ручного ввода
+	Secret string `json:"secret"`
+
+	// Uri Ссылка otpauth:// для QR-кода
+	Uri string `json:"uri"`
+}
+
+// TOTPRecoveryCodes defines model for TOTPRecoveryCodes.
+type TOTPRecoveryCodes struct {
+	// RecoveryCodes Одноразовые коды восстановления, показываются один раз
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// UnlockUserResponse200 defines model for UnlockUserResponse200.
+type UnlockUserResponse200 struct {
+	Status ResponseStatusOk `json:"status"`
+}
+
+// UnlockUserResponse500 defines model for UnlockUserResponse500.
+type UnlockUserResponse500 struct {
+	Status ResponseStatusError `json:"status"`
 }
 
 // UpdateRolePrivilegeRequest defines model for UpdateRolePrivilegeRequest.
@@ -448,6 +1092,9 @@ type UpdateRoleUserResponse500 struct {
 type UpdateUserRequest struct {
 	Blocked bool `json:"blocked"`
 
+	// Email Адрес электронной почты для сброса пароля, без поля не изменяется
+	Email *string `json:"email,omitempty"`
+
 	// Name Полное имя пользователя
 	Name string `json:"name"`
 }
@@ -465,9 +1112,12 @@ type UpdateUserResponse500 struct {
 
 // User defines model for User.
 type User struct {
-	Blocked bool   `json:"blocked"`
-	Login   string `json:"login"`
-	Name    string `json:"name"`
+	Blocked bool `json:"blocked"`
+
+	// Email Адрес электронной почты для сброса пароля
+	Email *string `json:"email,omitempty"`
+	Login string  `json:"login"`
+	Name  string  `json:"name"`
 }
 
 // UserPrivilege defines model for UserPrivilege.
@@ -488,6 +1138,153 @@ type UserRole struct {
 	Name        string             `json:"name"`
 }
 
+// WebAuthnAssertionStart defines model for WebAuthnAssertionStart.
+type WebAuthnAssertionStart struct {
+	// Ceremony Идентификатор церемонии для завершения входа
+	Ceremony string `json:"ceremony"`
+
+	// Options Параметры в формате PublicKeyCredentialRequestOptionsJSON
+	Options WebAuthnRequestOptions `json:"options"`
+}
+
+// WebAuthnAuthenticatorSelection defines model for WebAuthnAuthenticatorSelection.
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnCreationOptions Параметры в формате PublicKeyCredentialCreationOptionsJSON
+type WebAuthnCreationOptions struct {
+	Attestation            string                         `json:"attestation"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+
+	// Challenge Вызов в base64url
+	Challenge          string                         `json:"challenge"`
+	ExcludeCredentials []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	PubKeyCredParams   []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Rp                 WebAuthnRelyingParty           `json:"rp"`
+
+	// Timeout Время на выполнение церемонии в миллисекундах
+	Timeout int64        `json:"timeout"`
+	User    WebAuthnUser `json:"user"`
+}
+
+// WebAuthnCredential defines model for WebAuthnCredential.
+type WebAuthnCredential struct {
+	// CreatedAt Время регистрации ключа
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Идентификатор ключа в base64url
+	Id string `json:"id"`
+
+	// Name Название ключа
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+}
+
+// WebAuthnCredentialDescriptor defines model for WebAuthnCredentialDescriptor.
+type WebAuthnCredentialDescriptor struct {
+	// Id Идентификатор ключа в base64url
+	Id         string    `json:"id"`
+	Transports *[]string `json:"transports,omitempty"`
+	Type       string    `json:"type"`
+}
+
+// WebAuthnCredentialParameter defines model for WebAuthnCredentialParameter.
+type WebAuthnCredentialParameter struct {
+	// Alg Алгоритм COSE
+	Alg  int64  `json:"alg"`
+	Type string `json:"type"`
+}
+
+// WebAuthnRegistration defines model for WebAuthnRegistration.
+type WebAuthnRegistration struct {
+	// Ceremony Идентификатор церемонии для завершения регистрации
+	Ceremony string `json:"ceremony"`
+
+	// Options Параметры в формате PublicKeyCredentialCreationOptionsJSON
+	Options WebAuthnCreationOptions `json:"options"`
+}
+
+// WebAuthnRelyingParty defines model for WebAuthnRelyingParty.
+type WebAuthnRelyingParty struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// WebAuthnRequestOptions Параметры в формате PublicKeyCredentialRequestOptionsJSON
+type WebAuthnRequestOptions struct {
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+
+	// Challenge Вызов в base64url
+	Challenge string `json:"challenge"`
+	RpId      string `json:"rpId"`
+
+	// Timeout Время на выполнение церемонии в миллисекундах
+	Timeout          int64  `json:"timeout"`
+	UserVerification string `json:"userVerification"`
+}
+
+// WebAuthnUser defines model for WebAuthnUser.
+type WebAuthnUser struct {
+	DisplayName string `json:"displayName"`
+
+	// Id Идентификатор пользователя в base64url
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Conflict defines model for Conflict.
+type Conflict = ResponseError
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ResponseError
+
+// ForwardAuthParams defines parameters for ForwardAuth.
+type ForwardAuthParams struct {
+	// Upstream Защищаемый сервис. По умолчанию используется заголовок X-Forwarded-Host
+	Upstream *string `form:"upstream,omitempty" json:"upstream,omitempty"`
+
+	// XForwardedMethod Метод исходного запроса
+	XForwardedMethod *string `json:"X-Forwarded-Method,omitempty"`
+
+	// XForwardedUri Путь исходного запроса. Без заголовка запрос отклоняется с кодом 400
+	XForwardedUri *string `json:"X-Forwarded-Uri,omitempty"`
+
+	// XForwardedHost Хост исходного запроса
+	XForwardedHost *string `json:"X-Forwarded-Host,omitempty"`
+}
+
+// GetMySessionsParams defines parameters for GetMySessions.
+type GetMySessionsParams struct {
+	// PageSize Размер страницы
+	PageSize uint32 `form:"pageSize" json:"pageSize"`
+
+	// Offset Смещение страницы
+	Offset uint32 `form:"offset" json:"offset"`
+}
+
+// ChangePassParams defines parameters for ChangePass.
+type ChangePassParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
+// ResetPassParams defines parameters for ResetPass.
+type ResetPassParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
 // GetPrivilegesParams defines parameters for GetPrivileges.
 type GetPrivilegesParams struct {
 	// PageSize Размер страницы
@@ -551,6 +1348,12 @@ type GetUserRolesParams struct {
 	Offset uint32 `form:"offset" json:"offset"`
 }
 
+// DeleteUserSessionsParams defines parameters for DeleteUserSessions.
+type DeleteUserSessionsParams struct {
+	// KeepCurrent Сохранить текущую сессию вызывающего, остальные сессии пользователя отзываются
+	KeepCurrent *bool `form:"keep_current,omitempty" json:"keep_current,omitempty"`
+}
+
 // GetUserSessionsParams defines parameters for GetUserSessions.
 type GetUserSessionsParams struct {
 	// PageSize Размер страницы
@@ -560,12 +1363,21 @@ type GetUserSessionsParams struct {
 	Offset uint32 `form:"offset" json:"offset"`
 }
 
+// AuthorizeJSONRequestBody defines body for Authorize for application/json ContentType.
+type AuthorizeJSONRequestBody = AuthorizeRequest
+
+// IntrospectTokenFormdataRequestBody defines body for IntrospectToken for application/x-www-form-urlencoded ContentType.
+type IntrospectTokenFormdataRequestBody = IntrospectTokenRequest
+
 // ChangePassJSONRequestBody defines body for ChangePass for application/json ContentType.
 type ChangePassJSONRequestBody = ChangePassRequest
 
 // ResetPassJSONRequestBody defines body for ResetPass for application/json ContentType.
 type ResetPassJSONRequestBody = ResetPassRequest
 
+// CompletePassResetJSONRequestBody defines body for CompletePassReset for application/json ContentType.
+type CompletePassResetJSONRequestBody = CompletePassResetRequest
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = CreateRoleRequest
 
@@ -584,23 +1396,74 @@ type AddRoleUserJSONRequestBody = AddRoleUserRequest
 // UpdateRoleUserJSONRequestBody defines body for UpdateRoleUser for application/json ContentType.
 type UpdateRoleUserJSONRequestBody = UpdateRoleUserRequest
 
+// CompleteMFAJSONRequestBody defines body for CompleteMFA for application/json ContentType.
+type CompleteMFAJSONRequestBody = CompleteMFARequest
+
+// CompletePasswordChangeJSONRequestBody defines body for CompletePasswordChange for application/json ContentType.
+type CompletePasswordChangeJSONRequestBody = CompletePasswordChangeRequest
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionRequest
+
+// LoginWebAuthnJSONRequestBody defines body for LoginWebAuthn for application/json ContentType.
+type LoginWebAuthnJSONRequestBody = LoginWebAuthnRequest
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody = UpdateUserRequest
 
+// ConfirmTOTPJSONRequestBody defines body for ConfirmTOTP for application/json ContentType.
+type ConfirmTOTPJSONRequestBody = ConfirmTOTPRequest
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// BeginWebAuthnLoginJSONRequestBody defines body for BeginWebAuthnLogin for application/json ContentType.
+type BeginWebAuthnLoginJSONRequestBody = BeginWebAuthnLoginRequest
+
+// CreateWebAuthnCredentialJSONRequestBody defines body for CreateWebAuthnCredential for application/json ContentType.
+type CreateWebAuthnCredentialJSONRequestBody = CreateWebAuthnCredentialRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /.well-known/jwks.json)
+	GetJWKS(ctx echo.Context) error
+
+	// (POST /v1/authorize)
+	Authorize(ctx echo.Context) error
+
+	// (GET /v1/forward-auth)
+	ForwardAuth(ctx echo.Context, params ForwardAuthParams) error
+
+	// (POST /v1/introspect)
+	IntrospectToken(ctx echo.Context) error
+
+	// (GET /v1/me)
+	GetMe(ctx echo.Context) error
+
+	// (GET /v1/me/sessions)
+	GetMySessions(ctx echo.Context, params GetMySessionsParams) error
+
+	// (DELETE /v1/me/sessions/current)
+	DeleteCurrentSession(ctx echo.Context) error
+
+	// (DELETE /v1/me/sessions/{session_id})
+	DeleteMySession(ctx echo.Context, sessionId string) error
+
 	// (PUT /v1/passchanges/{login})
-	ChangePass(ctx echo.Context, login string) error
+	ChangePass(ctx echo.Context, login string, params ChangePassParams) error
+
+	// (POST /v1/passresets/{login})
+	RequestPassReset(ctx echo.Context, login string) error
 
 	// (PUT /v1/passresets/{login})
-	ResetPass(ctx echo.Context, login string) error
+	ResetPass(ctx echo.Context, login string, params ResetPassParams) error
+
+	// (POST /v1/passresets/{login}/confirmation)
+	CompletePassReset(ctx echo.Context, login string) error
 
 	// (GET /v1/privileges)
 	GetPrivileges(ctx echo.Context, params GetPrivilegesParams) error
@@ -644,6 +1507,27 @@ type ServerInterface interface {
 	// (PUT /v1/roles/{code}/users/{login})
 	UpdateRoleUser(ctx echo.Context, code string, login string) error
 
+	// (POST /v1/sessions/mfa)
+	CompleteMFA(ctx echo.Context) error
+
+	// (POST /v1/sessions/password)
+	CompletePasswordChange(ctx echo.Context) error
+
+	// (POST /v1/sessions/refresh)
+	RefreshSession(ctx echo.Context) error
+
+	// (POST /v1/sessions/webauthn)
+	LoginWebAuthn(ctx echo.Context) error
+
+	// (GET /v1/signing-keys)
+	GetSigningKeys(ctx echo.Context) error
+
+	// (POST /v1/signing-keys)
+	GenerateSigningKey(ctx echo.Context) error
+
+	// (POST /v1/signing-keys/{kid}/activation)
+	RotateSigningKey(ctx echo.Context, kid string) error
+
 	// (GET /v1/users)
 	GetUsers(ctx echo.Context, params GetUsersParams) error
 
@@ -659,25 +1543,220 @@ type ServerInterface interface {
 	// (PUT /v1/users/{login})
 	UpdateUser(ctx echo.Context, login string) error
 
+	// (DELETE /v1/users/{login}/lockout)
+	UnlockUser(ctx echo.Context, login string) error
+
+	// (DELETE /v1/users/{login}/mfa/totp)
+	DisableTOTP(ctx echo.Context, login string) error
+
+	// (POST /v1/users/{login}/mfa/totp)
+	EnrollTOTP(ctx echo.Context, login string) error
+
+	// (POST /v1/users/{login}/mfa/totp/confirmation)
+	ConfirmTOTP(ctx echo.Context, login string) error
+
 	// (GET /v1/users/{login}/privileges)
 	GetUserPrivileges(ctx echo.Context, login string, params GetUserPrivilegesParams) error
 
 	// (GET /v1/users/{login}/roles)
 	GetUserRoles(ctx echo.Context, login string, params GetUserRolesParams) error
 
+	// (DELETE /v1/users/{login}/sessions)
+	DeleteUserSessions(ctx echo.Context, login string, params DeleteUserSessionsParams) error
+
 	// (GET /v1/users/{login}/sessions)
 	GetUserSessions(ctx echo.Context, login string, params GetUserSessionsParams) error
 
 	// (POST /v1/users/{login}/sessions)
 	Login(ctx echo.Context, login string) error
 
-	// (DELETE /v1/users/{login}/sessions/{session_id})
-	DeleteUserSession(ctx echo.Context, login string, sessionId string) error
-}
+	// (DELETE /v1/users/{login}/sessions/{session_id})
+	DeleteUserSession(ctx echo.Context, login string, sessionId string) error
+
+	// (POST /v1/users/{login}/webauthn/assertions)
+	BeginWebAuthnLogin(ctx echo.Context, login string) error
+
+	// (GET /v1/users/{login}/webauthn/credentials)
+	GetWebAuthnCredentials(ctx echo.Context, login string) error
+
+	// (POST /v1/users/{login}/webauthn/credentials)
+	CreateWebAuthnCredential(ctx echo.Context, login string) error
+
+	// (DELETE /v1/users/{login}/webauthn/credentials/{credential_id})
+	DeleteWebAuthnCredential(ctx echo.Context, login string, credentialId string) error
+
+	// (POST /v1/users/{login}/webauthn/registrations)
+	BeginWebAuthnRegistration(ctx echo.Context, login string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJWKS converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJWKS(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJWKS(ctx)
+	return err
+}
+
+// Authorize converts echo context to params.
+func (w *ServerInterfaceWrapper) Authorize(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Authorize(ctx)
+	return err
+}
+
+// ForwardAuth converts echo context to params.
+func (w *ServerInterfaceWrapper) ForwardAuth(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ForwardAuthParams
+	// ------------- Optional query parameter "upstream" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "upstream", ctx.QueryParams(), &params.Upstream)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter upstream: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Forwarded-Method" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Forwarded-Method")]; found {
+		var XForwardedMethod string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Forwarded-Method, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Forwarded-Method", valueList[0], &XForwardedMethod, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Forwarded-Method: %s", err))
+		}
+
+		params.XForwardedMethod = &XForwardedMethod
+	}
+	// ------------- Optional header parameter "X-Forwarded-Uri" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Forwarded-Uri")]; found {
+		var XForwardedUri string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Forwarded-Uri, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Forwarded-Uri", valueList[0], &XForwardedUri, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Forwarded-Uri: %s", err))
+		}
+
+		params.XForwardedUri = &XForwardedUri
+	}
+	// ------------- Optional header parameter "X-Forwarded-Host" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Forwarded-Host")]; found {
+		var XForwardedHost string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Forwarded-Host, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Forwarded-Host", valueList[0], &XForwardedHost, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Forwarded-Host: %s", err))
+		}
+
+		params.XForwardedHost = &XForwardedHost
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForwardAuth(ctx, params)
+	return err
+}
+
+// IntrospectToken converts echo context to params.
+func (w *ServerInterfaceWrapper) IntrospectToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.IntrospectToken(ctx)
+	return err
+}
+
+// GetMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMe(ctx)
+	return err
+}
+
+// GetMySessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMySessions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMySessionsParams
+	// ------------- Required query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pageSize", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pageSize: %s", err))
+	}
+
+	// ------------- Required query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMySessions(ctx, params)
+	return err
+}
+
+// DeleteCurrentSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCurrentSession(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCurrentSession(ctx)
+	return err
+}
+
+// DeleteMySession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMySession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", ctx.Param("session_id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMySession(ctx, sessionId)
+	return err
 }
 
 // ChangePass converts echo context to params.
@@ -693,8 +1772,33 @@ func (w *ServerInterfaceWrapper) ChangePass(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ChangePassParams
+	// ------------- Optional query parameter "keep_current" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keep_current", ctx.QueryParams(), &params.KeepCurrent)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keep_current: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangePass(ctx, login, params)
+	return err
+}
+
+// RequestPassReset converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestPassReset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ChangePass(ctx, login)
+	err = w.Handler.RequestPassReset(ctx, login)
 	return err
 }
 
@@ -711,8 +1815,33 @@ func (w *ServerInterfaceWrapper) ResetPass(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResetPassParams
+	// ------------- Optional query parameter "keep_current" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keep_current", ctx.QueryParams(), &params.KeepCurrent)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keep_current: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResetPass(ctx, login, params)
+	return err
+}
+
+// CompletePassReset converts echo context to params.
+func (w *ServerInterfaceWrapper) CompletePassReset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ResetPass(ctx, login)
+	err = w.Handler.CompletePassReset(ctx, login)
 	return err
 }
 
@@ -1059,6 +2188,82 @@ func (w *ServerInterfaceWrapper) UpdateRoleUser(ctx echo.Context) error {
 	return err
 }
 
+// CompleteMFA converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteMFA(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteMFA(ctx)
+	return err
+}
+
+// CompletePasswordChange converts echo context to params.
+func (w *ServerInterfaceWrapper) CompletePasswordChange(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompletePasswordChange(ctx)
+	return err
+}
+
+// RefreshSession converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshSession(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshSession(ctx)
+	return err
+}
+
+// LoginWebAuthn converts echo context to params.
+func (w *ServerInterfaceWrapper) LoginWebAuthn(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LoginWebAuthn(ctx)
+	return err
+}
+
+// GetSigningKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSigningKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSigningKeys(ctx)
+	return err
+}
+
+// GenerateSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateSigningKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GenerateSigningKey(ctx)
+	return err
+}
+
+// RotateSigningKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateSigningKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kid" -------------
+	var kid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kid", ctx.Param("kid"), &kid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RotateSigningKey(ctx, kid)
+	return err
+}
+
 // GetUsers converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
 	var err error
@@ -1151,6 +2356,78 @@ func (w *ServerInterfaceWrapper) UpdateUser(ctx echo.Context) error {
 	return err
 }
 
+// UnlockUser converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlockUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnlockUser(ctx, login)
+	return err
+}
+
+// DisableTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableTOTP(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DisableTOTP(ctx, login)
+	return err
+}
+
+// EnrollTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) EnrollTOTP(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EnrollTOTP(ctx, login)
+	return err
+}
+
+// ConfirmTOTP converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmTOTP(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmTOTP(ctx, login)
+	return err
+}
+
 // GetUserPrivileges converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserPrivileges(ctx echo.Context) error {
 	var err error
@@ -1219,6 +2496,33 @@ func (w *ServerInterfaceWrapper) GetUserRoles(ctx echo.Context) error {
 	return err
 }
 
+// DeleteUserSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUserSessions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserSessionsParams
+	// ------------- Optional query parameter "keep_current" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keep_current", ctx.QueryParams(), &params.KeepCurrent)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keep_current: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUserSessions(ctx, login, params)
+	return err
+}
+
 // GetUserSessions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserSessions(ctx echo.Context) error {
 	var err error
@@ -1295,6 +2599,102 @@ func (w *ServerInterfaceWrapper) DeleteUserSession(ctx echo.Context) error {
 	return err
 }
 
+// BeginWebAuthnLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) BeginWebAuthnLogin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BeginWebAuthnLogin(ctx, login)
+	return err
+}
+
+// GetWebAuthnCredentials converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebAuthnCredentials(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebAuthnCredentials(ctx, login)
+	return err
+}
+
+// CreateWebAuthnCredential converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebAuthnCredential(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWebAuthnCredential(ctx, login)
+	return err
+}
+
+// DeleteWebAuthnCredential converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebAuthnCredential(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	// ------------- Path parameter "credential_id" -------------
+	var credentialId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "credential_id", ctx.Param("credential_id"), &credentialId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credential_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebAuthnCredential(ctx, login, credentialId)
+	return err
+}
+
+// BeginWebAuthnRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) BeginWebAuthnRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "login" -------------
+	var login string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "login", ctx.Param("login"), &login, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BeginWebAuthnRegistration(ctx, login)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -1323,8 +2723,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJWKS)
+	router.POST(baseURL+"/v1/authorize", wrapper.Authorize)
+	router.GET(baseURL+"/v1/forward-auth", wrapper.ForwardAuth)
+	router.POST(baseURL+"/v1/introspect", wrapper.IntrospectToken)
+	router.GET(baseURL+"/v1/me", wrapper.GetMe)
+	router.GET(baseURL+"/v1/me/sessions", wrapper.GetMySessions)
+	router.DELETE(baseURL+"/v1/me/sessions/current", wrapper.DeleteCurrentSession)
+	router.DELETE(baseURL+"/v1/me/sessions/:session_id", wrapper.DeleteMySession)
 	router.PUT(baseURL+"/v1/passchanges/:login", wrapper.ChangePass)
+	router.POST(baseURL+"/v1/passresets/:login", wrapper.RequestPassReset)
 	router.PUT(baseURL+"/v1/passresets/:login", wrapper.ResetPass)
+	router.POST(baseURL+"/v1/passresets/:login/confirmation", wrapper.CompletePassReset)
 	router.GET(baseURL+"/v1/privileges", wrapper.GetPrivileges)
 	router.GET(baseURL+"/v1/roles", wrapper.GetRoles)
 	router.POST(baseURL+"/v1/roles", wrapper.CreateRole)
@@ -1339,65 +2749,168 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v1/roles/:code/users/:login", wrapper.DeleteRoleUser)
 	router.POST(baseURL+"/v1/roles/:code/users/:login", wrapper.AddRoleUser)
 	router.PUT(baseURL+"/v1/roles/:code/users/:login", wrapper.UpdateRoleUser)
+	router.POST(baseURL+"/v1/sessions/mfa", wrapper.CompleteMFA)
+	router.POST(baseURL+"/v1/sessions/password", wrapper.CompletePasswordChange)
+	router.POST(baseURL+"/v1/sessions/refresh", wrapper.RefreshSession)
+	router.POST(baseURL+"/v1/sessions/webauthn", wrapper.LoginWebAuthn)
+	router.GET(baseURL+"/v1/signing-keys", wrapper.GetSigningKeys)
+	router.POST(baseURL+"/v1/signing-keys", wrapper.GenerateSigningKey)
+	router.POST(baseURL+"/v1/signing-keys/:kid/activation", wrapper.RotateSigningKey)
 	router.GET(baseURL+"/v1/users", wrapper.GetUsers)
 	router.POST(baseURL+"/v1/users", wrapper.CreateUser)
 	router.DELETE(baseURL+"/v1/users/:login", wrapper.DeleteUser)
 	router.GET(baseURL+"/v1/users/:login", wrapper.GetUser)
 	router.PUT(baseURL+"/v1/users/:login", wrapper.UpdateUser)
+	router.DELETE(baseURL+"/v1/users/:login/lockout", wrapper.UnlockUser)
+	router.DELETE(baseURL+"/v1/users/:login/mfa/totp", wrapper.DisableTOTP)
+	router.POST(baseURL+"/v1/users/:login/mfa/totp", wrapper.EnrollTOTP)
+	router.POST(baseURL+"/v1/users/:login/mfa/totp/confirmation", wrapper.ConfirmTOTP)
 	router.GET(baseURL+"/v1/users/:login/privileges", wrapper.GetUserPrivileges)
 	router.GET(baseURL+"/v1/users/:login/roles", wrapper.GetUserRoles)
+	router.DELETE(baseURL+"/v1/users/:login/sessions", wrapper.DeleteUserSessions)
 	router.GET(baseURL+"/v1/users/:login/sessions", wrapper.GetUserSessions)
 	router.POST(baseURL+"/v1/users/:login/sessions", wrapper.Login)
 	router.DELETE(baseURL+"/v1/users/:login/sessions/:session_id", wrapper.DeleteUserSession)
+	router.POST(baseURL+"/v1/users/:login/webauthn/assertions", wrapper.BeginWebAuthnLogin)
+	router.GET(baseURL+"/v1/users/:login/webauthn/credentials", wrapper.GetWebAuthnCredentials)
+	router.POST(baseURL+"/v1/users/:login/webauthn/credentials", wrapper.CreateWebAuthnCredential)
+	router.DELETE(baseURL+"/v1/users/:login/webauthn/credentials/:credential_id", wrapper.DeleteWebAuthnCredential)
+	router.POST(baseURL+"/v1/users/:login/webauthn/registrations", wrapper.BeginWebAuthnRegistration)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd624bxxV+FWLanyuRUiAE5T+3bgu3CWJYDgrXEIw1OaI2JnfXs0M7qkBAlzRF4CIu",
-	"iv4wWvT+AowiwnQc0a8w80bBzN53Z280d2eo8B9FLc+cOfOd25wzsyegZ41sy4QmdkD3BDi9IzjS+cdb",
-	"/f49awjvIuOZMYQDeA8+HUMHs3/ZyLIhwgbkD+rDofUc9tnHPjzUx0MMuhiNoQbwsQ1BFzy2rCHUTTCZ",
-	"aADBp2MDsacfBj88CJ60Hn8GexhMNMHojm2ZDtztdNIcOFjHY/7ppwgegi74STucVtubU9snsc+f/uRJ",
-	"ih+PTBV29lbFzi8RstASHH3qQJS5Mn0dw0eGyT7Cz/WRPWQkdjs7H251drY6O0ADhxYa6Rh0+aNAA7aO",
-	"MUQm6IIHDx482Pr4463bt0EwuoORYQ7Y6JyyNcYrJ50QgD+DyIiFwlABKFFO5GHkF0e6OYB3dcfJhEiP",
-	"P+Ipr9NDho0Niy0S+SdZkEv6grxpkXdkSk/Jgrylf44urK07znML9UUA6Y0RgiYW0P0fmZHv6AX9isyX",
-	"op2Yvj+QFkylSBJS8ZFmRCI8ENQxZHDNhMfjodV7Usq2a/F1Ti37v8g7MqdnZEquyZzMWu6ik7kIPKY+",
-	"gkJETslrclmCREIGnF6cQS2YWpFkcuDS17FeuEbWEDKSq4WW5o5djnfZCMv1UVUQNrQGhgBb/GtmSbgV",
-	"Ia+Z4SJTek5m5C19WQFg/+Z4uiYLMmuROfmevqxENbBZIsqBlcsjWd38edB2JaNFf1eM70J3WQbfjIgE",
-	"fKvhYG/DIXR1TZVANYcjFcSkjHRUEooCYauYGdnCUUYwqgllHzqOYZnKyCbBjzwR/RriwO45pXybgeGo",
-	"kKuAJhvDG1RHSD9u1PMJJydV1jE/s0J5x+jKlrl4ktLlvn6ZSYJx6RJklmvFoPWjYdl4jU1NuqBXLGQV",
-	"BKyGcNky12F/Y3Rli1s8SelyX7/MOcG4fAmu1jT4JFXAq1omwgvPVyhrj6IKok5OTrq0VwxpFWSsgHA/",
-	"sgaGmbmLXG7rdakd1uDBHK7eNxL3CNzq9aDjNLrAsQnIW90w2EhXKK0+/zZdg47XmzI3+Qvqh4y8JioR",
-	"ifi8Bx2IJRRUk0znlDojLErdHUrxIQ9dCf1Kd7Dw7+9bT6AYSQgeIugcZT2QbGuJUEv8No+56NwylQCa",
-	"4xEbA/LHDrSqWiEGfwnUx2FQyJ/1pDnmWMiXV1ZM1xGlmZT8qlx81yuvz0qpKeW1cQV7IjehNylSgF5S",
-	"jIlqbcnuJj/YTmsdL4X2H+miPpu/0lMy4zVsekYW5DW5chsn3C9m9IyekTlvnogJ6WdbO52tnd37nQ+7",
-	"u53uXmd7b/f3SYltYYPPIyUF+LnNplrEEJnTM3pOZvRPZBawxB3id2TaIldkRt7wJy7rZdcQuelXfPxr",
-	"ek7m9AsyZyzRc7Kgp0k+8pfaYF48skAx4YhW+VO7r6cqt8Utl+/RYykcUWrMkMORvOghZKpME8uN7ouK",
-	"imLdqg9p3lWA1KZ/VyQPRYyQGrulLj9lm+jS9qeOZjexISk2Heu5cZ7mXSIahNF0LgBWHLXmrnKscFRh",
-	"L2cds4H6U6uy5tOvfGwE3oTAmemBvTEy8PE+U2pPBaGOILo1xkfhX7/yJ/qb390Hmnu6iisn/284xSOM",
-	"bTBhhA3z0HIXzsR6jwlxogFsYC5EfYyPtox+69bdO0ADzyBy00Kws91h07dsaOq2Abrgg+3O9o4r2CPO",
-	"XPvZTtvWHcfdOHTaJ1yZJxwwY1Gy9l/yPc/NptHNyiwPwdI6wMdHOiNwpw+6kZMOnBGkjyCGyAHdh6nB",
-	"/kEW5FsyJ9d5HoiBlU/IX7tuYJHC1XU7x11TK0LCgfswdPDPrf6xL2jvoIpu20Ojx2fQ/sxxMRaSyrPp",
-	"6YM2fDGFlYgpkyw9p6f0RYu8JlPyjkmXZQCpmXDgut6Cr6LnMVfOcuiPBWx/8luGrb1ah94TD33HZGZB",
-	"H7b2IXoGUSt0lr7+cTRFNe/hAVtjrA8Y0MBz+BgcsOd9/CPoQFwK/t+4q1IG/hyecfAHm983H/upkojy",
-	"0BdWSBpBvrAmUj/wg+YZxt8A4qxsgF74O3Jk1qJn3s4E35NjKzUnl2TOrT0D7JsU6GP9qYXA/w/ftWCA",
-	"OG3RM4YKb3/yS/rCh/zTMUTHIeZtfQD3jT/AXNgHp4h29yKRxtgw8Qe7QAMjwzRG4xHo7gTe1zAxHEAu",
-	"X7EfpF9FpFKKU+vw0IG4HJ+dXDY7AjYPalSOzA7qRhQks8W5diVB1vA99MPbcpuJtYJ3A20UYm0VItWo",
-	"1pQupNrI6lMDDdiWIw6FIsWj+O5yIuAPDp6CmqLs1Hll9aNs4UHiZqJs4TngZuxo+4Ql2RMXTUOIRfuP",
-	"/+eYehsakixchWfTCk3o38mCXLXohUuavmSJKVmQN1Higlja2xGoGErXBBnxYclGICM+HlmnzSnrbD37",
-	"c01f0D9mI8WzmGVhsj6YEBz5adIFNeaBhLn4KzcqItfFpiIs3ZTEAJn7xGs2Fqv3humytPLeUFw+bgTJ",
-	"4upvo95wyTxclHoXmsAKeXgtxlDbZDdrld3ITfmzT9jKUtD2SfD5UfVQVqCy8zLxbVg5VURpPScpmI54",
-	"yLjQFAyphY1uDcfWwtY2CYn938iCfEOm5HJp5CZvRfyxwnb1wV3W5ZvKh3h593Y2omd5N3WqkLtU0zBB",
-	"Q+xGyVafE6yfnhX0bjecUzWsbaLYbexwdaiSV2W0shRnV/xI7Cax2iRWxWlN6kB4kzlV6ui2BJWM9tpU",
-	"rgWUb7iJX2Cnim423NxTe/qUbN9uOHNKdmArkjQVofXrrMTpRwzV2lKm6DmJdcmWpKhVxk31KtV3ypv/",
-	"+JmdjU6tPMJfK7XKPk7WcF7UnHJ58VflLCjR5ZmVEokyoXJZ0CY9UTU9kZaaNJiWlO5qK+1pwuvna+1y",
-	"Wyt7K36dQINdbnLs7FKpbcWMtlQ4E8YZqda3b8niBuSeEvPOJuPjpbrhSgPKs7yqnUiq18HJ8m/qlZ0q",
-	"ZVJVgZJuo2vM8NSV9axhxiMx25HrgVfZWlfVnlZotWvOsm7KROuVh8ntv8u+Yb1hNa5+CC84d1dZb8sd",
-	"ytuo7EZlMyI8WYcChffLN6yojnfpesWtRv/6xOra6t/yvlHYjcIuqTOityA0qbOiFxVUUtuSW5t/oRcc",
-	"6vErTL8kc4F2fRS8N/RG31ESe3mA8slc6qUCjaA09SYASS6lfeJ9emT06z92EVFMpV3LK3K1nbyAWDBU",
-	"KDoFd3AFb61seCNX8J7KGlHOf8AouGgaoyHogjaIPJleZl6P9bBFL+jX9Jye0ZctcsXvn6JfcDhfu/ad",
-	"wT9cejbo5GDyQwAAAP//Rp24n9KDAAA=",
+	"H4sIAAAAAAAC/+w9aXMbx5V/ZWp2P9hVA4KkJbvEb7QsOYpFSUtSSbyJCjUEmuCYgxlkpiGKUbFKJG0r",
+	"KjpiNpWquLIbK87WfgdpwoJ4gH+h5x9t9TF3z0ViDlD8xgPofu/1u/r1O56LTb3T1TWgQVOcey4awOzq",
+	"mgnIL5/KrUXw+x4wIf6tqWsQaORHudtVlaYMFV2rf2XqGv6b2VwDHRn/9O8GWBXnxH+ru0vX6X/N+iJb",
+	"/45h6Ia4tbUliS1gNg2lixcT5/Cegr3pliTe1rVVVWkWCICz45Yk3tWNFaXVAlpx27tbbkniAx3e1Xta",
+	"q7jtH+hQoFtuSeJjTe7BNd1Q/gAKBMG3K/43+yZeeL7VWtRV8MhQnioqaAMPe3YNvQsMqFDWlVVV36BQ",
+	"t8Cq3FOhOAeNHpBEuNkF4py4ousqkDWygQF+31MM/OnfOl984nxSX/kKUHYI705RmZ2eDkNgQhn2zLTU",
+	"WCKffrgegoctkwWcm+MCxz6irBA9NoEReTItGYKGQhgGPJM7XRUvMTs980lteqY2PSNK4qpudGQozpGP",
+	"ipLYlSEEBmaNL7/88svawkLts89EZ3cTGorWxruTlfUeHPvSAQLYGHh2TCRGFRjFC0mJPGIL92egqZiK",
+	"roXh8AmvVzWgv6KRtW3tWLvoXLBeoD56a71AA+uPaIDOxLBsS2LXFg7OYn9HI3QkoHPrBRqiQzREJ2iA",
+	"fkJDNORxlwFkpucCy7zB37deoiE6Q30BjawddIwhQ31REoHW62CCQH0daA3wrEsoJLHfFe2prCr4dxOY",
+	"mBYNTYeNVaJ/PbB7/vokiTldhKV4XWYfQ6SgOiuZUbSz9ij1RugQDawX1j4aoFNrz/qGR9R3oiQqEHTI",
+	"aiHqsj/IhiFviluMPuF955tNYJo1aweN0DE+dA4AaITeCdY2Gljb1jb/NAM0o5tJXowTSBYjzi0ZEhvo",
+	"4BonS2Fh4NBivApCohCmQrA8LfEpaCvar8EKBkm7r7cVLZJPO6tyo7kmqyrQuGL+L5dXztAAyyXjFqI1",
+	"0BkaoZ/QSECH1jeYqbEMn+Pfz1GfsNaJ9ZrLQalgTsEocVS0V5s3TfxFXVuCsgELZYporCrCHYugrZjQ",
+	"IL7o2AjuXbQ8cvNQK4/qt9dkrQ0eyaYZKYtN8hGe5f4HVtLWHnrnFazvvC5ZVzbNDd1o8Yxvs2cY7OYR",
+	"ku4BOrZ2rVdoeKG1A+jbG0kOKkmUKNWzCwNyY3b2kjbpEaPVrxRddfh/HDYpluFiRCKMZIkyoONrBQQL",
+	"d+fjhGB8xogrDXor2p9dfrj8SCB+11AgS5yhEXWVXQk8Jp9Eh8Sb3rZ2UJ/sfEictTM0tPa59yvwVGkC",
+	"rmzj1Q/JKkM0EKxd4qRjOXxHlj/ERvUInVj72DE7R0NrG/vIXi/tnYQp0meO4ymmifA7Ef0T9dEBGhH3",
+	"+p1AcNm1dtCBtYuOfycmi7NzFIxqiWd6SfthL0Ad1UItBweNG9MfXQwNWwdQ0Stc4DmYzF4QE+Kx3Neb",
+	"6/iaXgU8bl0JPMrXwMweAFi4MxJxN0U/cJWt565qbaMDvJm1jfrenfexvn4rUMVofYdOeWo/4s4a66aE",
+	"SVWutxIFz1V0WqJwrYbkuMq9PDdmEvwJydUEGdRIeqfEWT3LgU2+j8LH6IqrgTDCZeoCbVUxOviyEK0A",
+	"4q4ZzGIR6TpBI/SzfXOooT4RqQE6s3bQ0PoaDbFsWjvUMiZLR7Sb7gH5kiJAF2nqT4GxeVtvgaKlIIRJ",
+	"iZxgABkC/D4UyQgrqt5cT/WYGnjL5bhIVFk7mp3oTP6DiyZ3UhmHyCUCNCDr+QGUHNSSKHNZlauroFgW",
+	"C8FeNofFPgpn4TDQkRWVwxh/RkfkMXBbsP5EAhnHzGeg/ggx0iPrpbVj7XmcB75DzuNHFd/AwvuSP5O1",
+	"sfGnjj9Rd4OohSIY+w3hYwzqAGvXU2s/06oxfsob1zmJWzJ7tJSJFKWM5P1eslwlvounkSu8SAly5YX9",
+	"KjotISTLVh72g8htA7SABhVZjc78gRDg9fBrOlsmJBF2ltuU58MPyWcFdCisyCb4+EbPULmxV2CAjq5t",
+	"csTse3TE93oE61t8/2CP02dRGQZNVQEabOBjaXzFzTVw4KYf/UyG8i+XHj5IBDqlKUXH+LHTesm/rkFD",
+	"1syubkAzBrA2gMvO5z74MMObf9ANtOnMoYvEO+RszDOmZ0J3yRK0UDRe5YnrZ0AFENymr2hLNKml5KhT",
+	"HEhlE2phs0o0WtisGHmqlG0ZA1EVyFQZ6lSJKBXIveQDUzZxKkOYqhGlSuqYA0+FSGRWjUZmVYiU0d8s",
+	"ilSVdBcVU15RQWJMtwAahSEpjyx3NENX1bFFuulyHaAVmzkaxqI8it7VjQ3ZaGEBKJnROJBQbMohzOdA",
+	"A4YMwZLS1hSt/QXYvDTLuUsVym7RmJTHdp8DuAAmL8rpA7tc6m2mcjcyxVvZiqWWWnBRK5XSzq12jJR2",
+	"1iyb1mHkSqW1L4owRnr71i2b5nwkS6f75L3lBgAvnYLYHI2ZaW0LVza/+lArndBjJnIVCFwN4rou4jjd",
+	"Cp/bWyahOeiVSm4sVXmYO9+6ZROdj2TpdJ/Iy0c1osI2IOPVxPaSVeDX6mjk1IHlSbzpVStIzQAaM0tX",
+	"gcbVIG44zj5GUvMTT8okfAy65R3DPQ0autkFTbiM66Qik8WiSrnehLua+Eq5IsvCGvjPjTUlolZ9iM49",
+	"q6Qu80qFYgyLyU2oPE2o48G5a3Z1zZBmhUZ21QHPupzF/kJT3HDe6oiUGJ9ZL2luGUm1JSQ9Rv3gVvtB",
+	"ijh5qIoGP77hAqBoELSpplFkGA8ALss5x3VDZMfsG5hNvQuimv34mwX5es0I1kuW6vfWbk1zgEnJYxhT",
+	"aWXLIoztaSOJZm+Fs95/k1qsITqLS/2NZ0LGPhm4sDzJ/+Wvv+A1lGpzMxCbxlPu3wH3r+sZzys+nXId",
+	"bkaqiPhvalzoeiYf6mfcv25y/hogMgaQIh1B5yXA0ajrYNNMbc3wYSUlgpIFeRD4KqJDcND880ZPg9xi",
+	"gf9BI3RKz02ihQHbWKJJawR6fIESRix9tEdCnzROQG/RqV32NCWgH6wdaxvXPVG1Zu2igbXD6qMEdEBK",
+	"pI7RkJmVYzSIk0Zfl7oaVEiS/TgakhHIHZTQwNOgjOTwNyjV7JT+BlwzdAhV8peeCYxGOK0/gnkYcJHn",
+	"Ft0ecNKLQt9csgNNbEXoeLpKlVgAGkBg9mIILNydv+0U0ZYG/oR21/DjMIl9NQIY3JpwDMpzlggYbrOx",
+	"qIqaHlwDGlSaMtSNhk3aqIoa74dxgcrVrKhpOrfdBs8rNOSNe63ERSbD1plKW5NhzwAx1HM+k4gzcSPW",
+	"ZK2lxq2HP/UL8qGEBWOqhnwnxK8iCjO2F90UEjPhhjiIyCRbtBAuE2vZwpjcuiKYlGfpfB5jvq1ubMWM",
+	"zpmJOkJ9rG7x5euUqOcdrNK3WRs+fBHN0DKGh1xA+qqC3hlrj3PqKa1Hg7Fh65ZLcw2o9cLaZUiQ2tZz",
+	"0peLRO5oePCANiQJ1v1n6YFy5t/G2p8ToK43zDXdgBL5UdW1tiRo+IcNYJCfet0u+6mltBVIfjK7oKnI",
+	"qiQ0dQ3KimY2usAwdY3+qdPRNUkwQM8E3IZkHWCachuk6LcRhjhdPxZ3D+6JeJus8+nH8X18fUIiS5ZT",
+	"Acdp7cGDcxGsGsBcc2pqIvxdg35smf82wBapxT0GhIIhngXTADa5bgUfk/L0PjvkqnTAiwKnTAKZAJbQ",
+	"0zms8iPbGHpALPnwAnBcwdYfIRxL5UyvFuM8auK/O1qaEx/3q/GkNy53NSmNxi6/dIf39UgDbMf5AfnY",
+	"EymrReYb3mSLGxC3RPj09eKA01UQ24oq/PBdmjsT31HJn/cfN0+nUijFzadxssKvwgApT/OwC5Ix0Gkr",
+	"5QiqRR2mL+0rwIBGgFOejbETBMNqyQAyBK1GQp6JtU1ego88aS6+HA0fF92qzUzXZmaXpz+Zm52euzk9",
+	"dXP2P1O/9kZPwPjR3tHal8hrr0DjvRgi/3v2O5YXwxrNkUv/WxYiHlnbIr+f4yVi1JKAw8r07ZkFE2iX",
+	"KTRkcf0RiQ7QV3Lvs3QIfzY6K+lASAftHTTAaThpMo/yOq4xp/Yo3diGhz6S9iX25m+9sPbQIbnq4xCO",
+	"uwdvoJEkqrIJGyYAWiKNnXQJ0vCcro4TnXA4xXplE15izEWOZI9wyoDkR+yTUBPOlCKfxux6Rs7sBfnQ",
+	"CeoL84+Xf9FYurO0dO/hg8b9+aXlxtKdOw8a9x4s31n81fz9sR5VB8A1vcUVLIroCB34O1nbvorndtNZ",
+	"lUVJ3AAr+E3B2wuxQW82XKeGvIXIba5YY9tXm8f/G8fpBjQifRBxVZxPvLh60tHZGZIL/06TmIhMutku",
+	"1q7LBk4kEVOWRqqGTtzQ+sabtzdCh1z9xFK7QqJxgqlCEmF20GlgC2461hiTu4IZTITcGFApLpUu0Kkh",
+	"bBBB0wARBgCzA804oq9lH806z4M42vfSE8dFhw4fhxnSUHjrE/k9IRpUh13M4XP1ur3BfyzW0HHUkkFL",
+	"TFGgG0XRwN+XmROdo/9uNO3/pxjDMGDBdmsvduoNTQWjFouqLOu1zasYQ5xFSde9eGPDAPw8KjzWsK9f",
+	"gR5NYUDKc9Ued1tyqP9a8vTfS4z75e5Y7nlEQ1SFg0nT3vlKdwz3kmLSqszDsFeBpa5HSfPoURElVCXD",
+	"kLa9fDn95CUBHbCaEHvi0xm9+bxlaej7tldcVMd4vq5L1m6TWVQchr1EhuWGNUvn0QLClrHc5Svmz5BI",
+	"MInh4Pxj62kti12Nfk3wYggeMdE7TP7xZEU7MZZgYtfQ2vcHtUIk0cmGZvqJ2cT4PmTfisnMtReOJY83",
+	"NXcJqKAJuXF6A5hKC2iQRaa4IbZfAUNZxSulejz0rsj5fhzQpC0/HiXhUo5bHNQniXI7OIKHAzbW1yRa",
+	"cUqttfCot6IqzS/ApltRHVgYZ6iHMtU8IxG4lJAjKZpqAD3/21tSbHLhX6w96ogkpoWDZ0211wIuyuYl",
+	"SuI/Y1Do3K4E3d4KI+4j2ZA7l9mILAAgv/uB0U0vOuqmorUfyQYk34RKBzA1GB2EJ7V9geccer3lKIFD",
+	"AZ2SmuUTcpkmI9PRGUnd/CZdQXSPOS1p8KHeXVCuukycRMk/ATF4HC7+XLaI5GTfVJAkOWXLXfzh7wUt",
+	"/2ZvXn3rW0pob1S4tNckF4oyJsNcMC5KguTMsnoW9L1TpDtUj/iHjjc/Sl6MCPbvSVaJ/JcwQjoauJop",
+	"qhg+4cXk9sOlO+k0QyYE8OZxGCyCtmJCQ+Zb+yKcIr5kj8FBCnoHl/SQfEYjgs8v6Oa6ohgPgM/hG5uz",
+	"41+X7+vgqH0RvsJ4nBuje49/HFU19Bk9Zoye36i7Fjx0VBl96ogcMMXsqvLmAz47ZzeYEVGz1OYzrTxJ",
+	"PsjDeG9JogmaPUOBm0uYZVloCMgGMDA53N/u2if5y18vi5JIGJwEjch/XVjXIOyKW3hhRVvV6cVegzKZ",
+	"ikc4EBIPBftUNaUlzD+6J0riU2DQtChxZmqaqjqgyV1FnBM/mpqemqEX7zUCXH1qA6hqbV3TN7T6Vxvr",
+	"5pRdadvmvlb/YCcv4KCU17Vw1fK5230JN80IpAEIHyzevS18cnPmkw+JqgTUWmAREz8HEDcmESWnlJTA",
+	"yOKUGHP2tC53uypjv7oNL1USKXqWLAFISepH7eEX5ACh3DbxqW+AFfEJ/kP96Uwd01c3lD/QQItuwvim",
+	"U3a20ohYoV0cpvO3GULH9vBlTy8iax+dhkgy7+xMmRKY8FO9tTk2ejjr29F3DmV4psCTeIb6DDYqMNDo",
+	"ga0cT9ADsRvGjjhPSbyZ5843+Tvf0yAwNFkVloDxFBiCG2K2tYM491u/Xvjtk60nEby3SidO1GSmQNog",
+	"Fff5Dogw3FuSsDW0XqE+szqn1i7lyxckoW7b2hU+0NqK9kzAmzUYv0nCsiGDVWVd8Ay/CAuv559EwTAP",
+	"1iS4BqD9mx8U2p7NA8iUgF9HcPrhKVHtdiOy1zHZSBjhn/A/CB1G6Fj4TY3BBFq1X2ChxVpUnBN/3wPG",
+	"pq3T58Re14QGkDui5OGDkEng9CAaWDtsmLq1zUJxTqJOUD7IxmtAbgHD3dkL4ALNY8sGwxvSs+i7ZAim",
+	"BPRf9AXLT6Ygq9j9hk7QyPuq5a93FW5MT6dB6bGhZMTn/6jKHA9F2ZFH7/8kRy0VMbGGr6ckhgeB4jc1",
+	"/KXaff686hQN4XAaFE3npW9IjlneJZ2sht58slNSUPpHkma2Q9iEKpJjmst3RBjlZzfXmL12Eqaz/oR9",
+	"MZye95YUpr60XVt0GmQzLI3+PMh4xrCJ4EnwvlAy7uSTAhPjRr58aZunEGd+KrcExy3AYMyUAsZjzXG/",
+	"WhSOj0qB465urCitFtDG7VdkAWK8voXi9H+McWy/x2zKFO85uZN+G+r7ybz6jz+eDTsGgSaTqR3ZZ7WN",
+	"jY0avvPWeoYKNPx810pP1YgmspX3b2M6wxbi5cb0BM2dHzsgxsMl1m7X1a0CMXjH1i4pWiA6PDIQcO7L",
+	"sfdfSvu8e+gCyPMWGhosVsjJhuaCFXCedZOacDPTwZKqDVbog/94RusJPO2x0h8+93Sd4V2JV5V/krcU",
+	"2oDLDiqTu8i31l7EhaIrt8GSe2V31YbX0LfAqtxToTg3e9MT2OspGvxoVpTEjqIpHVynMhOO8nEc5x8J",
+	"hK88FEwFqb66agKYDs7pWDCnOWA+yVmCuMPlCpMk7vy3IiWq7isqVAHkhbn/FnwjCerNd369+AGt3cFX",
+	"r7Al583iz1NNxs3+L+ys44Ao/Mifs58aSmsr+7mH1CoaBc//4mqV0skRi0TF+j06mgpWThIdhWPTropy",
+	"EY5VU0Xe7QOYpuLIG9M3opZ14Kw/0OFdvae18mHhEMCFcG9XNk1aRGnWn5NMUsK43R6/Fu6U3Se8qa0R",
+	"PIhv11Igrh7pAOKwVqAoLMTBtAEbbp+SyLyp2vJzuNlOpU3PyDxbP7K+cYw7CT84QmvtWq99xaT07dHF",
+	"nIk1KUOlRXQY9jPylnMpUvLci3UAug3bSnGdilVZNUG4MJSK7/hfO9wTnpjroBfkVHpmOlnPfCq3fHGd",
+	"jKqJNU7KCT28OjcK1DX0JjDJnHfhjgYVmm13M1daF6YiDWAC6NeQ/GjM3zzBen8xAObRA/wwa0c0PSoU",
+	"zzjgFNZ6h+JQwbb7LJ54gv9UIfezFCtEKA86aoE4JjushoYm8ZDCbmuHNsAgoxhesWaUh3QFO+c5rTcS",
+	"bNVWIY2ep2sS1zCvEG85rkVeJkHyS4oU6TAc2A9XyQ4DfhoZo7vgNFu79hauprcQaq5YeWeB22vxyvgK",
+	"3A6OJbkK3E6LJXgK9aaurSo4LGen3Ea7DZx4QEr3wZ/DdUoqXwXWG+g7/HIqYc2xjQbj1K+3dZx/D0Fl",
+	"bXgO95MgypNzTQlDnpcG+ij5K76n2rFeV6LQLPPWEgXTJX0uW+c4U7EzPuG4c03CuY7oHe9Zxh3Aff0s",
+	"M7HPMvxR8UU9y/BnuOdumA1dvYR8OMMVuFJBxnxfC8TECkRoAn1RshCaD5+fGEhRfueP3g6r/u5NAW/P",
+	"AKwvTU6Z7u4Gk+NUeUBO5U3dSnaNbuvaqqrQKp2bucJanOKtP8e5YfEPov9LmPAEDZIYkT6WMUaM17l0",
+	"joy1S5emo8bpk6q7OOe6wfpQVOkxMwOPlf6OWRyPSanNOdNwLE8pirWYTk7LV5PDRAyx6nNQANDcjWKP",
+	"n1DL2oYlKyO3W1tKpvH3JMtRHY3fQIc7UVbeQPM7RlaT9fkdIgs10BeMJfDCB4lKNkMsIRd1K13f0Cbq",
+	"hlZu2IIPQZkCWn/u/NzI7l1zRHaYxuV2aFAVoWVWlYMOf0s/0Sro5XObYVfd3ef2yy4hmvFXnEBL82Mu",
+	"yOrzrdY1n+fTySBA2clpaBACvOqCGQVxVa5T2USS05b/WirHf+uYPMFMmCBR9WteweLJcyd7JjAyXvUi",
+	"Mv2TL3yPyV7Xd73ru17iTYuwSlnXPN/mJYmkN88884tJ1pIwG+mqyObVyPT20zalXcqcOlWN619w8ENF",
+	"bn5JAvE66vb3HktDbvc+7wSZSbnyZZDcIvIkK3ClLFTSMz7Opbd7/hlL15I+9qvNRAl79PivUiszbuaO",
+	"YiHOrdMaAc/wzVb/4A5Q8TY3RIfOxG0ymfdr1EfH7E99gcRghv7PR08kjaxpWLg7L+ZbQrBwd37iigcI",
+	"zHmJx0zyV/LsNMfBES+f1Gfuxmy+IMxyQbhPB32R/W/lu/8t3v7Lui4syNqm3X7Q9LfIXATQ2KzNr7IB",
+	"CdyByqRvOmnbKNjTznG5I+t5QyzgOal2OiYmN2TM3IDEVk71Gh4yjKdSw1GFzmTzC+tDa5s5Qayw2zMK",
+	"0R7IHx7Hj5WiT3fa4/zRcWxxFwaVlt6L+ZdVuZtNZG2VF/ycNOXsbEGQV6RmKgzYmMXRAKsGMNdipPEH",
+	"dOB3HWgovE84L9A/n/QyZEvW3P9Zu5wCdfIhb6OuPIqkvZtMUKW0H+xUsnRxFyLDNfxm7liOmb03wArG",
+	"WbuU902HSGB/2h4dQouED1mX9VMB9UljZm7TZ04PT9I/214rJ9737TExrB+A+or621wsi/a4+UAU6XNH",
+	"QPD+ed1cQoxJEyptTdHatXWwme2x21Z7Dm2OWFFocGgO7817ie76Bd403ydTz05lPJpytq9IMSPz2dhl",
+	"xzNsMO4sBRw+OkP94KgAwW646blWMdkZcgYDfQ40/CtwqZMvFwR3K4ETokAoJs7oEfL683WltVWXm1B5",
+	"mtR45R+8kw4f8SATD4VdfR0GeSGx+2ri0Ez+c8B6hTqxBtGufnpWFMSF8HDmdKxAF4+o3CyeeUqXjnWd",
+	"J1XVPKnScqQKzI9Kb+jTvvzSKnj26ptfF4OJev/0gpzXZTNj44OxxjhD6JUZ1wwBU5xhuVBSYcZcwlT5",
+	"FG6iQ6g1Q0JH+wnJ+rvaGX9F5gBdqL9DlkkzGRl2knmTYTsRTSCqmGeWMb0sK2eFG0MUpgzzSgWbwDSw",
+	"65TPGIIU76vUVb257szwj/BZfqRzPu1XKzqz352fQ0aJ4uDMkM1gPkZD3+vWOeG2ExowHFCfxHrpNSbn",
+	"rO9uOE/isYYhfI+siItw4ZfP8NYlMGRnVa5DHXZjOfIHa8cJ03neU6PzFsPutEKuIcsPlx+9J4zlwTgf",
+	"F6XU9nsc7EqKnfzF2qNDl9Ex6QaOjsnYkB3UFzB07hBmoh59XGzt27XOWKH+bP+xlvHp/45m6Kr6HrG2",
+	"i3BVboWlykKYHCXq8bQN+vGd84glvLxAP6MjV7FHSQr5JB7YcerJR5dIQjp6SxyVvvUK9emwHfIBay9r",
+	"ujqBvYqilEeWqIPsBKWGemCuTg5buZ1owzQpQf7H2Ooua7wpQ+u7MicTXb/9Vfntr9x+eHwIShDj7I39",
+	"nV7+meU2XaP/a5G9FtmIgHZZgwZCm5cgqHY6emLchIydYuOqcLNud2DVu4u8RtpT4K+HAFZhCGDuz67B",
+	"of8Fj6HngVCVN9KLSRJTHxMhRtfmr8rmrzTRjNj/8iNv+ZGiP3ODod9yAzj3Gddf8dANQXOyCrBScens",
+	"9Gxeu3JT1NC/yOvmgbXrDv8+jw5MshcnEoH0vTeN0Gl1isBKLf4qr+jrvS/2qsBlpP6c/dRQWvk30PcY",
+	"oUq7Ud+joynfzYG/lUu6CqZfZiscr0RO5WWrwC8vFnaleF02TWBA+7oeXTmFq5DwW3BisXjUq9d5yO5r",
+	"8lOlLUPdmGoaoAU0qMiqOdUG8IMPpwT0Y2jSNc7PexsoXz+zk+fCUPHsJYOFfZh8wttT5bWEU4gG6C3d",
+	"DC9cI61TXMn02uNDggy+iPtndE8J6K/0to1Xwdl+h+xzDrgnttB7GllF3tkZSL4aVQ6NCVAkKnBMs6F+",
+	"RgN64Sel+4K1TeMJp9ae9Uem4M7sN0vnIEMa7VPgKdd9T7zYMM4T49LyQK+6ZoyGeTxl4RG6z6N2Llws",
+	"7vbIyBBmsb902wPAe5OZzkF+IhLVY+AuKb+L18uF3Bp/ou2+iHH4lgaE3XLmMXV1ofVNYaK8B9kpEZhP",
+	"WBkgD4HqZMOXPj85mjxluusek1V/7v6S/VLL0wfZntwqLfrShdosCOhQWJFN8PGNnqHyQfHRvGK34QtL",
+	"9ASWJlZTPA3QVkxIRSXthTq9xb7s1bpJFNsHH8Zf8RY9OLwnXmkk/tdJ3Gmok7PEkS/gFSgHYtU8J9ZF",
+	"zyfDyt4fsrEzHeyKB+trEqo5o+4uNo0uZ+JNt55s/f8AkiBk29BJAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
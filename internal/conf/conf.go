@@ -13,11 +13,116 @@ type DBConfig struct {
 }
 
 type SessionConfig struct {
-	URL             string        `envconfig:"AUTH_SESSION_URL" required:"true"`
-	SigningKey      string        `envconfig:"AUTH_SESSION_SIGNING_KEY" required:"true"`
-	SessionTTL      time.Duration `envconfig:"AUTH_SESSION_TTL" default:"24h"`
-	AccessTokenTTL  time.Duration `envconfig:"AUTH_ACCESS_TOKEN_TTL" default:"15m"`
-	RefreshTokenTTL time.Duration `envconfig:"AUTH_REFRESH_TOKEN_TTL" default:"24h"`
+	URL                     string            `envconfig:"AUTH_SESSION_URL" required:"true"`
+	SigningKey              string            `envconfig:"AUTH_SESSION_SIGNING_KEY"`      // Общий секрет HS256
+	SigningKeyFile          string            `envconfig:"AUTH_SESSION_SIGNING_KEY_FILE"` // Закрытый ключ RSA, ECDSA или Ed25519 в формате PEM
+	SigningKeyID            string            `envconfig:"AUTH_SESSION_SIGNING_KEY_ID"`
+	RetiredSigningKeys      map[string]string `envconfig:"AUTH_SESSION_RETIRED_SIGNING_KEYS"`      // Пары kid:secret, секреты в base64
+	RetiredSigningKeyFiles  []string          `envconfig:"AUTH_SESSION_RETIRED_SIGNING_KEY_FILES"` // Пути к ключам PEM
+	SigningKeySyncInterval  time.Duration     `envconfig:"AUTH_SESSION_SIGNING_KEY_SYNC_INTERVAL" default:"10s"`
+	SigningKeyEncryptionKey string            `envconfig:"AUTH_SESSION_SIGNING_KEY_ENCRYPTION_KEY"` // Ключ AES-256 в base64, без ключа создание ключей подписи недоступно
+	SessionTTL              time.Duration     `envconfig:"AUTH_SESSION_TTL" default:"24h"`
+	AccessTokenTTL          time.Duration     `envconfig:"AUTH_ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTokenTTL         time.Duration     `envconfig:"AUTH_REFRESH_TOKEN_TTL" default:"24h"`
+	LastSeenInterval        time.Duration     `envconfig:"AUTH_SESSION_LAST_SEEN_INTERVAL" default:"1m"` // 0 - время обращения не записывается
+}
+
+// Ограничение неудачных попыток входа
+type LockoutConfig struct {
+	Threshold          int64         `envconfig:"AUTH_LOCKOUT_THRESHOLD" default:"5"` // 0 - без временной блокировки
+	Duration           time.Duration `envconfig:"AUTH_LOCKOUT_DURATION" default:"15m"`
+	BaseDelay          time.Duration `envconfig:"AUTH_LOCKOUT_BASE_DELAY" default:"1s"`
+	MaxDelay           time.Duration `envconfig:"AUTH_LOCKOUT_MAX_DELAY" default:"1m"`
+	Window             time.Duration `envconfig:"AUTH_LOCKOUT_WINDOW" default:"1h"`
+	PermanentThreshold int64         `envconfig:"AUTH_LOCKOUT_PERMANENT_THRESHOLD" default:"0"` // 0 - без блокировки пользователя
+}
+
+// Ограничение частоты запросов: скорость в запросах в секунду, 0 - без ограничения
+type RateLimitConfig struct {
+	Enabled      bool    `envconfig:"AUTH_RATE_LIMIT_ENABLED" default:"true"`
+	IPRate       float64 `envconfig:"AUTH_RATE_LIMIT_IP_RATE" default:"50"`
+	IPBurst      int     `envconfig:"AUTH_RATE_LIMIT_IP_BURST" default:"100"`
+	SessionRate  float64 `envconfig:"AUTH_RATE_LIMIT_SESSION_RATE" default:"20"`
+	SessionBurst int     `envconfig:"AUTH_RATE_LIMIT_SESSION_BURST" default:"40"`
+	LoginIPRate  float64 `envconfig:"AUTH_RATE_LIMIT_LOGIN_IP_RATE" default:"1"` // Вход с одного адреса
+	LoginIPBurst int     `envconfig:"AUTH_RATE_LIMIT_LOGIN_IP_BURST" default:"10"`
+	LoginRate    float64 `envconfig:"AUTH_RATE_LIMIT_LOGIN_RATE" default:"0.2"` // Вход под одним логином
+	LoginBurst   int     `envconfig:"AUTH_RATE_LIMIT_LOGIN_BURST" default:"5"`
+	FailOpen     bool    `envconfig:"AUTH_RATE_LIMIT_FAIL_OPEN" default:"true"` // Пропускать запросы при недоступности хранилища
+	RoutesFile   string  `envconfig:"AUTH_RATE_LIMIT_ROUTES_FILE"`              // Ограничения эндпоинтов в формате JSON
+}
+
+// Второй фактор аутентификации
+type MFAConfig struct {
+	Issuer            string        `envconfig:"AUTH_MFA_ISSUER" default:"auth-id"`
+	EncryptionKey     string        `envconfig:"AUTH_MFA_ENCRYPTION_KEY"` // Ключ AES-256 в base64, без ключа подключение недоступно
+	ChallengeTTL      time.Duration `envconfig:"AUTH_MFA_CHALLENGE_TTL" default:"5m"`
+	ChallengeAttempts int64         `envconfig:"AUTH_MFA_CHALLENGE_ATTEMPTS" default:"5"`
+}
+
+// Вход ключами WebAuthn, без идентификатора проверяющей стороны недоступен
+type WebAuthnConfig struct {
+	RPID    string        `envconfig:"AUTH_WEBAUTHN_RP_ID"` // Домен, например id.example.com
+	RPName  string        `envconfig:"AUTH_WEBAUTHN_RP_NAME" default:"auth-id"`
+	Origins []string      `envconfig:"AUTH_WEBAUTHN_ORIGINS"` // По умолчанию https://<RPID>
+	Timeout time.Duration `envconfig:"AUTH_WEBAUTHN_TIMEOUT" default:"2m"`
+	// Секрет в base64 для ключей-приманок неизвестных логинов, общий для всех экземпляров
+	DecoyKey string `envconfig:"AUTH_WEBAUTHN_DECOY_KEY"`
+}
+
+// Правила выбора паролей
+type PasswordConfig struct {
+	MinLength      int           `envconfig:"AUTH_PASSWORD_MIN_LENGTH" default:"8"`
+	MaxLength      int           `envconfig:"AUTH_PASSWORD_MAX_LENGTH" default:"72"` // В байтах, ограничение bcrypt
+	RequireLower   bool          `envconfig:"AUTH_PASSWORD_REQUIRE_LOWER" default:"false"`
+	RequireUpper   bool          `envconfig:"AUTH_PASSWORD_REQUIRE_UPPER" default:"false"`
+	RequireDigit   bool          `envconfig:"AUTH_PASSWORD_REQUIRE_DIGIT" default:"false"`
+	RequireSpecial bool          `envconfig:"AUTH_PASSWORD_REQUIRE_SPECIAL" default:"false"`
+	RejectPersonal bool          `envconfig:"AUTH_PASSWORD_REJECT_PERSONAL" default:"true"` // Запрет логина и имени в пароле
+	DenylistFile   string        `envconfig:"AUTH_PASSWORD_DENYLIST_FILE"`                  // Пароли или хеши SHA-1 по одному на строку
+	HistorySize    int           `envconfig:"AUTH_PASSWORD_HISTORY_SIZE" default:"5"`       // 0 - запрещен только текущий пароль
+	MaxAge         time.Duration `envconfig:"AUTH_PASSWORD_MAX_AGE" default:"0"`            // 0 - без ограничения срока
+}
+
+// Хеширование паролей. Хеши с устаревшими параметрами пересчитываются при входе
+type PasswordHashConfig struct {
+	Algorithm      string            `envconfig:"AUTH_PASSWORD_HASH_ALGORITHM" default:"argon2id"` // argon2id, scrypt или bcrypt
+	Argon2Memory   uint32            `envconfig:"AUTH_PASSWORD_ARGON2_MEMORY" default:"19456"`     // В КиБ
+	Argon2Time     uint32            `envconfig:"AUTH_PASSWORD_ARGON2_TIME" default:"2"`
+	Argon2Threads  uint8             `envconfig:"AUTH_PASSWORD_ARGON2_THREADS" default:"1"`
+	ScryptLogN     uint8             `envconfig:"AUTH_PASSWORD_SCRYPT_LOG_N" default:"17"`
+	ScryptR        uint32            `envconfig:"AUTH_PASSWORD_SCRYPT_R" default:"8"`
+	ScryptP        uint32            `envconfig:"AUTH_PASSWORD_SCRYPT_P" default:"1"`
+	BcryptCost     int               `envconfig:"AUTH_PASSWORD_BCRYPT_COST" default:"10"`
+	Pepper         string            `envconfig:"AUTH_PASSWORD_PEPPER"` // Секрет сервера в base64, пусто - без перца
+	PepperID       string            `envconfig:"AUTH_PASSWORD_PEPPER_ID" default:"1"`
+	RetiredPeppers map[string]string `envconfig:"AUTH_PASSWORD_RETIRED_PEPPERS"` // Пары id:secret для проверки прежних хешей, секреты в base64
+}
+
+// Сброс забытого пароля токеном из письма
+type PassResetConfig struct {
+	TTL time.Duration `envconfig:"AUTH_PASSWORD_RESET_TTL" default:"15m"`
+	URL string        `envconfig:"AUTH_PASSWORD_RESET_URL"` // Страница сброса пароля, пусто - в письме только токен
+}
+
+// Доставка уведомлений пользователям
+type NotifierConfig struct {
+	Kind         string        `envconfig:"AUTH_NOTIFIER" default:"log"` // log - запись в журнал, smtp - отправка писем
+	SMTPAddr     string        `envconfig:"AUTH_SMTP_ADDR"`              // host:port
+	SMTPUsername string        `envconfig:"AUTH_SMTP_USERNAME"`          // Пусто - без аутентификации
+	SMTPPassword string        `envconfig:"AUTH_SMTP_PASSWORD"`
+	SMTPFrom     string        `envconfig:"AUTH_SMTP_FROM"`
+	SMTPTLS      string        `envconfig:"AUTH_SMTP_TLS" default:"starttls"` // none, starttls или tls
+	SMTPTimeout  time.Duration `envconfig:"AUTH_SMTP_TIMEOUT" default:"10s"`
+}
+
+// Определение адреса клиента за обратным прокси
+type ProxyConfig struct {
+	TrustedNets []string `envconfig:"AUTH_TRUSTED_PROXIES"` // CIDR прокси, которым доверяется X-Forwarded-For, пусто - адрес соединения
+}
+
+type ExtAuthzConfig struct {
+	Port int `envconfig:"AUTH_EXT_AUTHZ_PORT" default:"9090"`
 }
 
 type LogConfig struct {
@@ -34,9 +139,20 @@ type Config struct {
 	Debug       bool   `envconfig:"AUTH_DEBUG" default:"false"`
 	ServiceName string `envconfig:"AUTH_SERVER_SERVICE_NAME" required:"true"`
 	Environment string `envconfig:"AUTH_ENVIRONMENT" required:"true"`
+	RoutesFile  string `envconfig:"AUTH_ROUTES_FILE"` // Таблица маршрутов защищаемых сервисов в формате JSON
+	Proxy       ProxyConfig
 	DB          DBConfig
 	Log         LogConfig
 	Session     SessionConfig
+	Lockout     LockoutConfig
+	RateLimit   RateLimitConfig
+	MFA         MFAConfig
+	WebAuthn    WebAuthnConfig
+	Password    PasswordConfig
+	PassHash    PasswordHashConfig
+	PassReset   PassResetConfig
+	Notifier    NotifierConfig
+	ExtAuthz    ExtAuthzConfig
 	Metrics     MetricsConfig
 }
 
@@ -49,5 +165,20 @@ func New() *Config {
 		log.Fatal(err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		err = fmt.Errorf("error while validate env config | %w", err)
+
+		log.Fatal(err)
+	}
+
 	return cfg
 }
+
+// Проверка значений, которые приводят к панике при запуске фоновых задач
+func (c *Config) validate() error {
+	if c.Session.SigningKeySyncInterval <= 0 {
+		return fmt.Errorf("AUTH_SESSION_SIGNING_KEY_SYNC_INTERVAL must be positive, got %s", c.Session.SigningKeySyncInterval)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package grpctransport
+
+import (
+	"context"
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	HeaderLogin   = "x-auth-login"
+	HeaderSession = "x-auth-session"
+)
+
+// Проверка запроса по протоколу Envoy ext_authz
+func (t *Transport) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+
+	var (
+		upstream = httpReq.GetHost()
+		method   = httpReq.GetMethod()
+		path     = httpReq.GetPath()
+	)
+
+	// Таблица маршрутов сервиса определяется по заголовку Host
+	route, err := t.routes.Match(upstream, method, path)
+	if err != nil {
+		t.logger.Debug("failed to match route",
+			zap.String("upstream", upstream),
+			zap.String("method", method),
+			zap.String("path", path),
+			zap.Error(err),
+		)
+
+		return t.denied(codes.PermissionDenied, typev3.StatusCode_Forbidden, err), nil
+	}
+
+	// Заголовки идентификации не должны приходить от клиента
+	if route.Public {
+		return &authv3.CheckResponse{ //nolint:exhaustruct
+			Status: &rpcstatus.Status{Code: int32(codes.OK)}, //nolint:exhaustruct
+			HttpResponse: &authv3.CheckResponse_OkResponse{
+				OkResponse: &authv3.OkHttpResponse{ //nolint:exhaustruct
+					HeadersToRemove: []string{HeaderLogin, HeaderSession},
+				},
+			},
+		}, nil
+	}
+
+	// Envoy передает имена заголовков в нижнем регистре
+	value, err := authidjwt.ExtractToken([]string{httpReq.GetHeaders()["authorization"]})
+	if err != nil || value == "" {
+		return t.denied(codes.Unauthenticated, typev3.StatusCode_Unauthorized, fmt.Errorf("token not found")), nil
+	}
+
+	token, err := authidjwt.ParseToken(t.keys, []byte(value))
+	if err != nil {
+		return t.denied(codes.Unauthenticated, typev3.StatusCode_Unauthorized, err), nil
+	}
+
+	if !token.Valid || !token.AccessOnly {
+		return t.denied(codes.Unauthenticated, typev3.StatusCode_Unauthorized, fmt.Errorf("token not valid")), nil
+	}
+
+	cart, err := t.sessionSvc.Get(ctx, token.SessionID)
+	if err != nil {
+		return t.denied(codes.Unauthenticated, typev3.StatusCode_Unauthorized, err), nil
+	}
+
+	if err = t.sessionSvc.Search(ctx, token.SessionID, route.Privilege); err != nil {
+		return t.denied(codes.PermissionDenied, typev3.StatusCode_Forbidden, err), nil
+	}
+
+	return &authv3.CheckResponse{ //nolint:exhaustruct
+		Status: &rpcstatus.Status{Code: int32(codes.OK)}, //nolint:exhaustruct
+		HttpResponse: &authv3.CheckResponse_OkResponse{
+			OkResponse: &authv3.OkHttpResponse{ //nolint:exhaustruct
+				Headers: []*corev3.HeaderValueOption{
+					header(HeaderLogin, cart.Login),
+					header(HeaderSession, cart.ID),
+				},
+			},
+		},
+	}, nil
+}
+
+func header(key, value string) *corev3.HeaderValueOption {
+	return &corev3.HeaderValueOption{ //nolint:exhaustruct
+		Header: &corev3.HeaderValue{ //nolint:exhaustruct
+			Key:   key,
+			Value: value,
+		},
+		AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
+
+func (t *Transport) denied(code codes.Code, httpCode typev3.StatusCode, err error) *authv3.CheckResponse {
+	t.logger.Debug("request denied",
+		zap.String("code", code.String()),
+		zap.Error(err),
+	)
+
+	return &authv3.CheckResponse{ //nolint:exhaustruct
+		Status: &rpcstatus.Status{ //nolint:exhaustruct
+			Code:    int32(code), //nolint:gosec
+			Message: err.Error(),
+		},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{ //nolint:exhaustruct
+				Status: &typev3.HttpStatus{
+					Code: httpCode,
+				},
+			},
+		},
+	}
+}
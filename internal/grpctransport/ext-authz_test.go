@@ -0,0 +1,181 @@
+package grpctransport_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/vtievsky/auth-id/internal/grpctransport"
+	"github.com/vtievsky/auth-id/internal/routetable"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	login     = "ivan"
+	sessionID = "session"
+	privilege = "report_read"
+)
+
+var signingKey = authidjwt.NewHMACKey("1", []byte("secret")) //nolint:gochecknoglobals
+
+// Сессия с единственной привилегией
+type sessions struct{}
+
+func (s *sessions) Get(_ context.Context, id string) (*sessionsvc.SessionCart, error) {
+	if id != sessionID {
+		return nil, sessionsvc.ErrSessionNotFound
+	}
+
+	return &sessionsvc.SessionCart{ID: sessionID, Login: login, CreatedAt: time.Now()}, nil
+}
+
+func (s *sessions) Search(_ context.Context, _, code string) error {
+	if code != privilege {
+		return sessionsvc.ErrSessionPrivilegeNotFound
+	}
+
+	return nil
+}
+
+func newTransport(t *testing.T) *grpctransport.Transport {
+	t.Helper()
+
+	routes, err := routetable.New(map[string][]routetable.Route{
+		routetable.DefaultUpstream: {
+			{Method: "", Path: "/public/*", Privilege: "", Public: true},
+			{Method: "GET", Path: "/reports/*", Privilege: privilege, Public: false},
+			{Method: "DELETE", Path: "/reports/:id", Privilege: "report_delete", Public: false},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create route table: %v", err)
+	}
+
+	return grpctransport.New(&grpctransport.TransportOpts{
+		Logger:     zap.NewNop(),
+		SessionSvc: &sessions{},
+		Keys:       signingKey,
+		Routes:     routes,
+	})
+}
+
+func sign(t *testing.T, issue func(key *authidjwt.Key, opts *authidjwt.TokenOpts) ([]byte, error)) string {
+	t.Helper()
+
+	token, err := issue(signingKey, &authidjwt.TokenOpts{
+		ID:        "1",
+		Subject:   login,
+		SessionID: sessionID,
+		ExpiredAt: time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return "Bearer " + string(token)
+}
+
+func checkRequest(method, path, authorization string) *authv3.CheckRequest {
+	headers := map[string]string{}
+
+	// Заголовки идентификации от клиента должны быть заменены или удалены
+	headers[grpctransport.HeaderLogin] = "admin"
+
+	if authorization != "" {
+		headers["authorization"] = authorization
+	}
+
+	return &authv3.CheckRequest{ //nolint:exhaustruct
+		Attributes: &authv3.AttributeContext{ //nolint:exhaustruct
+			Request: &authv3.AttributeContext_Request{ //nolint:exhaustruct
+				Http: &authv3.AttributeContext_HttpRequest{ //nolint:exhaustruct
+					Host:    "reports.local",
+					Method:  method,
+					Path:    path,
+					Headers: headers,
+				},
+			},
+		},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var (
+		transport = newTransport(t)
+		access    = sign(t, authidjwt.NewAccessToken)
+		refresh   = sign(t, authidjwt.NewRefreshToken)
+	)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		token    string
+		code     codes.Code
+		status   typev3.StatusCode
+		injected map[string]string
+	}{
+		{
+			name: "privileged route", method: "GET", path: "/reports/1?page=2", token: access, code: codes.OK,
+			injected: map[string]string{grpctransport.HeaderLogin: login, grpctransport.HeaderSession: sessionID},
+		},
+		{name: "public route", method: "POST", path: "/public/form", token: "", code: codes.OK, injected: map[string]string{}},
+		{name: "missing token", method: "GET", path: "/reports/1", token: "", code: codes.Unauthenticated, status: typev3.StatusCode_Unauthorized},
+		{name: "refresh token", method: "GET", path: "/reports/1", token: refresh, code: codes.Unauthenticated, status: typev3.StatusCode_Unauthorized},
+		{name: "privilege not granted", method: "DELETE", path: "/reports/1", token: access, code: codes.PermissionDenied, status: typev3.StatusCode_Forbidden},
+		{name: "unknown route", method: "GET", path: "/admin", token: access, code: codes.PermissionDenied, status: typev3.StatusCode_Forbidden},
+		{name: "path traversal", method: "GET", path: "/public/../reports/1", token: "", code: codes.PermissionDenied, status: typev3.StatusCode_Forbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := transport.Check(context.Background(), checkRequest(tt.method, tt.path, tt.token))
+			if err != nil {
+				t.Fatalf("failed to check request: %v", err)
+			}
+
+			if code := codes.Code(resp.GetStatus().GetCode()); code != tt.code { //nolint:gosec
+				t.Fatalf("expected code %s, got %s: %s", tt.code, code, resp.GetStatus().GetMessage())
+			}
+
+			if tt.code != codes.OK {
+				if status := resp.GetDeniedResponse().GetStatus().GetCode(); status != tt.status {
+					t.Fatalf("expected http status %s, got %s", tt.status, status)
+				}
+
+				return
+			}
+
+			ok := resp.GetOkResponse()
+			if ok == nil {
+				t.Fatal("expected ok response")
+			}
+
+			injected := map[string]string{}
+
+			for _, h := range ok.GetHeaders() {
+				injected[h.GetHeader().GetKey()] = h.GetHeader().GetValue()
+			}
+
+			if len(injected) != len(tt.injected) {
+				t.Fatalf("expected headers %v, got %v", tt.injected, injected)
+			}
+
+			for key, value := range tt.injected {
+				if injected[key] != value {
+					t.Fatalf("expected header %s=%q, got %q", key, value, injected[key])
+				}
+			}
+
+			// Публичный маршрут удаляет заголовки идентификации, переданные клиентом
+			if len(tt.injected) == 0 && len(ok.GetHeadersToRemove()) != 2 {
+				t.Fatalf("expected identity headers to be removed, got %v", ok.GetHeadersToRemove())
+			}
+		})
+	}
+}
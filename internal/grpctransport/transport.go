@@ -0,0 +1,47 @@
+package grpctransport
+
+import (
+	"context"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/vtievsky/auth-id/internal/routetable"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type SessionService interface {
+	Get(ctx context.Context, sessionID string) (*sessionsvc.SessionCart, error)
+	Search(ctx context.Context, sessionID, privilegeCode string) error
+}
+
+type TransportOpts struct {
+	Logger     *zap.Logger
+	SessionSvc SessionService
+	Keys       authidjwt.KeySet
+	Routes     *routetable.Table
+}
+
+type Transport struct {
+	authv3.UnimplementedAuthorizationServer
+
+	logger     *zap.Logger
+	sessionSvc SessionService
+	keys       authidjwt.KeySet
+	routes     *routetable.Table
+}
+
+func New(opts *TransportOpts) *Transport {
+	return &Transport{
+		UnimplementedAuthorizationServer: authv3.UnimplementedAuthorizationServer{},
+		logger:                           opts.Logger,
+		sessionSvc:                       opts.SessionSvc,
+		keys:                             opts.Keys,
+		routes:                           opts.Routes,
+	}
+}
+
+func (t *Transport) Register(srv *grpc.Server) {
+	authv3.RegisterAuthorizationServer(srv, t)
+}
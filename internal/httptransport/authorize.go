@@ -0,0 +1,61 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	"github.com/vtievsky/auth-id/pkg/authz"
+)
+
+func (t *Transport) Authorize(ctx echo.Context) error {
+	var request serverhttp.AuthorizeJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.AuthorizeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	decisions, err := t.services.SessionSvc.Authorize(ctx.Request().Context(), request.Token, request.Privileges)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.AuthorizeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp := make([]serverhttp.AuthorizeDecision, 0, len(decisions))
+
+	for _, decision := range decisions {
+		resp = append(resp, decisionOf(decision))
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.AuthorizeResponse200{ //nolint:wrapcheck
+		Data: resp,
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func decisionOf(decision *authz.Decision) serverhttp.AuthorizeDecision {
+	var reason *serverhttp.AuthorizeDecisionReason
+
+	if decision.Reason != "" {
+		value := serverhttp.AuthorizeDecisionReason(decision.Reason)
+		reason = &value
+	}
+
+	return serverhttp.AuthorizeDecision{
+		Privilege: decision.Privilege,
+		Allowed:   decision.Allowed,
+		Reason:    reason,
+	}
+}
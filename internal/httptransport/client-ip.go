@@ -0,0 +1,36 @@
+package httptransport
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Определение адреса клиента. Без доверенных прокси используется адрес соединения,
+// иначе X-Forwarded-For разбирается только по цепочке доверенных прокси,
+// чтобы клиент не мог подменить адрес заголовком
+func NewIPExtractor(trustedNets []string) (echo.IPExtractor, error) {
+	const op = "httptransport.NewIPExtractor"
+
+	if len(trustedNets) < 1 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, value := range trustedNets {
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trusted proxy net %q | %s:%w", value, op, err)
+		}
+
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
@@ -5,6 +5,26 @@ var (
 	// Эндпоинты не требующие наличие токена
 	endpointWithout = map[string]struct{}{
 		"post/v1/users/:login/sessions": {}, // Аутентификация пользователя
+		"post/v1/sessions/refresh":      {}, // Обновление токенов сессии
+		"post/v1/sessions/mfa":          {}, // Завершение входа вторым фактором
+		"post/v1/sessions/webauthn":     {}, // Завершение входа ключом WebAuthn
+		"post/v1/sessions/password":     {}, // Завершение входа сменой истекшего пароля
+		"get/.well-known/jwks.json":     {}, // Открытые ключи проверки токенов
+		"get/v1/forward-auth":           {}, // Проверка запроса к защищаемому сервису
+		// Начало входа ключом WebAuthn
+		"post/v1/users/:login/webauthn/assertions": {},
+		// Сброс забытого пароля токеном из письма
+		"post/v1/passresets/:login":              {},
+		"post/v1/passresets/:login/confirmation": {},
+	}
+
+	//nolint:gochecknoglobals
+	// Эндпоинты текущего пользователя, требующие только действующей сессии
+	endpointWithSession = map[string]struct{}{
+		"get/v1/me":                         {},
+		"get/v1/me/sessions":                {},
+		"delete/v1/me/sessions/current":     {},
+		"delete/v1/me/sessions/:session_id": {},
 	}
 
 	//nolint:gochecknoglobals
@@ -23,7 +43,18 @@ var (
 		"get/v1/users/:login/privileges": "user2privilege_read",
 		// Сессии пользователя
 		"get/v1/users/:login/sessions":                "user2session_read",
+		"delete/v1/users/:login/sessions":             "user2session_delete",
 		"delete/v1/users/:login/sessions/:session_id": "user2session_delete",
+		"delete/v1/users/:login/lockout":              "user_unlock",
+		// Второй фактор пользователя
+		"post/v1/users/:login/mfa/totp":              "user_mfa_update",
+		"post/v1/users/:login/mfa/totp/confirmation": "user_mfa_update",
+		"delete/v1/users/:login/mfa/totp":            "user_mfa_delete",
+		// Ключи WebAuthn пользователя
+		"post/v1/users/:login/webauthn/registrations":                "user_mfa_update",
+		"post/v1/users/:login/webauthn/credentials":                  "user_mfa_update",
+		"get/v1/users/:login/webauthn/credentials":                   "user_mfa_read",
+		"delete/v1/users/:login/webauthn/credentials/:credential_id": "user_mfa_delete",
 		// Роли
 		"get/v1/roles/:code":    "role_read",
 		"get/v1/roles":          "role_read",
@@ -42,5 +73,13 @@ var (
 		"delete/v1/roles/:code/privileges/:privilege_code": "role2privilege_delete",
 		// Справочник привилегий
 		"get/v1/privileges": "privilege_read",
+		// Интроспекция токенов
+		"post/v1/introspect": "token_introspect",
+		// Проверка доступа сессии к привилегиям
+		"post/v1/authorize": "session_authorize",
+		// Ключи подписи токенов
+		"get/v1/signing-keys":                  "signing_key_read",
+		"post/v1/signing-keys":                 "signing_key_rotate",
+		"post/v1/signing-keys/:kid/activation": "signing_key_rotate",
 	}
 )
@@ -1,6 +1,20 @@
 package httptransport
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	mfasvc "github.com/vtievsky/auth-id/internal/services/mfa"
+	passresetsvc "github.com/vtievsky/auth-id/internal/services/pass-resets"
+	roleusersvc "github.com/vtievsky/auth-id/internal/services/role-users"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
 
 var (
 	ErrDeleteHimself      = errors.New("unacceptable to delete yourself")
@@ -8,4 +22,68 @@ var (
 	ErrAddHimselfRoles    = errors.New("unacceptable to add your roles")
 	ErrHimself            = errors.New("unacceptable to processing yourself")
 	ErrBlockHimself       = errors.New("unacceptable to block yourself")
+	ErrNotHimself         = errors.New("acceptable only for yourself")
+
+	ErrForwardedURIMissing = errors.New("X-Forwarded-Uri header required")
 )
+
+// Код ответа для ошибки с известной причиной, остальные ошибки возвращаются как 500
+func errorStatus(err error) (int, bool) {
+	var assertionErr *webauthnsvc.AssertionError
+
+	switch {
+	case errors.Is(err, usersvc.ErrInvalidName),
+		errors.Is(err, usersvc.ErrInvalidLogin),
+		errors.Is(err, usersvc.ErrInvalidPassword),
+		errors.Is(err, usersvc.ErrInvalidEmail),
+		errors.Is(err, roleusersvc.ErrInvalidDateRange),
+		errors.Is(err, webauthnsvc.ErrCeremonyInvalid),
+		errors.Is(err, webauthnsvc.ErrCredentialEncodingInvalid),
+		errors.Is(err, sessionsvc.ErrMFAChallengeInvalid),
+		errors.Is(err, sessionsvc.ErrPasswordChangeInvalid),
+		errors.Is(err, passresetsvc.ErrResetTokenInvalid):
+		return http.StatusBadRequest, true
+	case errors.Is(err, sessionsvc.ErrRefreshTokenInvalid),
+		errors.Is(err, sessionsvc.ErrRefreshTokenExpected),
+		errors.Is(err, sessionsvc.ErrRefreshTokenReused),
+		errors.Is(err, mfasvc.ErrMFACodeInvalid),
+		errors.Is(err, mfasvc.ErrMFACodeReused),
+		errors.As(err, &assertionErr):
+		return http.StatusUnauthorized, true
+	case errors.Is(err, sessionsvc.ErrUserBlocked),
+		errors.Is(err, passresetsvc.ErrUserBlocked),
+		errors.Is(err, ErrDeleteHimself),
+		errors.Is(err, ErrDeleteHimselfRoles),
+		errors.Is(err, ErrAddHimselfRoles),
+		errors.Is(err, ErrBlockHimself),
+		errors.Is(err, ErrNotHimself):
+		return http.StatusForbidden, true
+	case errors.Is(err, dberrors.ErrUserNotFound),
+		errors.Is(err, dberrors.ErrRoleNotFound),
+		errors.Is(err, dberrors.ErrPrivilegeNotFound),
+		errors.Is(err, dberrors.ErrCredentialNotFound),
+		errors.Is(err, webauthnsvc.ErrCredentialNotFound),
+		errors.Is(err, sessionsvc.ErrSessionNotFound),
+		errors.Is(err, authidjwt.ErrKeyNotFound):
+		return http.StatusNotFound, true
+	case errors.Is(err, dberrors.ErrUserAlreadyExists),
+		errors.Is(err, dberrors.ErrRoleAlreadyExists),
+		errors.Is(err, webauthnsvc.ErrCredentialAlreadyExists),
+		errors.Is(err, webauthnsvc.ErrCredentialsLimitExceeded),
+		errors.Is(err, mfasvc.ErrMFAAlreadyEnabled),
+		errors.Is(err, mfasvc.ErrMFANotEnrolled):
+		return http.StatusConflict, true
+	}
+
+	return 0, false
+}
+
+// Ошибка с известной причиной возвращается с кодом 4xx
+func rejected(ctx echo.Context, status int, err error) error {
+	return ctx.JSON(status, serverhttp.ResponseError{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusError{
+			Code:        serverhttp.Error,
+			Description: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package httptransport
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+const (
+	HeaderAuthLogin   = "X-Auth-Login"
+	HeaderAuthSession = "X-Auth-Session"
+)
+
+func (t *Transport) ForwardAuth(
+	ctx echo.Context,
+	params serverhttp.ForwardAuthParams,
+) error {
+	if t.routes == nil {
+		return forwardAuthError(ctx, http.StatusInternalServerError, fmt.Errorf("route table is not configured"))
+	}
+
+	// Путь по умолчанию превратил бы ошибку настройки прокси в проверку корня,
+	// открытую для всех запросов при публичном "/" или "/*"
+	uri := valueOf(params.XForwardedUri, "")
+	if uri == "" {
+		return forwardAuthError(ctx, http.StatusBadRequest, ErrForwardedURIMissing)
+	}
+
+	var (
+		upstream = valueOf(params.Upstream, valueOf(params.XForwardedHost, ""))
+		method   = valueOf(params.XForwardedMethod, ctx.Request().Method)
+	)
+
+	route, err := t.routes.Match(upstream, method, uri)
+	if err != nil {
+		return forwardAuthError(ctx, http.StatusForbidden, err)
+	}
+
+	// Заголовки идентификации присутствуют в ответе всегда: прокси заменяет ими заголовки клиента,
+	// и на публичном маршруте клиент не может передать сервису чужую идентификацию
+	if route.Public {
+		ctx.Response().Header().Set(HeaderAuthLogin, "")
+		ctx.Response().Header().Set(HeaderAuthSession, "")
+
+		return forwardAuthOk(ctx)
+	}
+
+	value, err := authidjwt.ExtractToken(ctx.Request().Header["Authorization"])
+	if err != nil {
+		return forwardAuthError(ctx, http.StatusUnauthorized, err)
+	}
+
+	token, err := authidjwt.ParseToken(t.services.SigningKeySvc.KeySet(), []byte(value))
+	if err != nil {
+		return forwardAuthError(ctx, http.StatusUnauthorized, err)
+	}
+
+	if !token.Valid || !token.AccessOnly {
+		return forwardAuthError(ctx, http.StatusUnauthorized, fmt.Errorf("token not valid"))
+	}
+
+	cart, err := t.services.SessionSvc.Get(ctx.Request().Context(), token.SessionID)
+	if err != nil {
+		return forwardAuthError(ctx, http.StatusUnauthorized, err)
+	}
+
+	if err = t.services.SessionSvc.Search(ctx.Request().Context(), token.SessionID, route.Privilege); err != nil {
+		return forwardAuthError(ctx, http.StatusForbidden, err)
+	}
+
+	ctx.Response().Header().Set(HeaderAuthLogin, cart.Login)
+	ctx.Response().Header().Set(HeaderAuthSession, cart.ID)
+
+	return forwardAuthOk(ctx)
+}
+
+func forwardAuthOk(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, serverhttp.ForwardAuthResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func forwardAuthError(ctx echo.Context, code int, err error) error {
+	return ctx.JSON(code, serverhttp.ForwardAuthResponseError{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusError{
+			Code:        serverhttp.Error,
+			Description: err.Error(),
+		},
+	})
+}
+
+func valueOf(value *string, def string) string {
+	if value == nil || *value == "" {
+		return def
+	}
+
+	return *value
+}
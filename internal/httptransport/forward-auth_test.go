@@ -0,0 +1,163 @@
+package httptransport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	"github.com/vtievsky/auth-id/internal/conf"
+	"github.com/vtievsky/auth-id/internal/httptransport"
+	"github.com/vtievsky/auth-id/internal/routetable"
+	"github.com/vtievsky/auth-id/internal/services"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+const (
+	login     = "ivan"
+	sessionID = "session"
+	privilege = "report_read"
+)
+
+var signingKey = authidjwt.NewHMACKey("1", []byte("secret")) //nolint:gochecknoglobals
+
+// Сессия с единственной привилегией
+type sessions struct {
+	services.SessionService
+}
+
+func (s *sessions) Get(_ context.Context, id string) (*sessionsvc.SessionCart, error) {
+	if id != sessionID {
+		return nil, sessionsvc.ErrSessionNotFound
+	}
+
+	return &sessionsvc.SessionCart{ID: sessionID, Login: login, CreatedAt: time.Now()}, nil
+}
+
+func (s *sessions) Search(_ context.Context, _, code string) error {
+	if code != privilege {
+		return sessionsvc.ErrSessionPrivilegeNotFound
+	}
+
+	return nil
+}
+
+type signingKeys struct {
+	services.SigningKeyService
+}
+
+func (s *signingKeys) KeySet() authidjwt.KeySet {
+	return signingKey
+}
+
+func newTransport(t *testing.T) *httptransport.Transport {
+	t.Helper()
+
+	routes, err := routetable.New(map[string][]routetable.Route{
+		routetable.DefaultUpstream: {
+			{Method: "", Path: "/", Privilege: "", Public: true},
+			{Method: "GET", Path: "/reports/*", Privilege: privilege, Public: false},
+			{Method: "DELETE", Path: "/reports/:id", Privilege: "report_delete", Public: false},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create route table: %v", err)
+	}
+
+	return httptransport.New(&conf.Config{}, &services.SvcLayer{ //nolint:exhaustruct
+		SessionSvc:    &sessions{},
+		SigningKeySvc: &signingKeys{},
+	}, routes)
+}
+
+func accessToken(t *testing.T) string {
+	t.Helper()
+
+	token, err := authidjwt.NewAccessToken(signingKey, &authidjwt.TokenOpts{
+		ID:        "1",
+		Subject:   login,
+		SessionID: sessionID,
+		ExpiredAt: time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return string(token)
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func TestForwardAuth(t *testing.T) {
+	transport := newTransport(t)
+	token := accessToken(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		uri     string
+		token   string
+		status  int
+		login   string
+		session string
+	}{
+		{name: "missing uri", method: "GET", uri: "", token: token, status: http.StatusBadRequest},
+		{name: "public route", method: "GET", uri: "/", token: "", status: http.StatusOK},
+		{name: "privileged route", method: "GET", uri: "/reports/1?page=2", token: token, status: http.StatusOK, login: login, session: sessionID},
+		{name: "privileged route without token", method: "GET", uri: "/reports/1", token: "", status: http.StatusUnauthorized},
+		{name: "privilege not granted", method: "DELETE", uri: "/reports/1", token: token, status: http.StatusForbidden},
+		{name: "unknown route", method: "GET", uri: "/admin", token: token, status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/forward-auth", nil)
+			rec := httptest.NewRecorder()
+
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			err := transport.ForwardAuth(echo.New().NewContext(req, rec), serverhttp.ForwardAuthParams{
+				Upstream:         nil,
+				XForwardedMethod: optional(tt.method),
+				XForwardedUri:    optional(tt.uri),
+				XForwardedHost:   nil,
+			})
+			if err != nil {
+				t.Fatalf("failed to handle request: %v", err)
+			}
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body.String())
+			}
+
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			// Заголовки идентификации заменяют заголовки клиента, в том числе пустыми значениями
+			if _, ok := rec.Header()[httptransport.HeaderAuthLogin]; !ok {
+				t.Fatal("identity headers must always be set")
+			}
+
+			if got := rec.Header().Get(httptransport.HeaderAuthLogin); got != tt.login {
+				t.Errorf("expected login %q, got %q", tt.login, got)
+			}
+
+			if got := rec.Header().Get(httptransport.HeaderAuthSession); got != tt.session {
+				t.Errorf("expected session %q, got %q", tt.session, got)
+			}
+		})
+	}
+}
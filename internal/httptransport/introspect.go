@@ -0,0 +1,42 @@
+package httptransport
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) IntrospectToken(ctx echo.Context) error {
+	introspection, err := t.services.SessionSvc.Introspect(ctx.Request().Context(), ctx.FormValue("token"))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.IntrospectTokenResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	// Для неактивного токена возвращается только признак active
+	if !introspection.Active {
+		return ctx.JSON(http.StatusOK, serverhttp.IntrospectTokenResponse200{ //nolint:wrapcheck,exhaustruct
+			Active: false,
+		})
+	}
+
+	var (
+		exp = introspection.ExpiredAt.Unix()
+		iat = introspection.IssuedAt.Unix()
+	)
+
+	return ctx.JSON(http.StatusOK, serverhttp.IntrospectTokenResponse200{ //nolint:wrapcheck
+		Active: true,
+		Sub:    &introspection.Subject,
+		Sid:    &introspection.SessionID,
+		Scope:  optional(strings.Join(introspection.Scope, " ")),
+		Exp:    &exp,
+		Iat:    &iat,
+	})
+}
@@ -0,0 +1,40 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) GetJWKS(ctx echo.Context) error {
+	set := t.services.SigningKeySvc.JWKS(ctx.Request().Context())
+
+	resp := make([]serverhttp.JWK, 0, len(set.Keys))
+
+	for _, key := range set.Keys {
+		resp = append(resp, serverhttp.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: optional(key.Use),
+			Alg: optional(key.Alg),
+			N:   optional(key.N),
+			E:   optional(key.E),
+			Crv: optional(key.Crv),
+			X:   optional(key.X),
+			Y:   optional(key.Y),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.JWKSet{ //nolint:wrapcheck
+		Keys: resp,
+	})
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
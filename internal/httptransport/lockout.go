@@ -0,0 +1,83 @@
+package httptransport
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+)
+
+// Причина отказа во входе, отличная от неверных учетных данных
+func loginLockout(err error) (serverhttp.LoginLockout, bool) {
+	var lockoutErr *sessionsvc.LockoutError
+
+	switch {
+	case errors.As(err, &lockoutErr) && errors.Is(err, sessionsvc.ErrLoginLocked):
+		return serverhttp.LoginLockout{
+			Reason:      serverhttp.LoginLocked,
+			LockedUntil: &lockoutErr.Until,
+		}, true
+	case errors.As(err, &lockoutErr) && errors.Is(err, sessionsvc.ErrLoginThrottled):
+		return serverhttp.LoginLockout{
+			Reason:      serverhttp.LoginThrottled,
+			LockedUntil: &lockoutErr.Until,
+		}, true
+	case errors.Is(err, sessionsvc.ErrUserBlocked):
+		return serverhttp.LoginLockout{
+			Reason:      serverhttp.UserBlocked,
+			LockedUntil: nil,
+		}, true
+	}
+
+	return serverhttp.LoginLockout{}, false //nolint:exhaustruct
+}
+
+// Задержка между попытками возвращается как 429 с Retry-After, блокировка - как 423
+func loginLocked(ctx echo.Context, lockout serverhttp.LoginLockout, err error) error {
+	status := serverhttp.ResponseStatusError{
+		Code:        serverhttp.Error,
+		Description: err.Error(),
+	}
+
+	if lockout.Reason == serverhttp.LoginThrottled {
+		retryAfter := math.Ceil(time.Until(*lockout.LockedUntil).Seconds())
+
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+
+		return ctx.JSON(http.StatusTooManyRequests, serverhttp.LoginResponse429{ //nolint:wrapcheck
+			Data:   lockout,
+			Status: status,
+		})
+	}
+
+	return ctx.JSON(http.StatusLocked, serverhttp.LoginResponse423{ //nolint:wrapcheck
+		Data:   lockout,
+		Status: status,
+	})
+}
+
+func (t *Transport) UnlockUser(
+	ctx echo.Context,
+	login string,
+) error {
+	if err := t.services.SessionSvc.Unlock(ctx.Request().Context(), login); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.UnlockUserResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.UnlockUserResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
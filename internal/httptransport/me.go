@@ -0,0 +1,141 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) GetMe(ctx echo.Context) error {
+	cart, err := t.currentSession(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetMeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	user, err := t.services.UserSvc.GetUser(ctx.Request().Context(), cart.Login)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetMeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.GetMeResponse200{ //nolint:wrapcheck
+		Data: serverhttp.User{
+			Name:    user.Name,
+			Login:   user.Login,
+			Blocked: user.Blocked,
+			Email:   optional(user.Email),
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) GetMySessions(ctx echo.Context, params serverhttp.GetMySessionsParams) error {
+	cart, err := t.currentSession(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetMySessionsResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	sessions, err := t.services.SessionSvc.GetUserSessions(
+		ctx.Request().Context(),
+		cart.Login,
+		params.PageSize,
+		params.Offset,
+	)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetMySessionsResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp := make([]serverhttp.Session, 0, len(sessions))
+
+	for _, session := range sessions {
+		current := string(session.ID) == cart.ID
+
+		item := sessionOf(session)
+		item.Current = &current
+
+		resp = append(resp, item)
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.GetMySessionsResponse200{ //nolint:wrapcheck
+		Data: resp,
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+// Выход: завершение сессии, от имени которой выполнен запрос
+func (t *Transport) DeleteCurrentSession(ctx echo.Context) error {
+	cart, err := t.currentSession(ctx)
+	if err == nil {
+		err = t.services.SessionSvc.Delete(ctx.Request().Context(), cart.Login, cart.ID)
+	}
+
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteCurrentSessionResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.DeleteCurrentSessionResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+// Завершение собственной сессии, сессии других пользователей недоступны
+func (t *Transport) DeleteMySession(ctx echo.Context, sessionID string) error {
+	cart, err := t.currentSession(ctx)
+	if err == nil {
+		err = t.services.SessionSvc.Delete(ctx.Request().Context(), cart.Login, sessionID)
+	}
+
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteMySessionResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.DeleteMySessionResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package httptransport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) CompleteMFA(ctx echo.Context) error {
+	var request serverhttp.CompleteMFAJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompleteMFAResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp, err := t.services.SessionSvc.CompleteMFA(clientContext(ctx, request.Device), request.Challenge, request.Code)
+	if err != nil {
+		if lockout, ok := loginLockout(err); ok {
+			return loginLocked(ctx, lockout, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompleteMFAResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	// Токены будут выданы после смены пароля с истекшим сроком действия
+	if resp.PasswordChange != "" {
+		return passwordExpired(ctx, resp.PasswordChange)
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.CompleteMFAResponse200{ //nolint:wrapcheck
+		Data: serverhttp.ResponseAccess{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) EnrollTOTP(
+	ctx echo.Context,
+	login string,
+) error {
+	// Секрет выдается только самому пользователю
+	if err := t.onlyYourSelf(ctx, login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.EnrollTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	enrollment, err := t.services.MFASvc.Enroll(ctx.Request().Context(), login)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.EnrollTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.EnrollTOTPResponse200{ //nolint:wrapcheck
+		Data: serverhttp.TOTPEnrollment{
+			Secret: enrollment.Secret,
+			Uri:    enrollment.URI,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) ConfirmTOTP(
+	ctx echo.Context,
+	login string,
+) error {
+	var request serverhttp.ConfirmTOTPJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.ConfirmTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	if err := t.onlyYourSelf(ctx, login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.ConfirmTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	recoveryCodes, err := t.services.MFASvc.Confirm(ctx.Request().Context(), login, request.Code)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.ConfirmTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.ConfirmTOTPResponse200{ //nolint:wrapcheck
+		Data: serverhttp.TOTPRecoveryCodes{
+			RecoveryCodes: recoveryCodes,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+// Отключение при утрате устройства выполняется администратором
+func (t *Transport) DisableTOTP(
+	ctx echo.Context,
+	login string,
+) error {
+	if err := t.services.MFASvc.Disable(ctx.Request().Context(), login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.DisableTOTPResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.DisableTOTPResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) onlyYourSelf(ctx echo.Context, login string) error {
+	err := t.yourSelf(ctx, login)
+
+	switch {
+	case errors.Is(err, ErrHimself):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return ErrNotHimself
+}
@@ -16,6 +16,7 @@ import (
 
 type SessionService interface {
 	Search(ctx context.Context, sessionID, privilegeCode string) error
+	Touch(ctx context.Context, sessionID string)
 }
 
 func TracerMiddleware() echo.MiddlewareFunc {
@@ -70,7 +71,7 @@ func LoggerMiddleware(l *zap.Logger) echo.MiddlewareFunc {
 
 func AuthorizationMiddleware(
 	sessionSvc SessionService,
-	signingKey string,
+	keys authidjwt.KeySet,
 ) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -82,6 +83,11 @@ func AuthorizationMiddleware(
 
 			endpointPrivilegeCode, ok := endpointWithPrivileges[endpointPrivilegeKey]
 
+			// Пустой код привилегии - проверяется только наличие сессии
+			if _, own := endpointWithSession[endpointPrivilegeKey]; own {
+				endpointPrivilegeCode, ok = "", true
+			}
+
 			if !ok {
 				return fmt.Errorf("privilege path could not be mapped")
 			}
@@ -91,7 +97,7 @@ func AuthorizationMiddleware(
 				return err //nolint:wrapcheck
 			}
 
-			token, err := authidjwt.ParseToken([]byte(signingKey), []byte(value))
+			token, err := authidjwt.ParseToken(keys, []byte(value))
 			if err != nil {
 				return err //nolint:wrapcheck
 			}
@@ -100,11 +106,18 @@ func AuthorizationMiddleware(
 				return fmt.Errorf("token not valid")
 			}
 
+			// Refresh-токен предназначен только для продления сессии
+			if !token.AccessOnly {
+				return fmt.Errorf("token not access")
+			}
+
 			err = sessionSvc.Search(c.Request().Context(), token.SessionID, endpointPrivilegeCode)
 			if err != nil {
 				return fmt.Errorf("failed to search session privilege | %w", err)
 			}
 
+			sessionSvc.Touch(c.Request().Context(), token.SessionID)
+
 			c.Set("session_id", token.SessionID)
 
 			return next(c)
@@ -0,0 +1,69 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) RequestPassReset(
+	ctx echo.Context,
+	login string,
+) error {
+	if err := t.services.PassResetSvc.Request(ctx.Request().Context(), login); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.RequestPassResetResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.RequestPassResetResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) CompletePassReset(
+	ctx echo.Context,
+	login string,
+) error {
+	var request serverhttp.CompletePassResetJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompletePassResetResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	if err := t.services.PassResetSvc.Complete(ctx.Request().Context(), login, request.Token, request.Changed); err != nil {
+		if violations, ok := passwordViolations(err); ok {
+			return passwordRejected(ctx, violations, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompletePassResetResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.CompletePassResetResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
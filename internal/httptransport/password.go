@@ -0,0 +1,96 @@
+package httptransport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+)
+
+func (t *Transport) CompletePasswordChange(ctx echo.Context) error {
+	var request serverhttp.CompletePasswordChangeJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompletePasswordChangeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp, err := t.services.SessionSvc.CompletePasswordChange(clientContext(ctx, request.Device), request.Challenge, request.Password)
+	if err != nil {
+		if violations, ok := passwordViolations(err); ok {
+			return passwordRejected(ctx, violations, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CompletePasswordChangeResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.CompletePasswordChangeResponse200{ //nolint:wrapcheck
+		Data: serverhttp.ResponseAccess{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+// Нарушения правил выбора пароля
+func passwordViolations(err error) ([]serverhttp.PasswordViolation, bool) {
+	var policyErr *usersvc.PolicyError
+
+	if !errors.As(err, &policyErr) {
+		return nil, false
+	}
+
+	violations := make([]serverhttp.PasswordViolation, 0, len(policyErr.Violations))
+
+	for _, violation := range policyErr.Violations {
+		violations = append(violations, serverhttp.PasswordViolation{
+			Code:    violation.Code,
+			Message: violation.Message,
+		})
+	}
+
+	return violations, true
+}
+
+// Пароль отклонен правилами, нарушения возвращаются как 422
+func passwordRejected(ctx echo.Context, violations []serverhttp.PasswordViolation, err error) error {
+	return ctx.JSON(http.StatusUnprocessableEntity, serverhttp.CreateUserResponse422{ //nolint:wrapcheck
+		Data: violations,
+		Status: serverhttp.ResponseStatusError{
+			Code:        serverhttp.Error,
+			Description: err.Error(),
+		},
+	})
+}
+
+// Срок действия пароля истек, токены выдаются после его смены как 403
+func passwordExpired(ctx echo.Context, challenge string) error {
+	return ctx.JSON(http.StatusForbidden, serverhttp.LoginResponse403{ //nolint:wrapcheck
+		Data: serverhttp.PasswordChange{
+			Challenge: challenge,
+		},
+		Status: serverhttp.ResponseStatusError{
+			Code:        serverhttp.Error,
+			Description: "password expired",
+		},
+	})
+}
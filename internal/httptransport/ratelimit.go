@@ -0,0 +1,255 @@
+package httptransport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	"github.com/vtievsky/auth-id/internal/conf"
+	"go.uber.org/zap"
+)
+
+const (
+	rateLimitKindIP      = "ip"
+	rateLimitKindLogin   = "login"
+	rateLimitKindSession = "session"
+)
+
+type RateLimitStorage interface {
+	Take(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error)
+}
+
+// Скорость пополнения в запросах в секунду и допустимый всплеск запросов.
+// Нулевая скорость отключает ограничение
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// Ограничения эндпоинта по каждому из ключей
+type RateLimitRule struct {
+	IP      RateLimit `json:"ip"`
+	Login   RateLimit `json:"login"`   // По логину из пути запроса
+	Session RateLimit `json:"session"` // По сессии авторизованного запроса
+}
+
+type RateLimiterOpts struct {
+	Logger  *zap.Logger
+	Storage RateLimitStorage
+	Conf    *conf.RateLimitConfig
+	Routes  map[string]RateLimitRule // Дополняют и заменяют ограничения эндпоинтов по умолчанию
+}
+
+// Ограничение частоты запросов, общее для всех экземпляров приложения
+type RateLimiter struct {
+	logger   *zap.Logger
+	storage  RateLimitStorage
+	enabled  bool
+	failOpen bool
+	def      RateLimitRule
+	routes   map[string]RateLimitRule
+}
+
+func NewRateLimiter(opts *RateLimiterOpts) *RateLimiter {
+	routes := defaultRateLimitRoutes(opts.Conf)
+	maps.Copy(routes, opts.Routes)
+
+	return &RateLimiter{
+		logger:   opts.Logger,
+		storage:  opts.Storage,
+		enabled:  opts.Conf.Enabled,
+		failOpen: opts.Conf.FailOpen,
+		def: RateLimitRule{
+			IP: RateLimit{
+				Rate:  opts.Conf.IPRate,
+				Burst: opts.Conf.IPBurst,
+			},
+			Login: RateLimit{}, //nolint:exhaustruct
+			Session: RateLimit{
+				Rate:  opts.Conf.SessionRate,
+				Burst: opts.Conf.SessionBurst,
+			},
+		},
+		routes: routes,
+	}
+}
+
+// Загрузка ограничений эндпоинтов из JSON-файла вида
+// {"post/v1/users/:login/sessions": {"ip": {"rate": 1, "burst": 10}, "login": {...}, "session": {...}}}.
+// Ключ - метод в нижнем регистре и путь маршрута, нулевая скорость отключает ограничение
+func LoadRateLimitRoutes(path string) (map[string]RateLimitRule, error) {
+	const op = "httptransport.LoadRateLimitRoutes"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limit routes | %s:%w", op, err)
+	}
+
+	var routes map[string]RateLimitRule
+
+	if err = json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("failed to parse rate limit routes | %s:%w", op, err)
+	}
+
+	return routes, nil
+}
+
+// Эндпоинты с ограничениями, отличными от общих
+func defaultRateLimitRoutes(cfg *conf.RateLimitConfig) map[string]RateLimitRule {
+	strict := RateLimitRule{
+		IP: RateLimit{
+			Rate:  cfg.LoginIPRate,
+			Burst: cfg.LoginIPBurst,
+		},
+		Login: RateLimit{
+			Rate:  cfg.LoginRate,
+			Burst: cfg.LoginBurst,
+		},
+		Session: RateLimit{}, //nolint:exhaustruct
+	}
+
+	return map[string]RateLimitRule{
+		"post/v1/users/:login/sessions": strict, // Аутентификация пользователя
+		"post/v1/sessions/refresh":      strict, // Обновление токенов сессии
+		"post/v1/sessions/mfa":          strict, // Завершение входа вторым фактором
+		"post/v1/sessions/webauthn":     strict, // Завершение входа ключом WebAuthn
+		"post/v1/sessions/password":     strict, // Завершение входа сменой истекшего пароля
+		// Начало входа ключом WebAuthn
+		"post/v1/users/:login/webauthn/assertions": strict,
+		// Сброс забытого пароля токеном из письма
+		"post/v1/passresets/:login":              strict,
+		"post/v1/passresets/:login/confirmation": strict,
+		// Запросы приходят с адреса прокси от имени всех клиентов защищаемых сервисов
+		"get/v1/forward-auth": {}, //nolint:exhaustruct
+	}
+}
+
+// Ограничение по адресу клиента и логину, выполняется до авторизации
+func (s *RateLimiter) ByClient() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !s.enabled {
+				return next(c)
+			}
+
+			key := endpointPrivilegeKey(c)
+			rule := s.rule(key)
+
+			if ok, err := s.take(c, rateLimitKindIP, key, c.RealIP(), rule.IP); !ok {
+				return err
+			}
+
+			if login := c.Param("login"); login != "" {
+				if ok, err := s.take(c, rateLimitKindLogin, key, login, rule.Login); !ok {
+					return err
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// Ограничение по сессии, выполняется после авторизации
+func (s *RateLimiter) BySession() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !s.enabled {
+				return next(c)
+			}
+
+			sessionID, ok := c.Get("session_id").(string)
+			if !ok || sessionID == "" {
+				return next(c)
+			}
+
+			key := endpointPrivilegeKey(c)
+
+			if ok, err := s.take(c, rateLimitKindSession, key, sessionID, s.rule(key).Session); !ok {
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func (s *RateLimiter) rule(endpointKey string) RateLimitRule {
+	if rule, ok := s.routes[endpointKey]; ok {
+		return rule
+	}
+
+	return s.def
+}
+
+// Получение маркера. При отказе хранилища запрос пропускается, если это разрешено настройкой,
+// чтобы недоступность Redis не останавливала весь API, иначе отклоняется
+func (s *RateLimiter) take(c echo.Context, kind, endpointKey, value string, limit RateLimit) (bool, error) {
+	if limit.Rate <= 0 {
+		return true, nil
+	}
+
+	// Корзины эндпоинтов с отдельными ограничениями не пересекаются с общей
+	scope := "*"
+
+	if _, ok := s.routes[endpointKey]; ok {
+		scope = endpointKey
+	}
+
+	allowed, wait, err := s.storage.Take(c.Request().Context(),
+		fmt.Sprintf("%s:%s:%s", kind, scope, value),
+		limit.Rate,
+		max(limit.Burst, 1),
+	)
+	if err != nil {
+		s.logger.Error("failed to take rate limit token",
+			zap.String("kind", kind),
+			zap.String("endpoint", endpointKey),
+			zap.Error(err),
+		)
+
+		if s.failOpen {
+			return true, nil
+		}
+
+		return false, c.JSON(http.StatusServiceUnavailable, errorResponse{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: "rate limit unavailable",
+			},
+		})
+	}
+
+	if allowed {
+		return true, nil
+	}
+
+	s.logger.Warn("rate limit exceeded",
+		zap.String("kind", kind),
+		zap.String("endpoint", endpointKey),
+		zap.String("ip", c.RealIP()),
+		zap.Duration("retry_after", wait),
+	)
+
+	c.Response().Header().Set("Retry-After", strconv.Itoa(max(int(math.Ceil(wait.Seconds())), 1)))
+
+	return false, c.JSON(http.StatusTooManyRequests, errorResponse{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusError{
+			Code:        serverhttp.Error,
+			Description: fmt.Sprintf("rate limit exceeded by %s", kind),
+		},
+	})
+}
+
+// Ответ с ошибкой вне обработчиков эндпоинтов
+type errorResponse struct {
+	Status serverhttp.ResponseStatusError `json:"status"`
+}
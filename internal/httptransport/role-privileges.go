@@ -70,6 +70,10 @@ func (t *Transport) AddRolePrivilege(
 			Allowed:       request.Allowed,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.AddRolePrivilegeResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -108,6 +112,10 @@ func (t *Transport) UpdateRolePrivilege(
 			Allowed:       request.Allowed,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.UpdateRolePrivilegeResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -134,6 +142,10 @@ func (t *Transport) DeleteRolePrivilege(
 			PrivilegeCode: privilegeCode,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteRolePrivilegeResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
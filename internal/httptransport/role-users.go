@@ -58,12 +58,7 @@ func (t *Transport) AddRoleUser(
 	if err := t.yourSelf(ctx, login); err != nil {
 		err = fmt.Errorf("failed to add role user | %w", ErrAddHimselfRoles)
 
-		return ctx.JSON(http.StatusInternalServerError, serverhttp.AddRoleUserResponse500{ //nolint:wrapcheck
-			Status: serverhttp.ResponseStatusError{
-				Code:        serverhttp.Error,
-				Description: err.Error(),
-			},
-		})
+		return rejected(ctx, http.StatusForbidden, err)
 	}
 
 	var request serverhttp.AddRoleUserJSONRequestBody
@@ -85,6 +80,10 @@ func (t *Transport) AddRoleUser(
 			DateOut:  request.DateOut.Time,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.AddRoleUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -124,6 +123,10 @@ func (t *Transport) UpdateRoleUser(
 			DateOut:  request.DateOut.Time,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.UpdateRoleUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -148,12 +151,7 @@ func (t *Transport) DeleteRoleUser(
 	if err := t.yourSelf(ctx, login); err != nil {
 		err = fmt.Errorf("failed to delete role user | %w", ErrDeleteHimselfRoles)
 
-		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteRoleUserResponse500{ //nolint:wrapcheck
-			Status: serverhttp.ResponseStatusError{
-				Code:        serverhttp.Error,
-				Description: err.Error(),
-			},
-		})
+		return rejected(ctx, http.StatusForbidden, err)
 	}
 
 	if err := t.services.RoleUserSvc.DeleteRoleUser(ctx.Request().Context(),
@@ -162,6 +160,10 @@ func (t *Transport) DeleteRoleUser(
 			RoleCode: roleCode,
 		},
 	); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteRoleUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -14,6 +14,10 @@ func (t *Transport) GetRole(
 ) error {
 	role, err := t.services.RoleSvc.GetRole(ctx.Request().Context(), code)
 	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetRoleResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -90,6 +94,10 @@ func (t *Transport) CreateRole(ctx echo.Context) error {
 		},
 	)
 	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateRoleResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -136,6 +144,10 @@ func (t *Transport) UpdateRole(
 		},
 	)
 	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.UpdateRoleResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -163,6 +175,10 @@ func (t *Transport) DeleteRole(
 	code string,
 ) error {
 	if err := t.services.RoleSvc.DeleteRole(ctx.Request().Context(), code); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteRoleResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -0,0 +1,87 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+)
+
+func (t *Transport) GetSigningKeys(ctx echo.Context) error {
+	keys, err := t.services.SigningKeySvc.GetSigningKeys(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetSigningKeysResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp := make([]serverhttp.SigningKey, 0, len(keys))
+
+	for _, key := range keys {
+		resp = append(resp, serverhttp.SigningKey{
+			Kid:    key.ID,
+			Alg:    key.Algorithm,
+			Active: key.Active,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.GetSigningKeysResponse200{ //nolint:wrapcheck
+		Data: resp,
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) GenerateSigningKey(ctx echo.Context) error {
+	key, err := t.services.SigningKeySvc.Generate(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GenerateSigningKeyResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.GenerateSigningKeyResponse200{ //nolint:wrapcheck
+		Data: serverhttp.SigningKey{
+			Kid:    key.ID,
+			Alg:    key.Algorithm,
+			Active: key.Active,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) RotateSigningKey(
+	ctx echo.Context,
+	kid string,
+) error {
+	if err := t.services.SigningKeySvc.Rotate(ctx.Request().Context(), kid); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.RotateSigningKeyResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.RotateSigningKeyResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
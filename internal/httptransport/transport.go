@@ -1,40 +1,76 @@
 package httptransport
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/vtievsky/auth-id/internal/conf"
+	"github.com/vtievsky/auth-id/internal/routetable"
 	"github.com/vtievsky/auth-id/internal/services"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
 )
 
 type Transport struct {
 	conf     *conf.Config
 	services *services.SvcLayer
+	routes   *routetable.Table
 }
 
-func New(conf *conf.Config, svc *services.SvcLayer) *Transport {
+func New(conf *conf.Config, svc *services.SvcLayer, routes *routetable.Table) *Transport {
 	return &Transport{
 		conf:     conf,
 		services: svc,
+		routes:   routes,
 	}
 }
 
 func (t *Transport) yourSelf(ctx echo.Context, login string) error {
+	cart, err := t.currentSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(login, cart.Login) {
+		return ErrHimself
+	}
+
+	return nil
+}
+
+// Карточка сессии вызывающего по идентификатору, сохраненному AuthorizationMiddleware
+func (t *Transport) currentSession(ctx echo.Context) (*sessionsvc.SessionCart, error) {
 	sessionID, ok := ctx.Get("session_id").(string)
 	if !ok {
-		return fmt.Errorf("failed to assert type session id")
+		return nil, fmt.Errorf("failed to assert type session id")
 	}
 
 	cart, err := t.services.SessionSvc.Get(ctx.Request().Context(), sessionID)
 	if err != nil {
-		return err //nolint:wrapcheck
+		return nil, err //nolint:wrapcheck
 	}
 
-	if strings.EqualFold(login, cart.Login) {
-		return ErrHimself
+	return cart, nil
+}
+
+// Текущая сессия вызывающего, которую нужно сохранить при отзыве сессий пользователя.
+// Сессия другого пользователя в списке отзываемых отсутствует и не влияет на результат
+func keptSession(ctx echo.Context, keepCurrent *bool) string {
+	if keepCurrent == nil || !*keepCurrent {
+		return ""
 	}
 
-	return nil
+	sessionID, _ := ctx.Get("session_id").(string)
+
+	return sessionID
+}
+
+// Контекст запроса со сведениями о клиенте для сохранения в открываемой сессии
+func clientContext(ctx echo.Context, device *string) context.Context {
+	return sessionsvc.WithClient(ctx.Request().Context(), sessionsvc.Client{
+		IP:        ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
+		Device:    valueOf(device, ""),
+	})
 }
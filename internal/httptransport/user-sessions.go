@@ -1,10 +1,15 @@
 package httptransport
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
 )
 
 func (t *Transport) Login(
@@ -22,8 +27,17 @@ func (t *Transport) Login(
 		})
 	}
 
-	resp, err := t.services.SessionSvc.Login(ctx.Request().Context(), login, request.Password)
+	resp, err := t.services.SessionSvc.Login(clientContext(ctx, request.Device), login, request.Password)
 	if err != nil {
+		if lockout, ok := loginLockout(err); ok {
+			return loginLocked(ctx, lockout, err)
+		}
+
+		// Неизвестный логин и неверный пароль неразличимы по коду ответа
+		if errors.Is(err, dberrors.ErrUserNotFound) || errors.Is(err, usersvc.ErrInvalidPassword) {
+			return rejected(ctx, http.StatusUnauthorized, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.LoginResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -32,6 +46,24 @@ func (t *Transport) Login(
 		})
 	}
 
+	// Пароль верен, но вход требует подтверждения вторым фактором
+	if resp.Challenge != "" {
+		return ctx.JSON(http.StatusAccepted, serverhttp.LoginResponse202{ //nolint:wrapcheck
+			Data: serverhttp.MFAChallenge{
+				Challenge: resp.Challenge,
+			},
+			Status: serverhttp.ResponseStatusOk{
+				Code:        serverhttp.Ok,
+				Description: "",
+			},
+		})
+	}
+
+	// Токены будут выданы после смены пароля с истекшим сроком действия
+	if resp.PasswordChange != "" {
+		return passwordExpired(ctx, resp.PasswordChange)
+	}
+
 	return ctx.JSON(http.StatusOK, serverhttp.LoginResponse200{ //nolint:wrapcheck
 		Data: serverhttp.ResponseAccess{
 			AccessToken:  resp.AccessToken,
@@ -44,6 +76,44 @@ func (t *Transport) Login(
 	})
 }
 
+func (t *Transport) RefreshSession(ctx echo.Context) error {
+	var request serverhttp.RefreshSessionJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.RefreshSessionResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp, err := t.services.SessionSvc.Refresh(ctx.Request().Context(), request.RefreshToken)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.RefreshSessionResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.RefreshSessionResponse200{ //nolint:wrapcheck
+		Data: serverhttp.ResponseAccess{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
 func (t *Transport) GetUserSessions(
 	ctx echo.Context,
 	login string,
@@ -67,11 +137,7 @@ func (t *Transport) GetUserSessions(
 	resp := make([]serverhttp.Session, 0, len(sessions))
 
 	for _, session := range sessions {
-		resp = append(resp, serverhttp.Session{
-			Id:        string(session.ID),
-			CreatedAt: session.CreatedAt,
-			ExpiredAt: session.ExpiredAt,
-		})
+		resp = append(resp, sessionOf(session))
 	}
 
 	return ctx.JSON(http.StatusOK, serverhttp.GetUserSessionsResponse200{ //nolint:wrapcheck
@@ -88,6 +154,10 @@ func (t *Transport) DeleteUserSession(
 	login, sessionID string,
 ) error {
 	if err := t.services.SessionSvc.Delete(ctx.Request().Context(), login, sessionID); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteUserSessionResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -103,3 +173,56 @@ func (t *Transport) DeleteUserSession(
 		},
 	})
 }
+
+func (t *Transport) DeleteUserSessions(
+	ctx echo.Context,
+	login string,
+	params serverhttp.DeleteUserSessionsParams,
+) error {
+	if err := t.services.SessionSvc.RevokeUserSessions(
+		ctx.Request().Context(),
+		login,
+		keptSession(ctx, params.KeepCurrent),
+	); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteUserSessionsResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.DeleteUserSessionsResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func sessionOf(session *sessionsvc.Session) serverhttp.Session {
+	var (
+		method     *serverhttp.SessionMethod
+		lastSeenAt *time.Time
+	)
+
+	if session.Method != "" {
+		method = (*serverhttp.SessionMethod)(&session.Method)
+	}
+
+	if !session.LastSeenAt.IsZero() {
+		lastSeenAt = &session.LastSeenAt
+	}
+
+	return serverhttp.Session{
+		Id:         string(session.ID),
+		CreatedAt:  session.CreatedAt,
+		ExpiredAt:  session.ExpiredAt,
+		Current:    nil,
+		Ip:         optional(session.IP),
+		UserAgent:  optional(session.UserAgent),
+		Device:     optional(session.Device),
+		Method:     method,
+		LastSeenAt: lastSeenAt,
+	}
+}
@@ -15,6 +15,10 @@ func (t *Transport) GetUser(
 ) error {
 	user, err := t.services.UserSvc.GetUser(ctx.Request().Context(), login)
 	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -28,6 +32,7 @@ func (t *Transport) GetUser(
 			Name:    user.Name,
 			Login:   user.Login,
 			Blocked: user.Blocked,
+			Email:   optional(user.Email),
 		},
 		Status: serverhttp.ResponseStatusOk{
 			Code:        serverhttp.Ok,
@@ -58,6 +63,7 @@ func (t *Transport) GetUsers(ctx echo.Context, params serverhttp.GetUsersParams)
 			Name:    user.Name,
 			Login:   user.Login,
 			Blocked: user.Blocked,
+			Email:   optional(user.Email),
 		})
 	}
 
@@ -87,8 +93,17 @@ func (t *Transport) CreateUser(ctx echo.Context) error {
 		Login:    request.Login,
 		Password: request.Password,
 		Blocked:  request.Blocked,
+		Email:    valueOf(request.Email, ""),
 	})
 	if err != nil {
+		if violations, ok := passwordViolations(err); ok {
+			return passwordRejected(ctx, violations, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -102,6 +117,7 @@ func (t *Transport) CreateUser(ctx echo.Context) error {
 			Name:    user.Name,
 			Login:   user.Login,
 			Blocked: user.Blocked,
+			Email:   optional(user.Email),
 		},
 		Status: serverhttp.ResponseStatusOk{
 			Code:        serverhttp.Ok,
@@ -130,12 +146,7 @@ func (t *Transport) UpdateUser(
 		if err := t.yourSelf(ctx, login); err != nil {
 			err = fmt.Errorf("failed to update user | %w", ErrBlockHimself)
 
-			return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteUserResponse500{ //nolint:wrapcheck
-				Status: serverhttp.ResponseStatusError{
-					Code:        serverhttp.Error,
-					Description: err.Error(),
-				},
-			})
+			return rejected(ctx, http.StatusForbidden, err)
 		}
 	}
 
@@ -143,8 +154,13 @@ func (t *Transport) UpdateUser(
 		Name:    request.Name,
 		Login:   login,
 		Blocked: request.Blocked,
+		Email:   request.Email,
 	})
 	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.UpdateUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -158,6 +174,7 @@ func (t *Transport) UpdateUser(
 			Name:    user.Name,
 			Login:   user.Login,
 			Blocked: user.Blocked,
+			Email:   optional(user.Email),
 		},
 		Status: serverhttp.ResponseStatusOk{
 			Code:        serverhttp.Ok,
@@ -174,15 +191,14 @@ func (t *Transport) DeleteUser(
 	if err := t.yourSelf(ctx, login); err != nil {
 		err = fmt.Errorf("failed to delete user | %w", ErrDeleteHimself)
 
-		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteUserResponse500{ //nolint:wrapcheck
-			Status: serverhttp.ResponseStatusError{
-				Code:        serverhttp.Error,
-				Description: err.Error(),
-			},
-		})
+		return rejected(ctx, http.StatusForbidden, err)
 	}
 
 	if err := t.services.UserSvc.DeleteUser(ctx.Request().Context(), login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteUserResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -202,6 +218,7 @@ func (t *Transport) DeleteUser(
 func (t *Transport) ChangePass(
 	ctx echo.Context,
 	login string,
+	params serverhttp.ChangePassParams,
 ) error {
 	var request serverhttp.ChangePassJSONRequestBody
 
@@ -214,7 +231,21 @@ func (t *Transport) ChangePass(
 		})
 	}
 
-	if err := t.services.UserSvc.ChangePass(ctx.Request().Context(), login, request.Current, request.Changed); err != nil {
+	if err := t.services.UserSvc.ChangePass(
+		ctx.Request().Context(),
+		login,
+		request.Current,
+		request.Changed,
+		keptSession(ctx, params.KeepCurrent),
+	); err != nil {
+		if violations, ok := passwordViolations(err); ok {
+			return passwordRejected(ctx, violations, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.ChangePassResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
@@ -234,6 +265,7 @@ func (t *Transport) ChangePass(
 func (t *Transport) ResetPass(
 	ctx echo.Context,
 	login string,
+	params serverhttp.ResetPassParams,
 ) error {
 	var request serverhttp.ResetPassJSONRequestBody
 
@@ -246,7 +278,20 @@ func (t *Transport) ResetPass(
 		})
 	}
 
-	if err := t.services.UserSvc.ResetPass(ctx.Request().Context(), login, request.Changed); err != nil {
+	if err := t.services.UserSvc.ResetPass(
+		ctx.Request().Context(),
+		login,
+		request.Changed,
+		keptSession(ctx, params.KeepCurrent),
+	); err != nil {
+		if violations, ok := passwordViolations(err); ok {
+			return passwordRejected(ctx, violations, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
 		return ctx.JSON(http.StatusInternalServerError, serverhttp.ResetPassResponse500{ //nolint:wrapcheck
 			Status: serverhttp.ResponseStatusError{
 				Code:        serverhttp.Error,
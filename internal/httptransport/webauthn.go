@@ -0,0 +1,423 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	serverhttp "github.com/vtievsky/auth-id/gen/httpserver/auth-id"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+)
+
+const (
+	credentialType = "public-key"
+	attestation    = "none"
+)
+
+func (t *Transport) BeginWebAuthnRegistration(
+	ctx echo.Context,
+	login string,
+) error {
+	// Ключ регистрирует только сам пользователь на своем устройстве
+	if err := t.onlyYourSelf(ctx, login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.BeginWebAuthnRegistrationResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp, err := t.services.WebAuthnSvc.BeginRegistration(ctx.Request().Context(), login)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.BeginWebAuthnRegistrationResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	algorithms := make([]serverhttp.WebAuthnCredentialParameter, 0, len(resp.Options.Algorithms))
+
+	for _, alg := range resp.Options.Algorithms {
+		algorithms = append(algorithms, serverhttp.WebAuthnCredentialParameter{
+			Alg:  alg,
+			Type: credentialType,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.BeginWebAuthnRegistrationResponse200{ //nolint:wrapcheck
+		Data: serverhttp.WebAuthnRegistration{
+			Ceremony: resp.Ceremony,
+			Options: serverhttp.WebAuthnCreationOptions{
+				Attestation: attestation,
+				AuthenticatorSelection: serverhttp.WebAuthnAuthenticatorSelection{
+					ResidentKey:      resp.Options.ResidentKey,
+					UserVerification: resp.Options.UserVerification,
+				},
+				Challenge:          resp.Options.Challenge,
+				ExcludeCredentials: credentialDescriptors(resp.Options.ExcludeCredentials),
+				PubKeyCredParams:   algorithms,
+				Rp: serverhttp.WebAuthnRelyingParty{
+					Id:   resp.Options.RPID,
+					Name: resp.Options.RPName,
+				},
+				Timeout: resp.Options.Timeout.Milliseconds(),
+				User: serverhttp.WebAuthnUser{
+					DisplayName: resp.Options.UserDisplayName,
+					Id:          resp.Options.UserID,
+					Name:        resp.Options.UserName,
+				},
+			},
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) CreateWebAuthnCredential(
+	ctx echo.Context,
+	login string,
+) error {
+	var request serverhttp.CreateWebAuthnCredentialJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateWebAuthnCredentialResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	if err := t.onlyYourSelf(ctx, login); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateWebAuthnCredentialResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	registration, err := registrationCreated(&request)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateWebAuthnCredentialResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	credential, err := t.services.WebAuthnSvc.FinishRegistration(ctx.Request().Context(), login, registration)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.CreateWebAuthnCredentialResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.CreateWebAuthnCredentialResponse200{ //nolint:wrapcheck
+		Data: webAuthnCredential(credential),
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) GetWebAuthnCredentials(
+	ctx echo.Context,
+	login string,
+) error {
+	credentials, err := t.services.WebAuthnSvc.GetCredentials(ctx.Request().Context(), login)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.GetWebAuthnCredentialsResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp := make([]serverhttp.WebAuthnCredential, 0, len(credentials))
+
+	for _, credential := range credentials {
+		resp = append(resp, webAuthnCredential(credential))
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.GetWebAuthnCredentialsResponse200{ //nolint:wrapcheck
+		Data: resp,
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+// Удаление при утрате ключа доступно администратору, как и отключение TOTP
+func (t *Transport) DeleteWebAuthnCredential(
+	ctx echo.Context,
+	login string,
+	credentialID string,
+) error {
+	if err := t.services.WebAuthnSvc.DeleteCredential(ctx.Request().Context(), login, credentialID); err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.DeleteWebAuthnCredentialResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.DeleteWebAuthnCredentialResponse200{ //nolint:wrapcheck
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) BeginWebAuthnLogin(
+	ctx echo.Context,
+	login string,
+) error {
+	var request serverhttp.BeginWebAuthnLoginJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.BeginWebAuthnLoginResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	var mfaChallenge string
+
+	if request.MfaChallenge != nil {
+		mfaChallenge = *request.MfaChallenge
+	}
+
+	resp, err := t.services.SessionSvc.BeginWebAuthnLogin(ctx.Request().Context(), login, mfaChallenge)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.BeginWebAuthnLoginResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.BeginWebAuthnLoginResponse200{ //nolint:wrapcheck
+		Data: serverhttp.WebAuthnAssertionStart{
+			Ceremony: resp.Ceremony,
+			Options: serverhttp.WebAuthnRequestOptions{
+				AllowCredentials: credentialDescriptors(resp.Options.AllowCredentials),
+				Challenge:        resp.Options.Challenge,
+				RpId:             resp.Options.RPID,
+				Timeout:          resp.Options.Timeout.Milliseconds(),
+				UserVerification: resp.Options.UserVerification,
+			},
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func (t *Transport) LoginWebAuthn(ctx echo.Context) error {
+	var request serverhttp.LoginWebAuthnJSONRequestBody
+
+	if err := ctx.Bind(&request); err != nil {
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.LoginWebAuthnResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	assertion, err := webAuthnAssertion(&request)
+	if err != nil {
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.LoginWebAuthnResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	resp, err := t.services.SessionSvc.LoginWebAuthn(clientContext(ctx, request.Device), request.Ceremony, assertion)
+	if err != nil {
+		if lockout, ok := loginLockout(err); ok {
+			return loginLocked(ctx, lockout, err)
+		}
+
+		if status, ok := errorStatus(err); ok {
+			return rejected(ctx, status, err)
+		}
+
+		return ctx.JSON(http.StatusInternalServerError, serverhttp.LoginWebAuthnResponse500{ //nolint:wrapcheck
+			Status: serverhttp.ResponseStatusError{
+				Code:        serverhttp.Error,
+				Description: err.Error(),
+			},
+		})
+	}
+
+	// Токены будут выданы после смены пароля с истекшим сроком действия
+	if resp.PasswordChange != "" {
+		return passwordExpired(ctx, resp.PasswordChange)
+	}
+
+	return ctx.JSON(http.StatusOK, serverhttp.LoginWebAuthnResponse200{ //nolint:wrapcheck
+		Data: serverhttp.ResponseAccess{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		},
+		Status: serverhttp.ResponseStatusOk{
+			Code:        serverhttp.Ok,
+			Description: "",
+		},
+	})
+}
+
+func registrationCreated(request *serverhttp.CreateWebAuthnCredentialRequest) (webauthnsvc.RegistrationCreated, error) {
+	var registration webauthnsvc.RegistrationCreated
+
+	clientDataJSON, err := webauthn.Decode(request.ClientDataJson)
+	if err != nil {
+		return registration, webauthnsvc.ErrCredentialEncodingInvalid
+	}
+
+	attestationObject, err := webauthn.Decode(request.AttestationObject)
+	if err != nil {
+		return registration, webauthnsvc.ErrCredentialEncodingInvalid
+	}
+
+	registration.Ceremony = request.Ceremony
+	registration.ClientDataJSON = clientDataJSON
+	registration.AttestationObject = attestationObject
+
+	if request.Name != nil {
+		registration.Name = *request.Name
+	}
+
+	if request.Transports != nil {
+		registration.Transports = *request.Transports
+	}
+
+	return registration, nil
+}
+
+func webAuthnAssertion(request *serverhttp.LoginWebAuthnRequest) (*webauthn.Assertion, error) {
+	fields := []string{
+		request.CredentialId,
+		request.ClientDataJson,
+		request.AuthenticatorData,
+		request.Signature,
+	}
+
+	if request.UserHandle != nil {
+		fields = append(fields, *request.UserHandle)
+	}
+
+	values := make([][]byte, 0, len(fields))
+
+	for _, field := range fields {
+		value, err := webauthn.Decode(field)
+		if err != nil {
+			return nil, webauthnsvc.ErrCredentialEncodingInvalid
+		}
+
+		values = append(values, value)
+	}
+
+	assertion := &webauthn.Assertion{
+		CredentialID:      values[0],
+		ClientDataJSON:    values[1],
+		AuthenticatorData: values[2],
+		Signature:         values[3],
+		UserHandle:        nil,
+	}
+
+	if len(values) > 4 { //nolint:mnd
+		assertion.UserHandle = values[4]
+	}
+
+	return assertion, nil
+}
+
+func credentialDescriptors(descriptors []webauthnsvc.CredentialDescriptor) []serverhttp.WebAuthnCredentialDescriptor {
+	resp := make([]serverhttp.WebAuthnCredentialDescriptor, 0, len(descriptors))
+
+	for _, descriptor := range descriptors {
+		transports := descriptor.Transports
+
+		resp = append(resp, serverhttp.WebAuthnCredentialDescriptor{
+			Id:         descriptor.ID,
+			Transports: &transports,
+			Type:       credentialType,
+		})
+	}
+
+	return resp
+}
+
+func webAuthnCredential(credential *webauthnsvc.Credential) serverhttp.WebAuthnCredential {
+	transports := credential.Transports
+
+	if transports == nil {
+		transports = []string{}
+	}
+
+	return serverhttp.WebAuthnCredential{
+		CreatedAt:  credential.CreatedAt,
+		Id:         credential.ID,
+		Name:       credential.Name,
+		Transports: transports,
+	}
+}
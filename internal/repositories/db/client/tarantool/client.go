@@ -25,6 +25,13 @@ func New(opts *ClientOpts) (*Client, error) {
 		return nil, fmt.Errorf("failed to parse URL | %w", err)
 	}
 
+	// Ограничение числа одновременных запросов соединения с ожиданием освобождения в пределах таймаута
+	var rateLimitAction uint
+
+	if opts.RateLimit > 0 {
+		rateLimitAction = tarantool.RLimitWait
+	}
+
 	c, err := tarantool.Connect(databaseURL.Host, tarantool.Opts{
 		Auth:                 0,
 		Dialer:               nil,
@@ -33,8 +40,8 @@ func New(opts *ClientOpts) (*Client, error) {
 		MaxReconnects:        3,                      //nolint:mnd
 		User:                 "",
 		Pass:                 "",
-		RateLimit:            0,
-		RLimitAction:         0,
+		RateLimit:            opts.RateLimit,
+		RLimitAction:         rateLimitAction,
 		Concurrency:          0,
 		SkipSchema:           false,
 		Notify:               make(chan<- tarantool.ConnEvent),
@@ -0,0 +1,42 @@
+package clienttarantool
+
+type UserMFA struct {
+	UserID        uint64   `json:"user_id"`
+	Secret        string   `json:"secret"`
+	Confirmed     bool     `json:"confirmed"`
+	LastStep      uint64   `json:"last_step"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (s Tuple) ToUserMFA() UserMFA {
+	values := s[4].([]any) //nolint:forcetypeassert
+	recoveryCodes := make([]string, 0, len(values))
+
+	for _, value := range values {
+		recoveryCodes = append(recoveryCodes, value.(string)) //nolint:forcetypeassert
+	}
+
+	return UserMFA{
+		UserID:        s[0].(uint64), //nolint:forcetypeassert
+		Secret:        s[1].(string), //nolint:forcetypeassert
+		Confirmed:     s[2].(bool),   //nolint:forcetypeassert
+		LastStep:      s[3].(uint64), //nolint:forcetypeassert
+		RecoveryCodes: recoveryCodes,
+	}
+}
+
+func (s UserMFA) ToTuple() Tuple {
+	recoveryCodes := s.RecoveryCodes
+
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	return Tuple{
+		s.UserID,
+		s.Secret,
+		s.Confirmed,
+		s.LastStep,
+		recoveryCodes,
+	}
+}
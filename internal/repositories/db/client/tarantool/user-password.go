@@ -0,0 +1,38 @@
+package clienttarantool
+
+import "time"
+
+type UserPassword struct {
+	UserID    uint64    `json:"user_id"`
+	History   []string  `json:"history"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (s Tuple) ToUserPassword() UserPassword {
+	values := s[1].([]any) //nolint:forcetypeassert
+	history := make([]string, 0, len(values))
+
+	for _, value := range values {
+		history = append(history, value.(string)) //nolint:forcetypeassert
+	}
+
+	return UserPassword{
+		UserID:    s[0].(uint64), //nolint:forcetypeassert
+		History:   history,
+		ChangedAt: time.Unix(int64(s[2].(uint64)), 0), //nolint:forcetypeassert,gosec
+	}
+}
+
+func (s UserPassword) ToTuple() Tuple {
+	history := s.History
+
+	if history == nil {
+		history = []string{}
+	}
+
+	return Tuple{
+		s.UserID,
+		history,
+		uint64(s.ChangedAt.Unix()), //nolint:gosec
+	}
+}
@@ -0,0 +1,50 @@
+package clienttarantool
+
+import "time"
+
+type WebAuthnCredential struct {
+	ID         string    `json:"id"`
+	UserID     uint64    `json:"user_id"`
+	Name       string    `json:"name"`
+	PublicKey  string    `json:"public_key"`
+	SignCount  uint64    `json:"sign_count"`
+	Transports []string  `json:"transports"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (s Tuple) ToWebAuthnCredential() WebAuthnCredential {
+	values := s[5].([]any) //nolint:forcetypeassert
+	transports := make([]string, 0, len(values))
+
+	for _, value := range values {
+		transports = append(transports, value.(string)) //nolint:forcetypeassert
+	}
+
+	return WebAuthnCredential{
+		ID:         s[0].(string), //nolint:forcetypeassert
+		UserID:     s[1].(uint64), //nolint:forcetypeassert
+		Name:       s[2].(string), //nolint:forcetypeassert
+		PublicKey:  s[3].(string), //nolint:forcetypeassert
+		SignCount:  s[4].(uint64), //nolint:forcetypeassert
+		Transports: transports,
+		CreatedAt:  time.Unix(int64(s[6].(uint64)), 0), //nolint:forcetypeassert,gosec
+	}
+}
+
+func (s WebAuthnCredential) ToTuple() Tuple {
+	transports := s.Transports
+
+	if transports == nil {
+		transports = []string{}
+	}
+
+	return Tuple{
+		s.ID,
+		s.UserID,
+		s.Name,
+		s.PublicKey,
+		s.SignCount,
+		transports,
+		uint64(s.CreatedAt.Unix()), //nolint:gosec
+	}
+}
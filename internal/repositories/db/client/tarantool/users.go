@@ -1,21 +1,31 @@
 // Модели для обмена данными между хранилищем и приложением
 package clienttarantool
 
+const userEmailField = 5 // Поле добавлено после первого выпуска и может отсутствовать
+
 type User struct {
 	ID       uint64 `json:"id"`
 	Name     string `json:"name"`
 	Login    string `json:"login"`
 	Password string `json:"password"`
 	Blocked  bool   `json:"blocked"`
+	Email    string `json:"email"`
 }
 
 func (s Tuple) ToUser() User {
+	var email string
+
+	if len(s) > userEmailField {
+		email, _ = s[userEmailField].(string)
+	}
+
 	return User{
 		ID:       s[0].(uint64), //nolint:forcetypeassert
 		Name:     s[1].(string), //nolint:forcetypeassert
 		Login:    s[2].(string), //nolint:forcetypeassert
 		Password: s[3].(string), //nolint:forcetypeassert
 		Blocked:  s[4].(bool),   //nolint:forcetypeassert
+		Email:    email,
 	}
 }
 
@@ -24,6 +34,7 @@ type UserCreated struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 	Blocked  bool   `json:"blocked"`
+	Email    string `json:"email"`
 }
 
 func (s UserCreated) ToTuple() Tuple {
@@ -33,6 +44,7 @@ func (s UserCreated) ToTuple() Tuple {
 		s.Login,
 		s.Password,
 		s.Blocked,
+		nullableString(s.Email),
 	}
 }
 
@@ -42,6 +54,7 @@ type UserUpdated struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 	Blocked  bool   `json:"blocked"`
+	Email    string `json:"email"`
 }
 
 func (s UserUpdated) ToTuple() Tuple {
@@ -51,5 +64,15 @@ func (s UserUpdated) ToTuple() Tuple {
 		s.Login,
 		s.Password,
 		s.Blocked,
+		nullableString(s.Email),
 	}
 }
+
+// Пустая строка хранится как отсутствующее значение
+func nullableString(value string) any {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/tarantool/go-tarantool"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
 	clienttarantool "github.com/vtievsky/auth-id/internal/repositories/db/client/tarantool"
 	"github.com/vtievsky/auth-id/internal/repositories/models"
 )
@@ -27,6 +28,18 @@ func New(opts *PrivilegesOpts) *Privileges {
 	}
 }
 
+func (s *Privileges) GetPrivilege(ctx context.Context, code string) (*models.Privilege, error) {
+	const op = "DbPrivileges.GetPrivilege"
+
+	return s.getPrivilege(ctx, op, "secondary", code)
+}
+
+func (s *Privileges) GetPrivilegeByID(ctx context.Context, id uint64) (*models.Privilege, error) {
+	const op = "DbPrivileges.GetPrivilegeByID"
+
+	return s.getPrivilege(ctx, op, "pk", id)
+}
+
 func (s *Privileges) GetPrivileges(ctx context.Context, pageSize, offset uint32) ([]*models.Privilege, error) {
 	const op = "DbPrivileges.GetPrivileges"
 
@@ -52,3 +65,23 @@ func (s *Privileges) GetPrivileges(ctx context.Context, pageSize, offset uint32)
 
 	return privileges, nil
 }
+
+func (s *Privileges) getPrivilege(_ context.Context, op, index string, key any) (*models.Privilege, error) {
+	resp, err := s.c.Connection.Select(space, index, 0, 1, tarantool.IterEq, clienttarantool.Tuple{key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privilege | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get privilege | %s:%w", op, dberrors.ErrPrivilegeNotFound)
+	}
+
+	value := clienttarantool.Tuple(resp.Tuples()[0]).ToPrivilege()
+
+	return &models.Privilege{
+		ID:          value.ID,
+		Code:        value.Code,
+		Name:        value.Name,
+		Description: value.Description,
+	}, nil
+}
@@ -54,6 +54,29 @@ func (s *Roles) GetRole(ctx context.Context, code string) (*models.Role, error)
 	}, nil
 }
 
+func (s *Roles) GetRoleByID(ctx context.Context, id uint64) (*models.Role, error) {
+	const op = "DbRoles.GetRoleByID"
+
+	resp, err := s.c.Connection.Select(spaceRole, "pk", 0, 1, tarantool.IterEq, clienttarantool.Tuple{id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, dberrors.ErrRoleNotFound)
+	}
+
+	role := clienttarantool.Tuple(resp.Tuples()[0]).ToRole()
+
+	return &models.Role{
+		ID:          role.ID,
+		Code:        role.Code,
+		Name:        role.Name,
+		Description: role.Description,
+		Blocked:     role.Blocked,
+	}, nil
+}
+
 func (s *Roles) GetRoles(ctx context.Context, pageSize, offset uint32) ([]*models.Role, error) {
 	const op = "DbRoles.GetRoles"
 
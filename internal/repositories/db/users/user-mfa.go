@@ -0,0 +1,98 @@
+package tarantoolusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tarantool/go-tarantool"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	clienttarantool "github.com/vtievsky/auth-id/internal/repositories/db/client/tarantool"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+)
+
+func (s *Users) GetUserMFA(ctx context.Context, userID uint64) (*models.UserMFA, error) {
+	const op = "DbUsers.GetUserMFA"
+
+	resp, err := s.c.Connection.Select(spaceUserMFA, "pk", 0, 1, tarantool.IterEq, clienttarantool.Tuple{userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user mfa | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get user mfa | %s:%w", op, dberrors.ErrUserMFANotFound)
+	}
+
+	mfa := clienttarantool.Tuple(resp.Tuples()[0]).ToUserMFA()
+
+	return &models.UserMFA{
+		UserID:        mfa.UserID,
+		Secret:        mfa.Secret,
+		Confirmed:     mfa.Confirmed,
+		LastStep:      mfa.LastStep,
+		RecoveryCodes: mfa.RecoveryCodes,
+	}, nil
+}
+
+// Создание или замена настроек второго фактора пользователя
+func (s *Users) SaveUserMFA(ctx context.Context, mfa models.UserMFA) error {
+	const op = "DbUsers.SaveUserMFA"
+
+	userMFA := clienttarantool.UserMFA{
+		UserID:        mfa.UserID,
+		Secret:        mfa.Secret,
+		Confirmed:     mfa.Confirmed,
+		LastStep:      mfa.LastStep,
+		RecoveryCodes: mfa.RecoveryCodes,
+	}
+
+	if _, err := s.c.Connection.Replace(spaceUserMFA, userMFA.ToTuple()); err != nil {
+		return fmt.Errorf("failed to save user mfa | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Users) DeleteUserMFA(ctx context.Context, userID uint64) error {
+	const op = "DbUsers.DeleteUserMFA"
+
+	if _, err := s.c.Connection.Delete(spaceUserMFA, "pk", clienttarantool.Tuple{userID}); err != nil {
+		return fmt.Errorf("failed to delete user mfa | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Принятие шага времени TOTP. Возвращает false, если шаг не новее последнего принятого
+// или второй фактор не подтвержден
+func (s *Users) UseUserMFAStep(ctx context.Context, userID, step uint64) (bool, error) {
+	const op = "DbUsers.UseUserMFAStep"
+
+	resp, err := s.c.Connection.Call17(funcUserMFAUseStep, []any{userID, step})
+	if err != nil {
+		return false, fmt.Errorf("failed to use user mfa step | %s:%w", op, err)
+	}
+
+	return callResult(resp), nil
+}
+
+// Использование кода восстановления по его хешу. Возвращает false, если код уже использован
+func (s *Users) UseUserMFARecoveryCode(ctx context.Context, userID uint64, hash string) (bool, error) {
+	const op = "DbUsers.UseUserMFARecoveryCode"
+
+	resp, err := s.c.Connection.Call17(funcUserMFAUseRecoveryCode, []any{userID, hash})
+	if err != nil {
+		return false, fmt.Errorf("failed to use user mfa recovery code | %s:%w", op, err)
+	}
+
+	return callResult(resp), nil
+}
+
+func callResult(resp *tarantool.Response) bool {
+	if len(resp.Data) < 1 {
+		return false
+	}
+
+	ok, _ := resp.Data[0].(bool)
+
+	return ok
+}
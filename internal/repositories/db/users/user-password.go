@@ -0,0 +1,57 @@
+package tarantoolusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tarantool/go-tarantool"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	clienttarantool "github.com/vtievsky/auth-id/internal/repositories/db/client/tarantool"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+)
+
+func (s *Users) GetUserPassword(ctx context.Context, userID uint64) (*models.UserPassword, error) {
+	const op = "DbUsers.GetUserPassword"
+
+	resp, err := s.c.Connection.Select(spaceUserPassword, "pk", 0, 1, tarantool.IterEq, clienttarantool.Tuple{userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user password | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get user password | %s:%w", op, dberrors.ErrUserPasswordNotFound)
+	}
+
+	password := clienttarantool.Tuple(resp.Tuples()[0]).ToUserPassword()
+
+	return &models.UserPassword{
+		UserID:    password.UserID,
+		History:   password.History,
+		ChangedAt: password.ChangedAt,
+	}, nil
+}
+
+// Создание или замена истории паролей пользователя
+func (s *Users) SaveUserPassword(ctx context.Context, password models.UserPassword) error {
+	const op = "DbUsers.SaveUserPassword"
+
+	userPassword := clienttarantool.UserPassword{
+		UserID:    password.UserID,
+		History:   password.History,
+		ChangedAt: password.ChangedAt,
+	}
+
+	if _, err := s.c.Connection.Replace(spaceUserPassword, userPassword.ToTuple()); err != nil {
+		return fmt.Errorf("failed to save user password | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Users) deleteUserPassword(ctx context.Context, userID uint64) error {
+	if _, err := s.c.Connection.Delete(spaceUserPassword, "pk", clienttarantool.Tuple{userID}); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return nil
+}
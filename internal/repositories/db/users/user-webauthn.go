@@ -0,0 +1,110 @@
+package tarantoolusers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tarantool/go-tarantool"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	clienttarantool "github.com/vtievsky/auth-id/internal/repositories/db/client/tarantool"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+)
+
+const (
+	webAuthnLimit = 100 // Ограничение количества ключей одного пользователя
+)
+
+func (s *Users) GetWebAuthnCredentials(ctx context.Context, userID uint64) ([]*models.WebAuthnCredential, error) {
+	const op = "DbUsers.GetWebAuthnCredentials"
+
+	resp, err := s.c.Connection.Select(spaceWebAuthn, "secondary", 0, webAuthnLimit, tarantool.IterEq, clienttarantool.Tuple{userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webauthn credentials | %s:%w", op, err)
+	}
+
+	ul := make([]*models.WebAuthnCredential, 0, len(resp.Tuples()))
+
+	for _, tuple := range resp.Tuples() {
+		credential := clienttarantool.Tuple(tuple).ToWebAuthnCredential()
+
+		ul = append(ul, toWebAuthnCredential(credential))
+	}
+
+	return ul, nil
+}
+
+func (s *Users) GetWebAuthnCredential(ctx context.Context, credentialID string) (*models.WebAuthnCredential, error) {
+	const op = "DbUsers.GetWebAuthnCredential"
+
+	resp, err := s.c.Connection.Select(spaceWebAuthn, "pk", 0, 1, tarantool.IterEq, clienttarantool.Tuple{credentialID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webauthn credential | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get webauthn credential | %s:%w", op, dberrors.ErrCredentialNotFound)
+	}
+
+	credential := clienttarantool.Tuple(resp.Tuples()[0]).ToWebAuthnCredential()
+
+	return toWebAuthnCredential(credential), nil
+}
+
+// Создание или замена ключа, в том числе для сохранения счетчика подписей
+func (s *Users) SaveWebAuthnCredential(ctx context.Context, credential models.WebAuthnCredential) error {
+	const op = "DbUsers.SaveWebAuthnCredential"
+
+	value := clienttarantool.WebAuthnCredential{
+		ID:         credential.ID,
+		UserID:     credential.UserID,
+		Name:       credential.Name,
+		PublicKey:  credential.PublicKey,
+		SignCount:  credential.SignCount,
+		Transports: credential.Transports,
+		CreatedAt:  credential.CreatedAt,
+	}
+
+	if _, err := s.c.Connection.Replace(spaceWebAuthn, value.ToTuple()); err != nil {
+		return fmt.Errorf("failed to save webauthn credential | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Users) DeleteWebAuthnCredential(ctx context.Context, credentialID string) error {
+	const op = "DbUsers.DeleteWebAuthnCredential"
+
+	if _, err := s.c.Connection.Delete(spaceWebAuthn, "pk", clienttarantool.Tuple{credentialID}); err != nil {
+		return fmt.Errorf("failed to delete webauthn credential | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Удаление по неуникальному индексу не поддерживается, ключи удаляются по одному
+func (s *Users) deleteWebAuthnCredentials(ctx context.Context, userID uint64) error {
+	credentials, err := s.GetWebAuthnCredentials(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		if err = s.DeleteWebAuthnCredential(ctx, credential.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toWebAuthnCredential(credential clienttarantool.WebAuthnCredential) *models.WebAuthnCredential {
+	return &models.WebAuthnCredential{
+		ID:         credential.ID,
+		UserID:     credential.UserID,
+		Name:       credential.Name,
+		PublicKey:  credential.PublicKey,
+		SignCount:  credential.SignCount,
+		Transports: credential.Transports,
+		CreatedAt:  credential.CreatedAt,
+	}
+}
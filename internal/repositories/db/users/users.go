@@ -12,8 +12,15 @@ import (
 )
 
 const (
-	space         = "user"
-	spaceUserRole = "role_user"
+	space             = "user"
+	spaceUserRole     = "role_user"
+	spaceUserMFA      = "user_mfa"
+	spaceWebAuthn     = "user_webauthn"
+	spaceUserPassword = "user_password"
+
+	// Функции Tarantool, объявленные в build/scripts
+	funcUserMFAUseStep         = "user_mfa_use_step"
+	funcUserMFAUseRecoveryCode = "user_mfa_use_recovery_code"
 )
 
 type UsersOpts struct {
@@ -50,6 +57,31 @@ func (s *Users) GetUser(ctx context.Context, login string) (*models.User, error)
 		Login:    user.Login,
 		Password: user.Password,
 		Blocked:  user.Blocked,
+		Email:    user.Email,
+	}, nil
+}
+
+func (s *Users) GetUserByID(ctx context.Context, id uint64) (*models.User, error) {
+	const op = "DbUsers.GetUserByID"
+
+	resp, err := s.c.Connection.Select(space, "pk", 0, 1, tarantool.IterEq, clienttarantool.Tuple{id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if len(resp.Tuples()) < 1 {
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, dberrors.ErrUserNotFound)
+	}
+
+	user := clienttarantool.Tuple(resp.Tuples()[0]).ToUser()
+
+	return &models.User{
+		ID:       user.ID,
+		Name:     user.Name,
+		Login:    user.Login,
+		Password: user.Password,
+		Blocked:  user.Blocked,
+		Email:    user.Email,
 	}, nil
 }
 
@@ -74,6 +106,7 @@ func (s *Users) GetUsers(ctx context.Context, pageSize, offset uint32) ([]*model
 			Login:    user.Login,
 			Password: user.Password,
 			Blocked:  user.Blocked,
+			Email:    user.Email,
 		})
 	}
 
@@ -93,6 +126,7 @@ func (s *Users) CreateUser(ctx context.Context, user models.UserCreated) (*model
 			Login:    user.Login,
 			Password: user.Password,
 			Blocked:  user.Blocked,
+			Email:    user.Email,
 		}
 
 		if _, err := s.c.Connection.Insert(space, userCreated.ToTuple()); err != nil {
@@ -119,6 +153,7 @@ func (s *Users) UpdateUser(ctx context.Context, user models.UserUpdated) (*model
 		Login:    user.Login,
 		Password: user.Password,
 		Blocked:  user.Blocked,
+		Email:    user.Email,
 	}
 
 	if _, err := s.c.Connection.Replace(space, userUpdated.ToTuple()); err != nil {
@@ -131,7 +166,8 @@ func (s *Users) UpdateUser(ctx context.Context, user models.UserUpdated) (*model
 func (s *Users) DeleteUser(ctx context.Context, login string) error {
 	const op = "DbUsers.DeleteUser"
 
-	if _, err := s.GetUser(ctx, login); err != nil {
+	u, err := s.GetUser(ctx, login)
+	if err != nil {
 		return fmt.Errorf("failed to delete user | %s:%w", op, err)
 	}
 
@@ -139,5 +175,18 @@ func (s *Users) DeleteUser(ctx context.Context, login string) error {
 		return fmt.Errorf("failed to delete user | %s:%w", op, err)
 	}
 
+	// Удалим настройки второго фактора пользователя
+	if err = s.DeleteUserMFA(ctx, u.ID); err != nil {
+		return fmt.Errorf("failed to delete user | %s:%w", op, err)
+	}
+
+	if err = s.deleteWebAuthnCredentials(ctx, u.ID); err != nil {
+		return fmt.Errorf("failed to delete user | %s:%w", op, err)
+	}
+
+	if err = s.deleteUserPassword(ctx, u.ID); err != nil {
+		return fmt.Errorf("failed to delete user | %s:%w", op, err)
+	}
+
 	return nil
 }
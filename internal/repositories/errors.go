@@ -3,11 +3,14 @@ package dberrors
 import "errors"
 
 var (
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserScan          = errors.New("user scan error")
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrRoleNotFound      = errors.New("role not found")
-	ErrRoleScan          = errors.New("role scan error")
-	ErrRoleAlreadyExists = errors.New("role already exists")
-	ErrPrivilegeNotFound = errors.New("privilege not found")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserScan             = errors.New("user scan error")
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrRoleNotFound         = errors.New("role not found")
+	ErrRoleScan             = errors.New("role scan error")
+	ErrRoleAlreadyExists    = errors.New("role already exists")
+	ErrPrivilegeNotFound    = errors.New("privilege not found")
+	ErrUserMFANotFound      = errors.New("user mfa not found")
+	ErrCredentialNotFound   = errors.New("webauthn credential not found")
+	ErrUserPasswordNotFound = errors.New("user password not found")
 )
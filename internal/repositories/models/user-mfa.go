@@ -0,0 +1,9 @@
+package models
+
+type UserMFA struct {
+	UserID        uint64
+	Secret        string // Зашифрованный секрет TOTP
+	Confirmed     bool   // Устройство подтверждено первым кодом
+	LastStep      uint64 // Шаг времени последнего принятого кода
+	RecoveryCodes []string
+}
@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+type UserPassword struct {
+	UserID    uint64
+	History   []string  // Хеши предыдущих паролей, начиная с последнего
+	ChangedAt time.Time // Время последней смены пароля
+}
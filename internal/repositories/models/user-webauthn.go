@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type WebAuthnCredential struct {
+	ID         string // Идентификатор ключа в base64url
+	UserID     uint64
+	Name       string // Название ключа, заданное пользователем
+	PublicKey  string // Открытый ключ COSE в base64url
+	SignCount  uint64 // Счетчик подписей аутентификатора
+	Transports []string
+	CreatedAt  time.Time
+}
@@ -7,6 +7,7 @@ type User struct {
 	Login    string // Ключевое поле для интерфейса
 	Password string
 	Blocked  bool
+	Email    string // Пусто, если адрес не указан
 }
 
 type UserCreated struct {
@@ -14,6 +15,7 @@ type UserCreated struct {
 	Login    string
 	Password string
 	Blocked  bool
+	Email    string
 }
 
 type UserUpdated struct {
@@ -21,4 +23,5 @@ type UserUpdated struct {
 	Login    string // Ключевое поле для интерфейса
 	Password string
 	Blocked  bool
+	Email    string
 }
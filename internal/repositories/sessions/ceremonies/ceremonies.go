@@ -0,0 +1,92 @@
+package repoceremonies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	space = "wan:"
+)
+
+var (
+	ErrCeremonyNotFound = errors.New("ceremony not found")
+)
+
+// Незавершенная церемония WebAuthn
+type Ceremony struct {
+	Login            string `redis:"login"`
+	Kind             string `redis:"kind"`
+	Challenge        string `redis:"challenge"`         // Вызов в base64url
+	UserVerification bool   `redis:"user_verification"` // Требуется проверка пользователя аутентификатором
+	MFAChallenge     string `redis:"mfa_challenge"`     // Незавершенный вход, подтверждаемый ключом
+}
+
+type CeremoniesOpts struct {
+	Client *clientredis.Client
+}
+
+type Ceremonies struct {
+	client *clientredis.Client
+}
+
+func New(opts *CeremoniesOpts) *Ceremonies {
+	return &Ceremonies{
+		client: opts.Client,
+	}
+}
+
+func (s *Ceremonies) Store(ctx context.Context, ceremonyID string, ceremony Ceremony, ttl time.Duration) error {
+	const op = "Ceremonies.Store"
+
+	key := s.key(ceremonyID)
+
+	pipe := s.client.TxPipeline()
+	pipe.HSet(ctx, key, ceremony)
+	pipe.Expire(ctx, key, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store ceremony | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Получение и удаление церемонии: каждый вызов принимается только один раз
+func (s *Ceremonies) Take(ctx context.Context, ceremonyID string) (*Ceremony, error) {
+	const op = "Ceremonies.Take"
+
+	key := s.key(ceremonyID)
+
+	var cmd *redis.MapStringStringCmd
+
+	if _, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.HGetAll(ctx, key)
+		pipe.Del(ctx, key)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to take ceremony | %s:%w", op, err)
+	}
+
+	if len(cmd.Val()) == 0 {
+		return nil, fmt.Errorf("failed to take ceremony | %s:%w", op, ErrCeremonyNotFound)
+	}
+
+	var ceremony Ceremony
+
+	if err := cmd.Scan(&ceremony); err != nil {
+		return nil, fmt.Errorf("failed to scan ceremony | %s:%w", op, err)
+	}
+
+	return &ceremony, nil
+}
+
+func (s *Ceremonies) key(ceremonyID string) string {
+	return fmt.Sprintf("%s%s", space, ceremonyID)
+}
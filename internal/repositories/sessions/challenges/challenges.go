@@ -0,0 +1,122 @@
+package repochallenges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	space = "mfa:"
+
+	KindMFA            = "mfa"             // Подтверждение входа вторым фактором
+	KindPasswordChange = "password_change" // Смена истекшего пароля
+)
+
+var (
+	ErrChallengeNotFound = errors.New("challenge not found")
+)
+
+// Учет попытки только для существующего входа, чтобы не оставить ключ без срока жизни
+var attemptScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+`) //nolint:gochecknoglobals
+
+// Незавершенный вход, ожидающий подтверждения вторым фактором или смены пароля
+type Challenge struct {
+	Login    string `redis:"login"`
+	Kind     string `redis:"kind"`
+	Attempts int64  `redis:"attempts"` // Количество попыток ввода кода
+}
+
+type ChallengesOpts struct {
+	Client *clientredis.Client
+}
+
+type Challenges struct {
+	client *clientredis.Client
+}
+
+func New(opts *ChallengesOpts) *Challenges {
+	return &Challenges{
+		client: opts.Client,
+	}
+}
+
+func (s *Challenges) Store(ctx context.Context, challengeID, kind, login string, ttl time.Duration) error {
+	const op = "Challenges.Store"
+
+	key := s.key(challengeID)
+
+	pipe := s.client.TxPipeline()
+	pipe.HSet(ctx, key, "login", login, "kind", kind, "attempts", 0)
+	pipe.Expire(ctx, key, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store challenge | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Challenges) Get(ctx context.Context, challengeID string) (*Challenge, error) {
+	const op = "Challenges.Get"
+
+	cmd := s.client.HGetAll(ctx, s.key(challengeID))
+
+	switch {
+	case cmd.Err() != nil:
+		return nil, fmt.Errorf("failed to get challenge | %s:%w", op, cmd.Err())
+	case len(cmd.Val()) == 0:
+		return nil, fmt.Errorf("failed to get challenge | %s:%w", op, ErrChallengeNotFound)
+	}
+
+	var challenge Challenge
+
+	if err := cmd.Scan(&challenge); err != nil {
+		return nil, fmt.Errorf("failed to scan challenge | %s:%w", op, err)
+	}
+
+	return &challenge, nil
+}
+
+// Резервирование попытки ввода кода до его проверки, возвращает номер попытки.
+// Параллельные запросы получают разные номера, поэтому ограничение попыток не обходится
+func (s *Challenges) Attempt(ctx context.Context, challengeID string) (int64, error) {
+	const op = "Challenges.Attempt"
+
+	attempts, err := attemptScript.Run(ctx, s.client, []string{s.key(challengeID)}).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to register challenge attempt | %s:%w", op, err)
+	}
+
+	if attempts < 0 {
+		return 0, fmt.Errorf("failed to register challenge attempt | %s:%w", op, ErrChallengeNotFound)
+	}
+
+	return attempts, nil
+}
+
+// Удаление подтвержденного или исчерпавшего попытки входа.
+// Возвращает false, если вход уже был удален, чтобы исключить повторное завершение
+func (s *Challenges) Delete(ctx context.Context, challengeID string) (bool, error) {
+	const op = "Challenges.Delete"
+
+	deleted, err := s.client.Del(ctx, s.key(challengeID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete challenge | %s:%w", op, err)
+	}
+
+	return deleted > 0, nil
+}
+
+func (s *Challenges) key(challengeID string) string {
+	return fmt.Sprintf("%s%s", space, challengeID)
+}
@@ -0,0 +1,189 @@
+package repoinvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+	"github.com/vtievsky/auth-id/pkg/cache"
+	"go.uber.org/zap"
+)
+
+const (
+	channel             = "cache:invalidate"
+	healthCheckInterval = time.Second * 30
+	minRetryDelay       = time.Millisecond * 100
+	maxRetryDelay       = time.Second * 30
+)
+
+type BusOpts struct {
+	Logger *zap.Logger
+	Client *clientredis.Client
+}
+
+// Рассылка сброса ключей локальных кешей всем экземплярам приложения через Redis pub/sub
+type Bus struct {
+	logger   *zap.Logger
+	client   *clientredis.Client
+	mu       sync.RWMutex
+	handlers map[string][]func(msg cache.Message)
+}
+
+func New(opts *BusOpts) *Bus {
+	return &Bus{
+		logger:   opts.Logger,
+		client:   opts.Client,
+		mu:       sync.RWMutex{},
+		handlers: map[string][]func(msg cache.Message){},
+	}
+}
+
+func (s *Bus) Publish(ctx context.Context, msg cache.Message) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		s.logger.Error("failed to marshal invalidation",
+			zap.String("name", msg.Name),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if _, err = s.client.Publish(ctx, channel, payload).Result(); err != nil {
+		s.logger.Error("failed to publish invalidation",
+			zap.String("name", msg.Name),
+			zap.Strings("keys", msg.Keys),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *Bus) Subscribe(name string, handler func(msg cache.Message)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[name] = append(s.handlers[name], handler)
+}
+
+// Прием рассылки до отмены контекста. При потере соединения подписка восстанавливается
+// с нарастающей задержкой, после каждой подписки локальные кеши сбрасываются целиком,
+// так как сообщения, отправленные за время разрыва, потеряны
+func (s *Bus) Run(ctx context.Context) {
+	pubsub := s.client.Subscribe(ctx, channel)
+
+	// Чтение не прерывается отменой контекста, поэтому подписка закрывается явно
+	go func() {
+		<-ctx.Done()
+
+		_ = pubsub.Close()
+	}()
+
+	var attempt int
+
+	for {
+		received, err := pubsub.ReceiveTimeout(ctx, healthCheckInterval)
+
+		switch {
+		case ctx.Err() != nil:
+			return
+		case isTimeout(err):
+			// Проверка соединения при отсутствии сообщений, ответ придет следующим сообщением
+			err = pubsub.Ping(ctx)
+		}
+
+		if err != nil {
+			delay := retryDelay(attempt)
+			attempt++
+
+			s.logger.Error("failed to receive invalidation",
+				zap.Duration("retry_in", delay),
+				zap.Error(err),
+			)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+
+		attempt = 0
+
+		switch msg := received.(type) {
+		case *redis.Subscription:
+			s.flush()
+		case *redis.Message:
+			s.dispatch(msg.Payload)
+		}
+	}
+}
+
+// Сброс всех локальных кешей
+func (s *Bus) flush() {
+	s.mu.RLock()
+	handlers := maps.Clone(s.handlers)
+	s.mu.RUnlock()
+
+	for name, nameHandlers := range handlers {
+		for _, handler := range nameHandlers {
+			handler(cache.Message{Name: name, Origin: "", Keys: nil, Flush: true})
+		}
+	}
+
+	s.logger.Info("local caches have been flushed after subscription")
+}
+
+func (s *Bus) dispatch(payload string) {
+	var msg cache.Message
+
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		s.logger.Error("failed to unmarshal invalidation",
+			zap.String("payload", payload),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	s.mu.RLock()
+	handlers := s.handlers[msg.Name]
+	s.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(msg)
+	}
+
+	s.logger.Debug("invalidation has been received",
+		zap.String("name", msg.Name),
+		zap.Int("num", len(msg.Keys)),
+	)
+}
+
+// Задержка перед повторной попыткой удваивается до достижения предела
+func retryDelay(attempt int) time.Duration {
+	delay := minRetryDelay
+
+	for range attempt {
+		if delay >= maxRetryDelay/2 { //nolint:mnd
+			return maxRetryDelay
+		}
+
+		delay *= 2
+	}
+
+	return delay
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,184 @@
+package repolockouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	space = "lck:"
+)
+
+const (
+	Reserved  = iota // Попытка разрешена и учтена
+	Locked           // Действует временная блокировка
+	Throttled        // Не истекла задержка после предыдущей попытки
+)
+
+// Атомарная проверка блокировки и резервирование попытки. Попытка заранее учитывается
+// как неудачная, чтобы параллельные запросы не проходили проверку одновременно
+var reserveScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local state = redis.call('HMGET', KEYS[1], 'locked_until', 'next_at')
+local locked_until = tonumber(state[1] or 0)
+if locked_until > now then
+	return {1, 0, 0, locked_until}
+end
+local next_at = tonumber(state[2] or 0)
+if next_at > now then
+	return {2, 0, next_at, 0}
+end
+local failures = redis.call('HINCRBY', KEYS[1], 'failures', 1)
+local delay = 0
+local base_delay = tonumber(ARGV[4])
+if base_delay > 0 then
+	delay = base_delay * 2 ^ math.min(failures - 1, tonumber(ARGV[6]))
+	local max_delay = tonumber(ARGV[5])
+	if max_delay > 0 and delay > max_delay then
+		delay = max_delay
+	end
+end
+next_at = 0
+if delay > 0 then
+	next_at = now + delay
+end
+locked_until = 0
+local threshold = tonumber(ARGV[2])
+if threshold > 0 and failures >= threshold then
+	locked_until = now + tonumber(ARGV[3])
+end
+redis.call('HSET', KEYS[1], 'next_at', next_at, 'locked_until', locked_until)
+redis.call('PEXPIRE', KEYS[1], ARGV[7])
+return {0, failures, next_at, locked_until}
+`) //nolint:gochecknoglobals
+
+// Отмена резервирования попытки, которая не оказалась неудачной. Задержка и блокировка
+// снимаются, только если они установлены этим резервированием
+var releaseScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+if tonumber(redis.call('HGET', KEYS[1], 'failures') or 0) > 0 then
+	redis.call('HINCRBY', KEYS[1], 'failures', -1)
+end
+if redis.call('HGET', KEYS[1], 'next_at') == ARGV[1] then
+	redis.call('HSET', KEYS[1], 'next_at', 0)
+end
+if redis.call('HGET', KEYS[1], 'locked_until') == ARGV[2] then
+	redis.call('HSET', KEYS[1], 'locked_until', 0)
+end
+return 1
+`) //nolint:gochecknoglobals
+
+// Правила ограничения попыток, применяемые при резервировании
+type Rule struct {
+	Threshold     int64         // Попыток до временной блокировки, 0 - не блокировать
+	Duration      time.Duration // Длительность временной блокировки
+	BaseDelay     time.Duration // Задержка после первой попытки, далее удваивается
+	MaxDelay      time.Duration // Максимальная задержка между попытками
+	MaxDelayShift int64         // Ограничение степени двойки задержки
+	TTL           time.Duration // Срок хранения счетчика с момента последней попытки
+}
+
+// Результат резервирования попытки входа
+type Reservation struct {
+	Status      int
+	Failures    int64     // Неудачных попыток подряд с учетом зарезервированной
+	NextAt      time.Time // Момент, раньше которого следующая попытка отклоняется
+	LockedUntil time.Time // Момент окончания временной блокировки
+}
+
+type LockoutsOpts struct {
+	Client *clientredis.Client
+}
+
+type Lockouts struct {
+	client *clientredis.Client
+}
+
+func New(opts *LockoutsOpts) *Lockouts {
+	return &Lockouts{
+		client: opts.Client,
+	}
+}
+
+// Резервирование попытки входа. При действующей блокировке или задержке попытка
+// не учитывается, а в результате возвращается момент ее окончания
+func (s *Lockouts) Reserve(ctx context.Context, login string, rule Rule) (*Reservation, error) {
+	const op = "Lockouts.Reserve"
+
+	resp, err := reserveScript.Run(ctx, s.client, []string{s.key(login)},
+		time.Now().UnixMilli(),
+		rule.Threshold,
+		rule.Duration.Milliseconds(),
+		rule.BaseDelay.Milliseconds(),
+		rule.MaxDelay.Milliseconds(),
+		rule.MaxDelayShift,
+		rule.TTL.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve login attempt | %s:%w", op, err)
+	}
+
+	const fields = 4
+
+	if len(resp) != fields {
+		return nil, fmt.Errorf("failed to reserve login attempt | %s:unexpected response %v", op, resp)
+	}
+
+	return &Reservation{
+		Status:      int(resp[0]),
+		Failures:    resp[1],
+		NextAt:      fromUnixMilli(resp[2]),
+		LockedUntil: fromUnixMilli(resp[3]),
+	}, nil
+}
+
+// Возврат зарезервированной попытки, если она не была неудачной
+func (s *Lockouts) Release(ctx context.Context, login string, reservation *Reservation) error {
+	const op = "Lockouts.Release"
+
+	if err := releaseScript.Run(ctx, s.client, []string{s.key(login)},
+		unixMilli(reservation.NextAt),
+		unixMilli(reservation.LockedUntil),
+	).Err(); err != nil {
+		return fmt.Errorf("failed to release login attempt | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Сброс счетчика после успешного входа или снятия блокировки администратором
+func (s *Lockouts) Reset(ctx context.Context, login string) error {
+	const op = "Lockouts.Reset"
+
+	if _, err := s.client.Del(ctx, s.key(login)).Result(); err != nil {
+		return fmt.Errorf("failed to reset lockout | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Lockouts) key(login string) string {
+	return fmt.Sprintf("%s%s", space, login)
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixMilli()
+}
+
+func fromUnixMilli(ms int64) time.Time {
+	if ms <= 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,85 @@
+package repopassresets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	space = "pwr:"
+)
+
+var (
+	ErrPassResetNotFound = errors.New("password reset not found")
+)
+
+// Удаление только того токена, по которому сбрасывается пароль,
+// чтобы не удалить токен, выпущенный повторным запросом
+var deleteScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`) //nolint:gochecknoglobals
+
+type PassResetsOpts struct {
+	Client *clientredis.Client
+}
+
+// Хеши токенов сброса пароля. У пользователя действует только последний выпущенный токен
+type PassResets struct {
+	client *clientredis.Client
+}
+
+func New(opts *PassResetsOpts) *PassResets {
+	return &PassResets{
+		client: opts.Client,
+	}
+}
+
+func (s *PassResets) Store(ctx context.Context, login, tokenHash string, ttl time.Duration) error {
+	const op = "PassResets.Store"
+
+	if err := s.client.Set(ctx, s.key(login), tokenHash, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store password reset | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *PassResets) Get(ctx context.Context, login string) (string, error) {
+	const op = "PassResets.Get"
+
+	tokenHash, err := s.client.Get(ctx, s.key(login)).Result()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return "", fmt.Errorf("failed to get password reset | %s:%w", op, ErrPassResetNotFound)
+	case err != nil:
+		return "", fmt.Errorf("failed to get password reset | %s:%w", op, err)
+	}
+
+	return tokenHash, nil
+}
+
+// Удаление использованного токена.
+// Возвращает false, если токен уже был использован или заменен, чтобы исключить повторный сброс
+func (s *PassResets) Delete(ctx context.Context, login, tokenHash string) (bool, error) {
+	const op = "PassResets.Delete"
+
+	deleted, err := deleteScript.Run(ctx, s.client, []string{s.key(login)}, tokenHash).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete password reset | %s:%w", op, err)
+	}
+
+	return deleted > 0, nil
+}
+
+func (s *PassResets) key(login string) string {
+	return fmt.Sprintf("%s%s", space, login)
+}
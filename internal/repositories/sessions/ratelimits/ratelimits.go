@@ -0,0 +1,84 @@
+package reporatelimits
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	space = "rtl:"
+)
+
+// Корзина маркеров: пополняется со скоростью rate маркеров в секунду до емкости burst.
+// Время берется у Redis, чтобы ограничение было согласованным между экземплярами приложения.
+// Возвращает 1 и 0, если маркер получен, или 0 и время ожидания следующего маркера в миллисекундах
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+
+local allowed = 0
+local wait = 0
+
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate))
+
+return {allowed, wait}
+`) //nolint:gochecknoglobals
+
+type RateLimitsOpts struct {
+	Client *clientredis.Client
+}
+
+type RateLimits struct {
+	client *clientredis.Client
+}
+
+func New(opts *RateLimitsOpts) *RateLimits {
+	return &RateLimits{
+		client: opts.Client,
+	}
+}
+
+// Получение маркера из корзины ключа. Если маркера нет, возвращает время до его появления
+func (s *RateLimits) Take(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	const op = "RateLimits.Take"
+
+	res, err := takeScript.Run(ctx, s.client, []string{s.key(key)}, rate, burst).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to take token | %s:%w", op, err)
+	}
+
+	if len(res) < 2 { //nolint:mnd
+		return false, 0, fmt.Errorf("failed to take token | %s:unexpected script result %v", op, res)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+func (s *RateLimits) key(key string) string {
+	return fmt.Sprintf("%s%s", space, key)
+}
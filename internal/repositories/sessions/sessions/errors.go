@@ -6,4 +6,5 @@ var (
 	ErrSessionPrivilegesEmpty   = errors.New("session privileges empty")
 	ErrSessionPrivilegeNotFound = errors.New("session privilege not found")
 	ErrSessionCartNotFound      = errors.New("session cart not found")
+	ErrRefreshTokenReused       = errors.New("refresh token reused")
 )
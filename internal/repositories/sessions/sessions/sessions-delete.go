@@ -11,14 +11,16 @@ import (
 func (s *Sessions) Delete(ctx context.Context, login, sessionID string) error {
 	const op = "Sessions.Delete"
 
+	keyCart := s.keyCart(sessionID)
 	keySession := s.keySession(sessionID)
 	keySessions := s.keySessions(login)
 
 	g, gCtx := errgroup.WithContext(ctx)
 
-	// Удаление списка привилегий сессии
+	// Удаление карточки и списка привилегий сессии.
+	// Без карточки сессию невозможно продлить refresh-токеном
 	g.Go(func() error {
-		if _, err := s.client.Del(gCtx, keySession).Result(); err != nil {
+		if _, err := s.client.Del(gCtx, keyCart, keySession).Result(); err != nil {
 			return fmt.Errorf("failed to delete session privileges | %s:%w", op, err)
 		}
 
@@ -27,7 +29,7 @@ func (s *Sessions) Delete(ctx context.Context, login, sessionID string) error {
 
 	// Удаление сессии из списка сессий пользователя
 	g.Go(func() error {
-		if _, err := s.client.SRem(gCtx, keySessions, keySession).Result(); err != nil {
+		if _, err := s.client.SRem(gCtx, keySessions, sessionID).Result(); err != nil {
 			return fmt.Errorf("failed to delete session from sessions list | %s:%w", op, err)
 		}
 
@@ -40,3 +42,66 @@ func (s *Sessions) Delete(ctx context.Context, login, sessionID string) error {
 
 	return nil
 }
+
+// Удаляет все сессии пользователя, кроме указанной, перебирая список сессий пользователя.
+// Возвращает идентификаторы удаленных сессий
+func (s *Sessions) DeleteUserSessions(ctx context.Context, login, keepSessionID string) ([]string, error) {
+	const op = "Sessions.DeleteUserSessions"
+
+	keySessions := s.keySessions(login)
+
+	var (
+		deleted []string
+		batch   []string
+	)
+
+	// Удаление порциями по мере перебора, чтобы не держать весь список в памяти
+	flush := func() error {
+		if len(batch) < 1 {
+			return nil
+		}
+
+		pipe := s.client.TxPipeline()
+
+		for _, sessionID := range batch {
+			pipe.Del(ctx, s.keyCart(sessionID), s.keySession(sessionID))
+		}
+
+		pipe.SRem(ctx, keySessions, batch)
+
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		deleted = append(deleted, batch...)
+		batch = batch[:0]
+
+		return nil
+	}
+
+	iter := s.client.SScan(ctx, keySessions, 0, "", scanCount).Iterator()
+
+	for iter.Next(ctx) {
+		if sessionID := iter.Val(); sessionID != keepSessionID {
+			batch = append(batch, sessionID)
+		}
+
+		if len(batch) < scanCount {
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return deleted, fmt.Errorf("failed to delete user sessions | %s:%w", op, err)
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return deleted, fmt.Errorf("failed to scan sessions list | %s:%w", op, err)
+	}
+
+	if err := flush(); err != nil {
+		return deleted, fmt.Errorf("failed to delete user sessions | %s:%w", op, err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package reposessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Атомарная замена списка привилегий сессии и момента следующего пересчета
+// с сохранением срока жизни карточки.
+// Возвращает -1, если карточка не найдена или не имеет срока жизни
+var replaceSessionPrivilegesScript = redis.NewScript(`
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < 0 then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'sync_at', ARGV[1])
+redis.call('DEL', KEYS[2])
+redis.call('SADD', KEYS[2], unpack(ARGV, 2))
+redis.call('PEXPIRE', KEYS[2], ttl)
+return 1
+`) //nolint:gochecknoglobals
+
+// Идентификаторы сессий пользователя
+func (s *Sessions) ListSessionIDs(ctx context.Context, login string) ([]string, error) {
+	const op = "Sessions.ListSessionIDs"
+
+	ul, err := s.client.SMembers(ctx, s.keySessions(login)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions list | %s:%w", op, err)
+	}
+
+	return ul, nil
+}
+
+// Замена списка привилегий действующей сессии
+func (s *Sessions) ReplaceSessionPrivileges(
+	ctx context.Context,
+	sessionID string,
+	privileges []string,
+	syncAt time.Time,
+) error {
+	const op = "Sessions.ReplaceSessionPrivileges"
+
+	if len(privileges) < 1 {
+		return fmt.Errorf("failed to replace session privileges | %s:%w", op, ErrSessionPrivilegesEmpty)
+	}
+
+	args := make([]any, 0, len(privileges)+1)
+	args = append(args, unixTime(syncAt))
+
+	for _, privilege := range privileges {
+		args = append(args, privilege)
+	}
+
+	res, err := replaceSessionPrivilegesScript.Run(ctx, s.client,
+		[]string{s.keyCart(sessionID), s.keySession(sessionID)},
+		args...,
+	).Int()
+	if err != nil {
+		return fmt.Errorf("failed to replace session privileges | %s:%w", op, err)
+	}
+
+	if res < 0 {
+		return fmt.Errorf("failed to replace session privileges | %s:%w", op, ErrSessionCartNotFound)
+	}
+
+	return nil
+}
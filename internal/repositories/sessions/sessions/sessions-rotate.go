@@ -0,0 +1,47 @@
+package reposessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	rotateResultNotFound = -1
+	rotateResultReused   = 0
+)
+
+// Атомарная замена идентификатора refresh-токена в карточке сессии.
+// Возвращает -1, если карточка не найдена, 0 - если предъявлен
+// не актуальный (уже использованный) токен, 1 - при успешной замене
+var rotateRefreshTokenScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+local current = redis.call('HGET', KEYS[1], 'refresh_token_id') or ''
+if current ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'refresh_token_id', ARGV[2], 'refresh_token_used_id', ARGV[1])
+return 1
+`) //nolint:gochecknoglobals
+
+// Заменяет использованный refresh-токен сессии на новый
+func (s *Sessions) RotateRefreshToken(ctx context.Context, sessionID, usedID, newID string) error {
+	const op = "Sessions.RotateRefreshToken"
+
+	res, err := rotateRefreshTokenScript.Run(ctx, s.client, []string{s.keyCart(sessionID)}, usedID, newID).Int()
+	if err != nil {
+		return fmt.Errorf("failed to rotate refresh token | %s:%w", op, err)
+	}
+
+	switch res {
+	case rotateResultNotFound:
+		return fmt.Errorf("failed to rotate refresh token | %s:%w", op, ErrSessionCartNotFound)
+	case rotateResultReused:
+		return fmt.Errorf("failed to rotate refresh token | %s:%w", op, ErrRefreshTokenReused)
+	}
+
+	return nil
+}
@@ -9,15 +9,33 @@ import (
 )
 
 type SessionCart struct {
-	ID        string    `redis:"id"`
-	Login     string    `redis:"login"`
-	CreatedAt time.Time `redis:"created_at"`
+	ID                 string    `redis:"id"`
+	Login              string    `redis:"login"`
+	CreatedAt          time.Time `redis:"created_at"`
+	RefreshTokenID     string    `redis:"refresh_token_id"`      // Актуальный refresh-токен
+	RefreshTokenUsedID string    `redis:"refresh_token_used_id"` // Последний использованный refresh-токен
+	SyncAt             int64     `redis:"sync_at"`               // Unix-время пересчета привилегий, 0 - не требуется
+	IP                 string    `redis:"ip"`
+	UserAgent          string    `redis:"user_agent"`
+	Device             string    `redis:"device"`       // Название устройства, указанное клиентом
+	Method             string    `redis:"method"`       // Способ входа
+	LastSeenAt         int64     `redis:"last_seen_at"` // Unix-время последнего обращения, 0 - не обращалась
+}
+
+// Сведения о клиенте, открывшем сессию
+type SessionMeta struct {
+	IP        string
+	UserAgent string
+	Device    string
+	Method    string
 }
 
 func (s *Sessions) Store(
 	ctx context.Context,
-	login, sessionID string,
+	login, sessionID, refreshTokenID string,
 	privileges []string,
+	syncAt time.Time,
+	meta SessionMeta,
 	ttl time.Duration,
 ) error {
 	const op = "Sessions.Store"
@@ -34,9 +52,17 @@ func (s *Sessions) Store(
 
 	g.Go(func() error {
 		if _, err := s.client.HMSet(ctx, keyCart, SessionCart{
-			ID:        sessionID,
-			Login:     login,
-			CreatedAt: time.Now(),
+			ID:                 sessionID,
+			Login:              login,
+			CreatedAt:          time.Now(),
+			RefreshTokenID:     refreshTokenID,
+			RefreshTokenUsedID: "",
+			SyncAt:             unixTime(syncAt),
+			IP:                 meta.IP,
+			UserAgent:          meta.UserAgent,
+			Device:             meta.Device,
+			Method:             meta.Method,
+			LastSeenAt:         0,
 		}).Result(); err != nil {
 			return fmt.Errorf("failed to add session cart | %s:%w", op, err)
 		}
@@ -61,14 +87,17 @@ func (s *Sessions) Store(
 		return nil
 	})
 
-	// Добавление сессии в список сессий пользователя
+	// Добавление сессии в список сессий пользователя. Время жизни списка только продлевается,
+	// иначе короткая новая сессия исключит из него более долгие и они переживут отзыв всех сессий
 	g.Go(func() error {
-		if _, err := s.client.SAdd(gCtx, keySessions, sessionID).Result(); err != nil {
-			return fmt.Errorf("failed to add session to sessions list | %s:%w", op, err)
-		}
+		pipe := s.client.TxPipeline()
 
-		if _, err := s.client.Expire(gCtx, keySessions, ttl).Result(); err != nil {
-			return fmt.Errorf("failed to set ttl sessions list | %s:%w", op, err)
+		pipe.SAdd(gCtx, keySessions, sessionID)
+		pipe.ExpireNX(gCtx, keySessions, ttl)
+		pipe.ExpireGT(gCtx, keySessions, ttl)
+
+		if _, err := pipe.Exec(gCtx); err != nil {
+			return fmt.Errorf("failed to add session to sessions list | %s:%w", op, err)
 		}
 
 		return nil
@@ -80,3 +109,19 @@ func (s *Sessions) Store(
 
 	return nil
 }
+
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+func fromUnixTime(sec int64) time.Time {
+	if sec < 1 {
+		return time.Time{}
+	}
+
+	return time.Unix(sec, 0)
+}
@@ -0,0 +1,35 @@
+package reposessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Отметка времени последнего обращения без продления срока жизни карточки.
+// Удаленная или истекшая карточка не воссоздается
+var touchSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'last_seen_at', ARGV[1])
+return 1
+`) //nolint:gochecknoglobals
+
+// Сохраняет время последнего обращения к сессии
+func (s *Sessions) Touch(ctx context.Context, sessionID string, seenAt time.Time) error {
+	const op = "Sessions.Touch"
+
+	res, err := touchSessionScript.Run(ctx, s.client, []string{s.keyCart(sessionID)}, unixTime(seenAt)).Int()
+	if err != nil {
+		return fmt.Errorf("failed to touch session | %s:%w", op, err)
+	}
+
+	if res < 0 {
+		return fmt.Errorf("failed to touch session | %s:%w", op, ErrSessionCartNotFound)
+	}
+
+	return nil
+}
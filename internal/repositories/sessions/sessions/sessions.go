@@ -16,18 +16,29 @@ const (
 	spaceCarts    = "pak:"
 	spaceSessions = "omo:"
 	threadsLimit  = 5
+	scanCount     = 100 // Размер порции при переборе списка сессий пользователя
 )
 
 type sessionStats struct {
-	ID        string
-	TTL       time.Duration
-	CreatedAt time.Time
+	ID         string
+	TTL        time.Duration
+	CreatedAt  time.Time
+	IP         string
+	UserAgent  string
+	Device     string
+	Method     string
+	LastSeenAt time.Time
 }
 
 type Session struct {
-	ID        string
-	TTL       time.Duration
-	CreatedAt time.Time
+	ID         string
+	TTL        time.Duration
+	CreatedAt  time.Time
+	IP         string
+	UserAgent  string
+	Device     string
+	Method     string
+	LastSeenAt time.Time
 }
 
 type SessionsOpts struct {
@@ -150,9 +161,14 @@ func (s *Sessions) List(ctx context.Context, login string, pageSize, offset uint
 			}
 
 			acombine <- sessionStats{
-				ID:        asessionID,
-				TTL:       ttl,
-				CreatedAt: cart.CreatedAt,
+				ID:         asessionID,
+				TTL:        ttl,
+				CreatedAt:  cart.CreatedAt,
+				IP:         cart.IP,
+				UserAgent:  cart.UserAgent,
+				Device:     cart.Device,
+				Method:     cart.Method,
+				LastSeenAt: fromUnixTime(cart.LastSeenAt),
 			}
 
 			return nil
@@ -187,9 +203,14 @@ func (s *Sessions) List(ctx context.Context, login string, pageSize, offset uint
 
 	for v := range combine {
 		sessions = append(sessions, &Session{
-			ID:        v.ID,
-			TTL:       v.TTL,
-			CreatedAt: v.CreatedAt,
+			ID:         v.ID,
+			TTL:        v.TTL,
+			CreatedAt:  v.CreatedAt,
+			IP:         v.IP,
+			UserAgent:  v.UserAgent,
+			Device:     v.Device,
+			Method:     v.Method,
+			LastSeenAt: v.LastSeenAt,
 		})
 	}
 
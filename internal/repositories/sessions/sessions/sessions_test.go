@@ -0,0 +1,81 @@
+package reposessions_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	"go.uber.org/zap"
+)
+
+const login = "user"
+
+func newSessions(t *testing.T) (*reposessions.Sessions, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()}) //nolint:exhaustruct
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return reposessions.New(&reposessions.SessionsOpts{
+		Logger: zap.NewNop(),
+		Client: &clientredis.Client{UniversalClient: client},
+	}), server
+}
+
+func store(t *testing.T, sessions *reposessions.Sessions, sessionID string, ttl time.Duration) {
+	t.Helper()
+
+	err := sessions.Store(context.Background(), login, sessionID, sessionID+"-refresh",
+		[]string{"user_read"}, time.Time{}, reposessions.SessionMeta{}, ttl) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("failed to store session %s: %v", sessionID, err)
+	}
+}
+
+// Короткая сессия, открытая после долгой, не сокращает время жизни списка сессий пользователя
+func TestStoreKeepsLongerSessionsListTTL(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration // Время между открытием сессий и отзывом всех сессий
+	}{
+		{name: "both alive", elapsed: 0},
+		{name: "short expired", elapsed: time.Minute * 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions, server := newSessions(t)
+
+			store(t, sessions, "long", time.Hour)
+			store(t, sessions, "short", time.Minute)
+
+			if ttl := server.TTL("uev:" + login); ttl < time.Hour {
+				t.Fatalf("sessions list ttl shortened to %s", ttl)
+			}
+
+			server.FastForward(tt.elapsed)
+
+			deleted, err := sessions.DeleteUserSessions(context.Background(), login, "")
+			if err != nil {
+				t.Fatalf("failed to delete user sessions: %v", err)
+			}
+
+			if !slices.Contains(deleted, "long") {
+				t.Errorf("long session was not revoked, deleted %v", deleted)
+			}
+
+			for _, sessionID := range []string{"long", "short"} {
+				if server.Exists("pak:"+sessionID) || server.Exists("omo:"+sessionID) {
+					t.Errorf("session %s survived revoke-all", sessionID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package reposigningkeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+)
+
+const (
+	keyActive  = "jwk:active"
+	keyKeys    = "jwk:keys"
+	keyRetired = "jwk:retired"
+)
+
+var (
+	ErrActiveKeyNotFound = errors.New("active signing key not found")
+)
+
+type SigningKeysOpts struct {
+	Client *clientredis.Client
+}
+
+type SigningKeys struct {
+	client *clientredis.Client
+}
+
+func New(opts *SigningKeysOpts) *SigningKeys {
+	return &SigningKeys{
+		client: opts.Client,
+	}
+}
+
+// Идентификатор действующего ключа подписи, общий для всех экземпляров приложения
+func (s *SigningKeys) GetActive(ctx context.Context) (string, error) {
+	const op = "SigningKeys.GetActive"
+
+	kid, err := s.client.Get(ctx, keyActive).Result()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return "", fmt.Errorf("failed to get active signing key | %s:%w", op, ErrActiveKeyNotFound)
+	case err != nil:
+		return "", fmt.Errorf("failed to get active signing key | %s:%w", op, err)
+	}
+
+	return kid, nil
+}
+
+func (s *SigningKeys) SetActive(ctx context.Context, kid string) error {
+	const op = "SigningKeys.SetActive"
+
+	pipe := s.client.TxPipeline()
+
+	// Вновь назначенный ключ не считается выведенным из оборота
+	pipe.Set(ctx, keyActive, kid, 0)
+	pipe.HDel(ctx, keyRetired, kid)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set active signing key | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Зашифрованные ключи, созданные при ротации, по идентификаторам
+func (s *SigningKeys) GetKeys(ctx context.Context) (map[string][]byte, error) {
+	const op = "SigningKeys.GetKeys"
+
+	values, err := s.client.HGetAll(ctx, keyKeys).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing keys | %s:%w", op, err)
+	}
+
+	keys := make(map[string][]byte, len(values))
+
+	for kid, value := range values {
+		keys[kid] = []byte(value)
+	}
+
+	return keys, nil
+}
+
+func (s *SigningKeys) AddKey(ctx context.Context, kid string, key []byte) error {
+	const op = "SigningKeys.AddKey"
+
+	if _, err := s.client.HSet(ctx, keyKeys, kid, key).Result(); err != nil {
+		return fmt.Errorf("failed to add signing key | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Удаление ключа вместе с отметкой о выводе из оборота
+func (s *SigningKeys) DeleteKey(ctx context.Context, kid string) error {
+	const op = "SigningKeys.DeleteKey"
+
+	pipe := s.client.TxPipeline()
+
+	pipe.HDel(ctx, keyKeys, kid)
+	pipe.HDel(ctx, keyRetired, kid)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete signing key | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Отметка о выводе ключа из оборота, повторная отметка время не сдвигает
+func (s *SigningKeys) RetireKey(ctx context.Context, kid string, at time.Time) error {
+	const op = "SigningKeys.RetireKey"
+
+	if _, err := s.client.HSetNX(ctx, keyRetired, kid, at.Unix()).Result(); err != nil {
+		return fmt.Errorf("failed to retire signing key | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Время вывода из оборота по идентификаторам ключей
+func (s *SigningKeys) GetRetired(ctx context.Context) (map[string]time.Time, error) {
+	const op = "SigningKeys.GetRetired"
+
+	values, err := s.client.HGetAll(ctx, keyRetired).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retired signing keys | %s:%w", op, err)
+	}
+
+	retired := make(map[string]time.Time, len(values))
+
+	for kid, value := range values {
+		sec, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse retirement time of %s | %s:%w", kid, op, err)
+		}
+
+		retired[kid] = time.Unix(sec, 0)
+	}
+
+	return retired, nil
+}
@@ -0,0 +1,9 @@
+package routetable
+
+import "errors"
+
+var (
+	ErrRouteNotFound = errors.New("route not found")
+	ErrRouteInvalid  = errors.New("route invalid")
+	ErrPathInvalid   = errors.New("path not normalized")
+)
@@ -0,0 +1,201 @@
+package routetable
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	anyMethod = "*"
+	anyTail   = "*"
+
+	DefaultUpstream = "*"
+)
+
+// Маршрут защищаемого сервиса.
+// Path допускает параметры (:id) и завершающий шаблон (*) для всех вложенных путей
+type Route struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Privilege string `json:"privilege"`
+	Public    bool   `json:"public"` // Доступ без токена
+}
+
+// Маршруты сгруппированы по защищаемым сервисам (upstream).
+// Маршруты DefaultUpstream используются для сервисов без собственной таблицы
+type Table struct {
+	upstreams map[string][]route
+}
+
+type route struct {
+	Route
+	segments []string
+}
+
+func New(upstreams map[string][]Route) (*Table, error) {
+	const op = "routetable.New"
+
+	t := &Table{
+		upstreams: make(map[string][]route, len(upstreams)),
+	}
+
+	for upstream, routes := range upstreams {
+		ul := make([]route, 0, len(routes))
+
+		for _, r := range routes {
+			if r.Path == "" || (!r.Public && r.Privilege == "") {
+				return nil, fmt.Errorf("%s %s %s | %s:%w", upstream, r.Method, r.Path, op, ErrRouteInvalid)
+			}
+
+			if r.Method == "" {
+				r.Method = anyMethod
+			}
+
+			ul = append(ul, route{
+				Route:    r,
+				segments: split(r.Path),
+			})
+		}
+
+		t.upstreams[strings.ToLower(upstream)] = ul
+	}
+
+	return t, nil
+}
+
+// Загрузка таблицы маршрутов из JSON-файла вида
+// {"routes": [...], "upstreams": {"<upstream>": [...]}}, где routes - маршруты по умолчанию
+func Load(path string) (*Table, error) {
+	const op = "routetable.Load"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read route table | %s:%w", op, err)
+	}
+
+	var file struct {
+		Routes    []Route            `json:"routes"`
+		Upstreams map[string][]Route `json:"upstreams"`
+	}
+
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse route table | %s:%w", op, err)
+	}
+
+	upstreams := make(map[string][]Route, len(file.Upstreams)+1)
+
+	for upstream, routes := range file.Upstreams {
+		upstreams[upstream] = routes
+	}
+
+	if len(file.Routes) > 0 {
+		upstreams[DefaultUpstream] = append(upstreams[DefaultUpstream], file.Routes...)
+	}
+
+	return New(upstreams)
+}
+
+// Поиск первого подходящего маршрута сервиса в порядке объявления
+func (t *Table) Match(upstream, method, path string) (*Route, error) {
+	const op = "Table.Match"
+
+	routes, ok := t.upstreams[strings.ToLower(upstream)]
+	if !ok {
+		routes = t.upstreams[DefaultUpstream]
+	}
+
+	// Строка запроса не участвует в сопоставлении
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	cleaned, err := normalize(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s %s | %s:%w", upstream, method, path, op, err)
+	}
+
+	segments := split(cleaned)
+
+	for i := range routes {
+		r := &routes[i]
+
+		if r.Method != anyMethod && !strings.EqualFold(r.Method, method) {
+			continue
+		}
+
+		if match(r.segments, segments) {
+			return &r.Route, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s %s %s | %s:%w", upstream, method, path, op, ErrRouteNotFound)
+}
+
+func match(pattern, segments []string) bool {
+	for i, p := range pattern {
+		if p == anyTail && i == len(pattern)-1 {
+			return true
+		}
+
+		if i >= len(segments) {
+			return false
+		}
+
+		if strings.HasPrefix(p, ":") {
+			continue
+		}
+
+		if p != segments[i] {
+			return false
+		}
+	}
+
+	return len(pattern) == len(segments)
+}
+
+func split(value string) []string {
+	value = strings.Trim(value, "/")
+
+	if value == "" {
+		return []string{}
+	}
+
+	return strings.Split(value, "/")
+}
+
+// Декодирование пути запроса. Путь, который защищаемый сервис может разрешить иначе,
+// отклоняется: с точечными сегментами, пустыми сегментами, закодированными
+// или обратными косыми чертами. Завершающая косая черта допускается
+func normalize(value string) (string, error) {
+	lower := strings.ToLower(value)
+
+	if !strings.HasPrefix(value, "/") ||
+		strings.Contains(lower, "%2f") ||
+		strings.Contains(lower, "%5c") ||
+		strings.Contains(value, "\\") {
+		return "", ErrPathInvalid
+	}
+
+	decoded, err := url.PathUnescape(value)
+	if err != nil {
+		return "", ErrPathInvalid
+	}
+
+	for _, segment := range strings.Split(decoded, "/") {
+		if segment == "." || segment == ".." {
+			return "", ErrPathInvalid
+		}
+	}
+
+	cleaned := path.Clean(decoded)
+
+	if cleaned != decoded && cleaned+"/" != decoded {
+		return "", ErrPathInvalid
+	}
+
+	return cleaned, nil
+}
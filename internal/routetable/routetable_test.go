@@ -0,0 +1,118 @@
+package routetable_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vtievsky/auth-id/internal/routetable"
+)
+
+const upstream = "orders.local"
+
+func newTable(t *testing.T) *routetable.Table {
+	t.Helper()
+
+	table, err := routetable.New(map[string][]routetable.Route{
+		routetable.DefaultUpstream: {
+			{Method: "GET", Path: "/admin/*", Privilege: "admin_read", Public: false},
+			{Method: "", Path: "/public/*", Privilege: "", Public: true},
+			{Method: "DELETE", Path: "/users/:login", Privilege: "user_delete", Public: false},
+			{Method: "*", Path: "/users/:login", Privilege: "user_read", Public: false},
+		},
+		upstream: {
+			{Method: "GET", Path: "/orders/*", Privilege: "order_read", Public: false},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create route table: %v", err)
+	}
+
+	return table
+}
+
+func TestMatch(t *testing.T) {
+	table := newTable(t)
+
+	tests := []struct {
+		name      string
+		upstream  string
+		method    string
+		path      string
+		privilege string
+		public    bool
+		err       error
+	}{
+		{name: "tail wildcard", upstream: "", method: "GET", path: "/admin/users/1", privilege: "admin_read"},
+		{name: "tail wildcard without tail", upstream: "", method: "GET", path: "/admin", privilege: "admin_read"},
+		{name: "trailing slash", upstream: "", method: "GET", path: "/public/", public: true},
+		{name: "encoded letter", upstream: "", method: "GET", path: "/public/%61", public: true},
+
+		// Точечные сегменты не разрешаются: защищаемый сервис может понять путь иначе
+		{name: "dot dot segment", upstream: "", method: "GET", path: "/public/../admin", err: routetable.ErrPathInvalid},
+		{name: "dot dot from unknown prefix", upstream: "", method: "GET", path: "/a/../admin", err: routetable.ErrPathInvalid},
+		{name: "dot segment", upstream: "", method: "GET", path: "/public/./x", err: routetable.ErrPathInvalid},
+		{name: "encoded dot dot", upstream: "", method: "GET", path: "/public/%2e%2e/admin", err: routetable.ErrPathInvalid},
+		{name: "encoded upper dot dot", upstream: "", method: "GET", path: "/public/%2E%2E/admin", err: routetable.ErrPathInvalid},
+		{name: "encoded slash", upstream: "", method: "GET", path: "/public%2Fadmin", err: routetable.ErrPathInvalid},
+		{name: "encoded lower slash", upstream: "", method: "GET", path: "/public/..%2fadmin", err: routetable.ErrPathInvalid},
+		{name: "encoded backslash", upstream: "", method: "GET", path: "/public/..%5cadmin", err: routetable.ErrPathInvalid},
+		{name: "backslash", upstream: "", method: "GET", path: "/public\\..\\admin", err: routetable.ErrPathInvalid},
+		{name: "duplicate slashes", upstream: "", method: "GET", path: "/public//admin", err: routetable.ErrPathInvalid},
+		{name: "leading duplicate slashes", upstream: "", method: "GET", path: "//admin/users", err: routetable.ErrPathInvalid},
+		{name: "relative path", upstream: "", method: "GET", path: "admin/users", err: routetable.ErrPathInvalid},
+		{name: "invalid escape", upstream: "", method: "GET", path: "/public/%zz", err: routetable.ErrPathInvalid},
+
+		// Строка запроса и фрагмент отбрасываются до нормализации
+		{name: "query", upstream: "", method: "GET", path: "/admin/users?next=/public/", privilege: "admin_read"},
+		{name: "fragment", upstream: "", method: "GET", path: "/admin/users#/public/", privilege: "admin_read"},
+		{name: "dot dot in query", upstream: "", method: "GET", path: "/public/x?next=/../admin", public: true},
+
+		{name: "method", upstream: "", method: "DELETE", path: "/users/ivan", privilege: "user_delete"},
+		{name: "method case", upstream: "", method: "delete", path: "/users/ivan", privilege: "user_delete"},
+		{name: "method wildcard", upstream: "", method: "PATCH", path: "/users/ivan", privilege: "user_read"},
+		{name: "method empty wildcard", upstream: "", method: "POST", path: "/public/form", public: true},
+		{name: "method mismatch", upstream: "", method: "POST", path: "/admin/users", err: routetable.ErrRouteNotFound},
+		{name: "param single segment", upstream: "", method: "GET", path: "/users/ivan/roles", err: routetable.ErrRouteNotFound},
+
+		// Сервис с собственной таблицей не наследует маршруты по умолчанию
+		{name: "upstream", upstream: upstream, method: "GET", path: "/orders/1", privilege: "order_read"},
+		{name: "upstream case", upstream: "Orders.Local", method: "GET", path: "/orders/1", privilege: "order_read"},
+		{name: "upstream without default", upstream: upstream, method: "GET", path: "/admin/users", err: routetable.ErrRouteNotFound},
+		{name: "unknown upstream fallback", upstream: "billing.local", method: "GET", path: "/admin/users", privilege: "admin_read"},
+		{name: "unknown upstream not found", upstream: "billing.local", method: "GET", path: "/orders/1", err: routetable.ErrRouteNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, err := table.Match(tt.upstream, tt.method, tt.path)
+
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got route %+v and error %v", tt.err, route, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to match route: %v", err)
+			}
+
+			if route.Privilege != tt.privilege || route.Public != tt.public {
+				t.Fatalf("unexpected route %+v", route)
+			}
+		})
+	}
+}
+
+// Закрытый маршрут без привилегии открыл бы доступ любой сессии
+func TestNewInvalidRoute(t *testing.T) {
+	_, err := routetable.New(map[string][]routetable.Route{
+		routetable.DefaultUpstream: {
+			{Method: "GET", Path: "/admin/*", Privilege: "", Public: false},
+		},
+	})
+	if !errors.Is(err, routetable.ErrRouteInvalid) {
+		t.Fatalf("expected ErrRouteInvalid, got %v", err)
+	}
+}
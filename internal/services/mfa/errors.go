@@ -0,0 +1,12 @@
+package mfasvc
+
+import "errors"
+
+var (
+	ErrMFANotConfigured  = errors.New("mfa encryption key not configured")
+	ErrMFANotEnrolled    = errors.New("mfa not enrolled")
+	ErrMFAAlreadyEnabled = errors.New("mfa already enabled")
+	ErrMFACodeInvalid    = errors.New("mfa code invalid")
+	ErrMFACodeReused     = errors.New("mfa code reused")
+	ErrMFASecretInvalid  = errors.New("mfa secret invalid")
+)
@@ -0,0 +1,387 @@
+package mfasvc
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"github.com/vtievsky/auth-id/pkg/totp"
+	"go.uber.org/zap"
+)
+
+const (
+	recoveryCodesNum  = 10
+	recoveryCodeBytes = 5 // 8 символов base32
+	defaultSkew       = 1
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding) //nolint:gochecknoglobals
+
+// Данные для подключения приложения-аутентификатора
+type Enrollment struct {
+	Secret string // Секрет для ручного ввода
+	URI    string // Ссылка otpauth:// для QR-кода
+}
+
+type Storage interface {
+	GetUserMFA(ctx context.Context, userID uint64) (*models.UserMFA, error)
+	SaveUserMFA(ctx context.Context, mfa models.UserMFA) error
+	UseUserMFAStep(ctx context.Context, userID, step uint64) (bool, error)
+	UseUserMFARecoveryCode(ctx context.Context, userID uint64, hash string) (bool, error)
+	DeleteUserMFA(ctx context.Context, userID uint64) error
+}
+
+type UserSvc interface {
+	GetUser(ctx context.Context, login string) (*usersvc.User, error)
+}
+
+type MFASvcOpts struct {
+	Logger  *zap.Logger
+	Storage Storage
+	UserSvc UserSvc
+	Issuer  string
+	Cipher  cipher.AEAD // Шифрование секретов в хранилище, nil - второй фактор недоступен
+	Skew    uint64      // Допуск шагов времени на расхождение часов
+}
+
+type MFASvc struct {
+	logger  *zap.Logger
+	storage Storage
+	userSvc UserSvc
+	issuer  string
+	cipher  cipher.AEAD
+	skew    uint64
+}
+
+func New(opts *MFASvcOpts) *MFASvc {
+	skew := opts.Skew
+
+	if skew == 0 {
+		skew = defaultSkew
+	}
+
+	return &MFASvc{
+		logger:  opts.Logger,
+		storage: opts.Storage,
+		userSvc: opts.UserSvc,
+		issuer:  opts.Issuer,
+		cipher:  opts.Cipher,
+		skew:    skew,
+	}
+}
+
+// Подключен и подтвержден ли второй фактор пользователя
+func (s *MFASvc) Enabled(ctx context.Context, login string) (bool, error) {
+	const op = "MFASvc.Enabled"
+
+	_, mfa, err := s.userMFA(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserMFANotFound):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get user mfa | %s:%w", op, err)
+	}
+
+	return mfa.Confirmed, nil
+}
+
+// Выпуск нового секрета. До подтверждения первым кодом второй фактор не действует
+func (s *MFASvc) Enroll(ctx context.Context, login string) (*Enrollment, error) {
+	const op = "MFASvc.Enroll"
+
+	if s.cipher == nil {
+		return nil, fmt.Errorf("failed to enroll mfa | %s:%w", op, ErrMFANotConfigured)
+	}
+
+	u, mfa, err := s.userMFA(ctx, login)
+	if err != nil && !errors.Is(err, dberrors.ErrUserMFANotFound) {
+		return nil, fmt.Errorf("failed to get user mfa | %s:%w", op, err)
+	}
+
+	if mfa != nil && mfa.Confirmed {
+		return nil, fmt.Errorf("failed to enroll mfa | %s:%w", op, ErrMFAAlreadyEnabled)
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret | %s:%w", op, err)
+	}
+
+	sealed, err := s.seal(u.ID, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seal secret | %s:%w", op, err)
+	}
+
+	if err = s.storage.SaveUserMFA(ctx, models.UserMFA{
+		UserID:        u.ID,
+		Secret:        sealed,
+		Confirmed:     false,
+		LastStep:      0,
+		RecoveryCodes: nil,
+	}); err != nil {
+		s.logger.Error("failed to save user mfa",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to save user mfa | %s:%w", op, err)
+	}
+
+	return &Enrollment{
+		Secret: totp.EncodeSecret(secret),
+		URI:    totp.URI(s.issuer, u.Login, secret),
+	}, nil
+}
+
+// Подтверждение устройства первым кодом. Возвращает одноразовые коды восстановления,
+// которые показываются пользователю один раз и хранятся только в виде хешей
+func (s *MFASvc) Confirm(ctx context.Context, login, code string) ([]string, error) {
+	const op = "MFASvc.Confirm"
+
+	u, mfa, err := s.userMFA(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserMFANotFound):
+		return nil, fmt.Errorf("failed to confirm mfa | %s:%w", op, ErrMFANotEnrolled)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user mfa | %s:%w", op, err)
+	case mfa.Confirmed:
+		return nil, fmt.Errorf("failed to confirm mfa | %s:%w", op, ErrMFAAlreadyEnabled)
+	}
+
+	step, err := s.validate(u.ID, mfa, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm mfa | %s:%w", op, err)
+	}
+
+	recoveryCodes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery codes | %s:%w", op, err)
+	}
+
+	mfa.Confirmed = true
+	mfa.LastStep = step
+	mfa.RecoveryCodes = hashes
+
+	if err = s.storage.SaveUserMFA(ctx, *mfa); err != nil {
+		s.logger.Error("failed to save user mfa",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to save user mfa | %s:%w", op, err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// Проверка кода TOTP или одноразового кода восстановления при входе
+func (s *MFASvc) Verify(ctx context.Context, login, code string) error {
+	const op = "MFASvc.Verify"
+
+	u, mfa, err := s.userMFA(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserMFANotFound):
+		return fmt.Errorf("failed to verify mfa | %s:%w", op, ErrMFANotEnrolled)
+	case err != nil:
+		return fmt.Errorf("failed to get user mfa | %s:%w", op, err)
+	case !mfa.Confirmed:
+		return fmt.Errorf("failed to verify mfa | %s:%w", op, ErrMFANotEnrolled)
+	}
+
+	if len(strings.TrimSpace(code)) == totp.Digits {
+		step, err := s.validate(u.ID, mfa, code)
+		if err != nil {
+			return fmt.Errorf("failed to verify mfa | %s:%w", op, err)
+		}
+
+		// Код уже принятого шага времени повторно не принимается.
+		// Шаг сравнивается и сохраняется атомарно, чтобы параллельные запросы не приняли один код дважды
+		used, err := s.storage.UseUserMFAStep(ctx, u.ID, step)
+		if err != nil {
+			s.logger.Error("failed to use mfa step",
+				zap.String("login", login),
+				zap.Error(err),
+			)
+
+			return fmt.Errorf("failed to use mfa step | %s:%w", op, err)
+		}
+
+		if !used {
+			return fmt.Errorf("failed to verify mfa | %s:%w", op, ErrMFACodeReused)
+		}
+
+		return nil
+	}
+
+	idx := findRecoveryCode(mfa.RecoveryCodes, code)
+	if idx < 0 {
+		return fmt.Errorf("failed to verify mfa | %s:%w", op, ErrMFACodeInvalid)
+	}
+
+	// Код удаляется, только если он еще не использован параллельным запросом
+	used, err := s.storage.UseUserMFARecoveryCode(ctx, u.ID, mfa.RecoveryCodes[idx])
+	if err != nil {
+		s.logger.Error("failed to use recovery code",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to use recovery code | %s:%w", op, err)
+	}
+
+	if !used {
+		return fmt.Errorf("failed to verify mfa | %s:%w", op, ErrMFACodeInvalid)
+	}
+
+	s.logger.Info("recovery code has been used",
+		zap.String("login", login),
+		zap.Int("remaining", len(mfa.RecoveryCodes)-1),
+	)
+
+	return nil
+}
+
+func (s *MFASvc) Disable(ctx context.Context, login string) error {
+	const op = "MFASvc.Disable"
+
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if err = s.storage.DeleteUserMFA(ctx, u.ID); err != nil {
+		s.logger.Error("failed to delete user mfa",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to delete user mfa | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *MFASvc) userMFA(ctx context.Context, login string) (*usersvc.User, *models.UserMFA, error) {
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	mfa, err := s.storage.GetUserMFA(ctx, u.ID)
+	if err != nil {
+		return u, nil, err //nolint:wrapcheck
+	}
+
+	return u, mfa, nil
+}
+
+func (s *MFASvc) validate(userID uint64, mfa *models.UserMFA, code string) (uint64, error) {
+	secret, err := s.open(userID, mfa.Secret)
+	if err != nil {
+		return 0, err
+	}
+
+	step, err := totp.Validate(secret, code, time.Now(), s.skew)
+	if err != nil {
+		return 0, ErrMFACodeInvalid
+	}
+
+	return step, nil
+}
+
+// Шифрование секрета с привязкой к пользователю: nonce || ciphertext в base64
+func (s *MFASvc) seal(userID uint64, secret []byte) (string, error) {
+	nonce := make([]byte, s.cipher.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	sealed := s.cipher.Seal(nonce, nonce, secret, additionalData(userID))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *MFASvc) open(userID uint64, value string) ([]byte, error) {
+	if s.cipher == nil {
+		return nil, ErrMFANotConfigured
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(sealed) < s.cipher.NonceSize() {
+		return nil, ErrMFASecretInvalid
+	}
+
+	nonceSize := s.cipher.NonceSize()
+
+	secret, err := s.cipher.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData(userID))
+	if err != nil {
+		return nil, ErrMFASecretInvalid
+	}
+
+	return secret, nil
+}
+
+func additionalData(userID uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, userID)
+}
+
+// Коды восстановления в виде xxxx-xxxx и их хеши для хранения
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodesNum)
+	hashes := make([]string, 0, recoveryCodesNum)
+
+	for range recoveryCodesNum {
+		raw := make([]byte, recoveryCodeBytes)
+
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+
+		code := strings.ToLower(recoveryEncoding.EncodeToString(raw))
+		half := len(code) / 2 //nolint:mnd
+
+		codes = append(codes, code[:half]+"-"+code[half:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// Коды восстановления случайны и длинны, поэтому для хранения достаточно SHA-256
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func findRecoveryCode(hashes []string, code string) int {
+	hash := []byte(hashRecoveryCode(code))
+	found := -1
+
+	// Сравниваются все хеши, чтобы время проверки не зависело от позиции кода
+	for idx, value := range hashes {
+		if subtle.ConstantTimeCompare([]byte(value), hash) == 1 {
+			found = idx
+		}
+	}
+
+	return found
+}
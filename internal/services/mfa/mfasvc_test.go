@@ -0,0 +1,271 @@
+package mfasvc_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base32"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+	mfasvc "github.com/vtievsky/auth-id/internal/services/mfa"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"github.com/vtievsky/auth-id/pkg/totp"
+	"go.uber.org/zap"
+)
+
+type storage struct {
+	mu  sync.Mutex
+	mfa map[uint64]models.UserMFA
+}
+
+func (s *storage) GetUserMFA(_ context.Context, userID uint64) (*models.UserMFA, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mfa, ok := s.mfa[userID]
+	if !ok {
+		return nil, dberrors.ErrUserMFANotFound
+	}
+
+	mfa.RecoveryCodes = slices.Clone(mfa.RecoveryCodes)
+
+	return &mfa, nil
+}
+
+func (s *storage) SaveUserMFA(_ context.Context, mfa models.UserMFA) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mfa[mfa.UserID] = mfa
+
+	return nil
+}
+
+func (s *storage) UseUserMFAStep(_ context.Context, userID, step uint64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mfa := s.mfa[userID]
+	if step <= mfa.LastStep {
+		return false, nil
+	}
+
+	mfa.LastStep = step
+	s.mfa[userID] = mfa
+
+	return true, nil
+}
+
+func (s *storage) UseUserMFARecoveryCode(_ context.Context, userID uint64, hash string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mfa := s.mfa[userID]
+
+	idx := slices.Index(mfa.RecoveryCodes, hash)
+	if idx < 0 {
+		return false, nil
+	}
+
+	mfa.RecoveryCodes = slices.Delete(mfa.RecoveryCodes, idx, idx+1)
+	s.mfa[userID] = mfa
+
+	return true, nil
+}
+
+func (s *storage) DeleteUserMFA(_ context.Context, userID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.mfa, userID)
+
+	return nil
+}
+
+type users struct {
+	users map[string]*usersvc.User
+}
+
+func (u *users) GetUser(_ context.Context, login string) (*usersvc.User, error) {
+	user, ok := u.users[login]
+	if !ok {
+		return nil, dberrors.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func newMFASvc(t *testing.T) (*mfasvc.MFASvc, *storage) {
+	t.Helper()
+
+	block, err := aes.NewCipher([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("failed to create aead: %v", err)
+	}
+
+	st := &storage{
+		mu:  sync.Mutex{},
+		mfa: map[uint64]models.UserMFA{},
+	}
+
+	svc := mfasvc.New(&mfasvc.MFASvcOpts{
+		Logger:  zap.NewNop(),
+		Storage: st,
+		UserSvc: &users{users: map[string]*usersvc.User{
+			"ivan": {ID: 1, Name: "", Login: "ivan", Password: "", Blocked: false, Email: ""},
+			"petr": {ID: 2, Name: "", Login: "petr", Password: "", Blocked: false, Email: ""},
+		}},
+		Issuer: "auth-id",
+		Cipher: aead,
+		Skew:   1,
+	})
+
+	return svc, st
+}
+
+// Подключение второго фактора: секрет из ответа проверяет коды,
+// которые сервис сверяет с расшифрованным секретом из хранилища
+func enroll(t *testing.T, svc *mfasvc.MFASvc, login string) ([]byte, uint64, []string) {
+	t.Helper()
+
+	enrollment, err := svc.Enroll(context.Background(), login)
+	if err != nil {
+		t.Fatalf("failed to enroll: %v", err)
+	}
+
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	if err != nil {
+		t.Fatalf("failed to decode secret: %v", err)
+	}
+
+	step := totp.Step(time.Now())
+
+	recoveryCodes, err := svc.Confirm(context.Background(), login, totp.Code(secret, step))
+	if err != nil {
+		t.Fatalf("failed to confirm: %v", err)
+	}
+
+	return secret, step, recoveryCodes
+}
+
+func TestEnrollSealsSecret(t *testing.T) {
+	svc, st := newMFASvc(t)
+	secret, _, _ := enroll(t, svc, "ivan")
+
+	stored := st.mfa[1].Secret
+
+	if strings.Contains(stored, totp.EncodeSecret(secret)) || strings.Contains(stored, string(secret)) {
+		t.Fatal("secret must not be stored in plain text")
+	}
+
+	if enabled, err := svc.Enabled(context.Background(), "ivan"); err != nil || !enabled {
+		t.Fatalf("expected mfa to be enabled, got %v %v", enabled, err)
+	}
+}
+
+// Код шага, уже принятого при подтверждении или входе, повторно не принимается
+func TestVerifyRejectsReplay(t *testing.T) {
+	svc, st := newMFASvc(t)
+	secret, confirmed, _ := enroll(t, svc, "ivan")
+
+	err := svc.Verify(context.Background(), "ivan", totp.Code(secret, confirmed))
+	if !errors.Is(err, mfasvc.ErrMFACodeReused) {
+		t.Fatalf("expected ErrMFACodeReused, got %v", err)
+	}
+
+	// Следующий шаг в пределах допуска принимается один раз
+	next := totp.Code(secret, confirmed+1)
+
+	if err = svc.Verify(context.Background(), "ivan", next); err != nil {
+		t.Fatalf("failed to verify next step: %v", err)
+	}
+
+	if st.mfa[1].LastStep != confirmed+1 {
+		t.Fatalf("expected last step %d, got %d", confirmed+1, st.mfa[1].LastStep)
+	}
+
+	if err = svc.Verify(context.Background(), "ivan", next); !errors.Is(err, mfasvc.ErrMFACodeReused) {
+		t.Fatalf("expected ErrMFACodeReused, got %v", err)
+	}
+
+	if err = svc.Verify(context.Background(), "ivan", totp.Code(secret, confirmed+3)); !errors.Is(err, mfasvc.ErrMFACodeInvalid) {
+		t.Fatalf("expected ErrMFACodeInvalid beyond skew, got %v", err)
+	}
+}
+
+func TestVerifyRecoveryCode(t *testing.T) {
+	svc, st := newMFASvc(t)
+	_, _, recoveryCodes := enroll(t, svc, "ivan")
+
+	if len(recoveryCodes) != 10 {
+		t.Fatalf("expected 10 recovery codes, got %d", len(recoveryCodes))
+	}
+
+	for _, code := range recoveryCodes {
+		if slices.Contains(st.mfa[1].RecoveryCodes, code) {
+			t.Fatal("recovery codes must be stored as hashes")
+		}
+	}
+
+	// Регистр и разделитель при вводе не важны
+	entered := strings.ToUpper(strings.ReplaceAll(recoveryCodes[0], "-", ""))
+
+	if err := svc.Verify(context.Background(), "ivan", entered); err != nil {
+		t.Fatalf("failed to verify recovery code: %v", err)
+	}
+
+	if err := svc.Verify(context.Background(), "ivan", recoveryCodes[0]); !errors.Is(err, mfasvc.ErrMFACodeInvalid) {
+		t.Fatalf("expected used recovery code to be rejected, got %v", err)
+	}
+
+	if err := svc.Verify(context.Background(), "ivan", recoveryCodes[1]); err != nil {
+		t.Fatalf("failed to verify another recovery code: %v", err)
+	}
+
+	if remaining := len(st.mfa[1].RecoveryCodes); remaining != 8 {
+		t.Fatalf("expected 8 recovery codes left, got %d", remaining)
+	}
+}
+
+// Секрет привязан к пользователю: перенос записи другому пользователю не проходит проверку
+func TestSecretBoundToUser(t *testing.T) {
+	svc, st := newMFASvc(t)
+	secret, _, _ := enroll(t, svc, "ivan")
+
+	mfa := st.mfa[1]
+	mfa.UserID = 2
+	mfa.LastStep = 0
+	st.mfa[2] = mfa
+
+	err := svc.Verify(context.Background(), "petr", totp.Code(secret, totp.Step(time.Now())))
+	if !errors.Is(err, mfasvc.ErrMFASecretInvalid) {
+		t.Fatalf("expected ErrMFASecretInvalid, got %v", err)
+	}
+}
+
+func TestEnrollWithoutCipher(t *testing.T) {
+	svc := mfasvc.New(&mfasvc.MFASvcOpts{
+		Logger:  zap.NewNop(),
+		Storage: &storage{mu: sync.Mutex{}, mfa: map[uint64]models.UserMFA{}},
+		UserSvc: &users{users: map[string]*usersvc.User{}},
+		Issuer:  "auth-id",
+		Cipher:  nil,
+		Skew:    0,
+	})
+
+	if _, err := svc.Enroll(context.Background(), "ivan"); !errors.Is(err, mfasvc.ErrMFANotConfigured) {
+		t.Fatalf("expected ErrMFANotConfigured, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+package passresetsvc
+
+import "errors"
+
+var (
+	ErrResetTokenInvalid = errors.New("password reset token invalid or expired")
+	ErrUserBlocked       = errors.New("user blocked")
+)
@@ -0,0 +1,259 @@
+package passresetsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	repopassresets "github.com/vtievsky/auth-id/internal/repositories/sessions/passresets"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"github.com/vtievsky/auth-id/pkg/notifier"
+	"go.uber.org/zap"
+)
+
+const (
+	tokenBytes = 32
+	subject    = "Сброс пароля"
+)
+
+type Storage interface {
+	Store(ctx context.Context, login, tokenHash string, ttl time.Duration) error
+	Get(ctx context.Context, login string) (string, error)
+	Delete(ctx context.Context, login, tokenHash string) (bool, error)
+}
+
+type UserSvc interface {
+	GetUser(ctx context.Context, login string) (*usersvc.User, error)
+	CheckPassword(ctx context.Context, login, password string) error
+	ResetPass(ctx context.Context, login, changed, keepSessionID string) error
+}
+
+type PassResetSvcOpts struct {
+	Logger   *zap.Logger
+	Storage  Storage
+	Notifier notifier.Notifier
+	UserSvc  UserSvc
+	TTL      time.Duration // Срок действия токена
+	URL      string        // Страница сброса пароля, логин и токен добавляются параметрами. Пусто - в письме только токен
+}
+
+type PassResetSvc struct {
+	logger   *zap.Logger
+	storage  Storage
+	notifier notifier.Notifier
+	userSvc  UserSvc
+	ttl      time.Duration
+	url      string
+}
+
+func New(opts *PassResetSvcOpts) *PassResetSvc {
+	return &PassResetSvc{
+		logger:   opts.Logger,
+		storage:  opts.Storage,
+		notifier: opts.Notifier,
+		userSvc:  opts.UserSvc,
+		ttl:      opts.TTL,
+		url:      opts.URL,
+	}
+}
+
+// Выпуск одноразового токена и отправка его на адрес пользователя.
+// Отсутствие пользователя или адреса не считается ошибкой, чтобы не раскрывать существование логина
+func (s *PassResetSvc) Request(ctx context.Context, login string) error {
+	const op = "PassResetSvc.Request"
+
+	u, err := s.userSvc.GetUser(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserNotFound):
+		s.logger.Warn("password reset requested for unknown user",
+			zap.String("login", login),
+		)
+
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	case u.Blocked || u.Email == "":
+		s.logger.Warn("password reset requested for user without email or blocked",
+			zap.String("login", login),
+			zap.Bool("blocked", u.Blocked),
+		)
+
+		return nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate token | %s:%w", op, err)
+	}
+
+	// Новый токен заменяет выпущенный ранее
+	if err = s.storage.Store(ctx, u.Login, hashToken(token), s.ttl); err != nil {
+		s.logger.Error("failed to store password reset",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to store password reset | %s:%w", op, err)
+	}
+
+	// Письмо отправляется в фоне, чтобы время ответа не зависело от существования пользователя
+	go s.notify(context.WithoutCancel(ctx), u, token)
+
+	return nil
+}
+
+// Установка нового пароля по токену из письма. Пароль проверяется правилами до использования
+// токена, затем токен удаляется, и только удаливший его запрос меняет пароль
+// и отзывает все сессии пользователя
+func (s *PassResetSvc) Complete(ctx context.Context, login, token, password string) error {
+	const op = "PassResetSvc.Complete"
+
+	// Отсутствие пользователя не отличается от неверного токена
+	u, err := s.userSvc.GetUser(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserNotFound):
+		return fmt.Errorf("failed to get user | %s:%w", op, ErrResetTokenInvalid)
+	case err != nil:
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	tokenHash := hashToken(token)
+
+	stored, err := s.storage.Get(ctx, u.Login)
+
+	switch {
+	case errors.Is(err, repopassresets.ErrPassResetNotFound):
+		return fmt.Errorf("failed to get password reset | %s:%w", op, ErrResetTokenInvalid)
+	case err != nil:
+		s.logger.Error("failed to get password reset",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to get password reset | %s:%w", op, err)
+	case subtle.ConstantTimeCompare([]byte(stored), []byte(tokenHash)) != 1:
+		s.logger.Warn("invalid password reset token",
+			zap.String("login", u.Login),
+		)
+
+		return fmt.Errorf("failed to check password reset | %s:%w", op, ErrResetTokenInvalid)
+	}
+
+	if u.Blocked {
+		return fmt.Errorf("failed to reset password | %s:%w", op, ErrUserBlocked)
+	}
+
+	// Токен не используется при нарушении правил, чтобы пользователь мог выбрать другой пароль
+	if err = s.userSvc.CheckPassword(ctx, u.Login, password); err != nil {
+		return fmt.Errorf("failed to check password | %s:%w", op, err)
+	}
+
+	// Токен одноразовый: при параллельном использовании пароль сменит только один запрос
+	deleted, err := s.storage.Delete(ctx, u.Login, tokenHash)
+	if err == nil && !deleted {
+		err = ErrResetTokenInvalid
+	}
+
+	if err != nil {
+		s.logger.Error("failed to delete password reset",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to delete password reset | %s:%w", op, err)
+	}
+
+	// Сессии пользователя отзываются сервисом пользователей вместе со сменой пароля
+	if err = s.userSvc.ResetPass(ctx, u.Login, password, ""); err != nil {
+		s.logger.Error("failed to reset password",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to reset password | %s:%w", op, err)
+	}
+
+	s.logger.Info("password has been reset by token",
+		zap.String("login", u.Login),
+	)
+
+	return nil
+}
+
+func (s *PassResetSvc) notify(ctx context.Context, u *usersvc.User, token string) {
+	body, err := s.body(u, token)
+	if err == nil {
+		err = s.notifier.Notify(ctx, notifier.Message{
+			To:      u.Email,
+			Subject: subject,
+			Body:    body,
+		})
+	}
+
+	if err != nil {
+		s.logger.Error("failed to send password reset",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	s.logger.Info("password reset has been sent",
+		zap.String("login", u.Login),
+	)
+}
+
+func (s *PassResetSvc) body(u *usersvc.User, token string) (string, error) {
+	instruction := "Токен для сброса пароля: " + token
+
+	if s.url != "" {
+		link, err := url.Parse(s.url)
+		if err != nil {
+			return "", err //nolint:wrapcheck
+		}
+
+		query := link.Query()
+		query.Set("login", u.Login)
+		query.Set("token", token)
+		link.RawQuery = query.Encode()
+
+		instruction = "Для сброса пароля перейдите по ссылке:\n" + link.String()
+	}
+
+	return fmt.Sprintf(
+		"Здравствуйте, %s!\n\n%s\n\nСрок действия - %d мин., использовать можно один раз.\n"+
+			"Если вы не запрашивали сброс пароля, проигнорируйте это письмо.\n",
+		u.Name,
+		instruction,
+		int(s.ttl.Minutes()),
+	), nil
+}
+
+// Токен передается только в письме, в хранилище остается его хеш
+func newToken() (string, error) {
+	raw := make([]byte, tokenBytes)
+
+	if _, err := rand.Read(raw); err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Токены случайны и длинны, поэтому для хранения достаточно SHA-256
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,310 @@
+package passresetsvc_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	repopassresets "github.com/vtievsky/auth-id/internal/repositories/sessions/passresets"
+	passresetsvc "github.com/vtievsky/auth-id/internal/services/pass-resets"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"github.com/vtievsky/auth-id/pkg/notifier"
+	"github.com/vtievsky/auth-id/pkg/passpolicy"
+	"go.uber.org/zap"
+)
+
+const tokenPrefix = "Токен для сброса пароля: "
+
+var errWeakPassword = &usersvc.PolicyError{ //nolint:gochecknoglobals
+	Violations: []passpolicy.Violation{{Code: passpolicy.CodeReused, Message: "reused"}},
+}
+
+type storage struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func (s *storage) Store(_ context.Context, login, tokenHash string, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[login] = tokenHash
+
+	return nil
+}
+
+func (s *storage) Get(_ context.Context, login string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokenHash, ok := s.tokens[login]
+	if !ok {
+		return "", repopassresets.ErrPassResetNotFound
+	}
+
+	return tokenHash, nil
+}
+
+func (s *storage) Delete(_ context.Context, login, tokenHash string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tokens[login] != tokenHash {
+		return false, nil
+	}
+
+	delete(s.tokens, login)
+
+	return true, nil
+}
+
+type users struct {
+	mu     sync.Mutex
+	users  map[string]*usersvc.User
+	resets []string
+	kept   []string // Сессии, сохраненные при сбросе
+	weak   string   // Пароль, отклоняемый правилами
+}
+
+func (s *users) GetUser(_ context.Context, login string) (*usersvc.User, error) {
+	u, ok := s.users[login]
+	if !ok {
+		return nil, dberrors.ErrUserNotFound
+	}
+
+	return u, nil
+}
+
+func (s *users) CheckPassword(_ context.Context, _, password string) error {
+	if password == s.weak {
+		return errWeakPassword
+	}
+
+	return nil
+}
+
+func (s *users) ResetPass(_ context.Context, login, changed, keepSessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resets = append(s.resets, login+":"+changed)
+
+	if keepSessionID != "" {
+		s.kept = append(s.kept, keepSessionID)
+	}
+
+	return nil
+}
+
+type mailbox struct {
+	messages chan notifier.Message
+}
+
+func (s *mailbox) Notify(_ context.Context, msg notifier.Message) error {
+	s.messages <- msg
+
+	return nil
+}
+
+type fixture struct {
+	svc     *passresetsvc.PassResetSvc
+	storage *storage
+	users   *users
+	mailbox *mailbox
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		storage: &storage{tokens: map[string]string{}},
+		users: &users{
+			users: map[string]*usersvc.User{
+				"ivan":    {Login: "ivan", Name: "Иван", Email: "ivan@example.com"},
+				"blocked": {Login: "blocked", Name: "Петр", Email: "petr@example.com", Blocked: true},
+			},
+			weak: "weak",
+		},
+		mailbox: &mailbox{messages: make(chan notifier.Message, 1)},
+	}
+
+	f.svc = passresetsvc.New(&passresetsvc.PassResetSvcOpts{
+		Logger:   zap.NewNop(),
+		Storage:  f.storage,
+		Notifier: f.mailbox,
+		UserSvc:  f.users,
+		TTL:      time.Hour,
+		URL:      "",
+	})
+
+	return f
+}
+
+// Запрос сброса и получение токена из письма
+func (f *fixture) request(t *testing.T, login string) string {
+	t.Helper()
+
+	if err := f.svc.Request(context.Background(), login); err != nil {
+		t.Fatalf("failed to request password reset: %v", err)
+	}
+
+	select {
+	case msg := <-f.mailbox.messages:
+		for _, line := range strings.Split(msg.Body, "\n") {
+			if token, ok := strings.CutPrefix(line, tokenPrefix); ok {
+				return token
+			}
+		}
+
+		t.Fatalf("token not found in message %q", msg.Body)
+	case <-time.After(time.Second):
+		t.Fatal("password reset has not been sent")
+	}
+
+	return ""
+}
+
+func TestRequestStoresTokenHash(t *testing.T) {
+	f := newFixture()
+
+	token := f.request(t, "ivan")
+
+	stored, err := f.storage.Get(context.Background(), "ivan")
+	if err != nil {
+		t.Fatalf("token not stored: %v", err)
+	}
+
+	if stored == token || strings.Contains(stored, token) {
+		t.Error("token stored in plain text")
+	}
+}
+
+func TestRequestUnknownUser(t *testing.T) {
+	f := newFixture()
+
+	if err := f.svc.Request(context.Background(), "unknown"); err != nil {
+		t.Fatalf("unknown login must not be revealed: %v", err)
+	}
+
+	select {
+	case msg := <-f.mailbox.messages:
+		t.Fatalf("unexpected message to %s", msg.To)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestComplete(t *testing.T) {
+	f := newFixture()
+
+	token := f.request(t, "ivan")
+
+	if err := f.svc.Complete(context.Background(), "ivan", token, "changed"); err != nil {
+		t.Fatalf("failed to complete password reset: %v", err)
+	}
+
+	if len(f.users.resets) != 1 || f.users.resets[0] != "ivan:changed" {
+		t.Errorf("unexpected password resets %v", f.users.resets)
+	}
+
+	// Сброс отзывает все сессии пользователя
+	if len(f.users.kept) != 0 {
+		t.Errorf("unexpected kept sessions %v", f.users.kept)
+	}
+
+	// Повторное использование токена
+	err := f.svc.Complete(context.Background(), "ivan", token, "another")
+	if !errors.Is(err, passresetsvc.ErrResetTokenInvalid) {
+		t.Fatalf("expected ErrResetTokenInvalid on replay, got %v", err)
+	}
+
+	if len(f.users.resets) != 1 {
+		t.Errorf("password reset twice: %v", f.users.resets)
+	}
+}
+
+func TestCompletePolicyViolationKeepsToken(t *testing.T) {
+	f := newFixture()
+
+	token := f.request(t, "ivan")
+
+	err := f.svc.Complete(context.Background(), "ivan", token, "weak")
+
+	var policyErr *usersvc.PolicyError
+
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("expected policy error, got %v", err)
+	}
+
+	if len(f.users.resets) != 0 {
+		t.Errorf("password reset despite policy violation: %v", f.users.resets)
+	}
+
+	if err = f.svc.Complete(context.Background(), "ivan", token, "changed"); err != nil {
+		t.Fatalf("token must stay valid after policy violation: %v", err)
+	}
+}
+
+func TestCompleteInvalidToken(t *testing.T) {
+	f := newFixture()
+
+	f.request(t, "ivan")
+
+	tests := []struct {
+		name  string
+		login string
+		token string
+	}{
+		{name: "wrong token", login: "ivan", token: "wrong"},
+		{name: "unknown user", login: "unknown", token: "wrong"},
+		{name: "no token issued", login: "blocked", token: "wrong"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.svc.Complete(context.Background(), tt.login, tt.token, "changed")
+			if !errors.Is(err, passresetsvc.ErrResetTokenInvalid) {
+				t.Fatalf("expected ErrResetTokenInvalid, got %v", err)
+			}
+		})
+	}
+
+	if len(f.users.resets) != 0 {
+		t.Errorf("unexpected password resets %v", f.users.resets)
+	}
+}
+
+func TestCompleteConcurrentSingleUse(t *testing.T) {
+	f := newFixture()
+
+	token := f.request(t, "ivan")
+
+	const attempts = 8
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+
+	for range attempts {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := f.svc.Complete(context.Background(), "ivan", token, "changed"); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if succeeded != 1 || len(f.users.resets) != 1 {
+		t.Fatalf("token used %d times, resets %v", succeeded, f.users.resets)
+	}
+}
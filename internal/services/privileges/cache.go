@@ -2,8 +2,8 @@ package privilegesvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 
 	dberrors "github.com/vtievsky/auth-id/internal/repositories"
 	"github.com/vtievsky/auth-id/internal/repositories/models"
@@ -13,7 +13,7 @@ import (
 func (s *PrivilegeSvc) GetPrivilegeByID(ctx context.Context, id uint64) (*Privilege, error) {
 	const op = "PrivilegeSvc.GetPrivilegeByID"
 
-	val, err := s.cacheByID.Get(ctx, id, s.syncPrivilegesByID)
+	val, err := s.cacheByID.Get(ctx, id, s.loadPrivilegeByID)
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrPrivilegeNotFound, err)
 	}
@@ -29,7 +29,7 @@ func (s *PrivilegeSvc) GetPrivilegeByID(ctx context.Context, id uint64) (*Privil
 func (s *PrivilegeSvc) GetPrivilegeByCode(ctx context.Context, code string) (*Privilege, error) {
 	const op = "PrivilegeSvc.GetPrivilegeByCode"
 
-	val, err := s.cacheByCode.Get(ctx, code, s.syncPrivilegesByCode)
+	val, err := s.cacheByCode.Get(ctx, code, s.loadPrivilegeByCode)
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrPrivilegeNotFound, err)
 	}
@@ -42,52 +42,48 @@ func (s *PrivilegeSvc) GetPrivilegeByCode(ctx context.Context, code string) (*Pr
 	}, nil
 }
 
-func (s *PrivilegeSvc) syncPrivilegesByID(ctx context.Context) (map[uint64]*models.Privilege, error) {
-	const op = "PrivilegeSvc.syncPrivilegesByID"
+// Загрузка одной привилегии по идентификатору, отсутствие привилегии кешируется
+func (s *PrivilegeSvc) loadPrivilegeByID(ctx context.Context, id uint64) (map[uint64]*models.Privilege, error) {
+	const op = "PrivilegeSvc.loadPrivilegeByID"
 
-	privileges, err := s.storage.GetPrivileges(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync privileges",
+	privilege, err := s.storage.GetPrivilegeByID(ctx, id)
+
+	switch {
+	case errors.Is(err, dberrors.ErrPrivilegeNotFound):
+		return map[uint64]*models.Privilege{}, nil
+	case err != nil:
+		s.logger.Error("failed to load privilege",
+			zap.Uint64("privilege_id", id),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync privileges | %s:%w", op, err)
+		return nil, fmt.Errorf("failed to load privilege | %s:%w", op, err)
 	}
 
-	resp := make(map[uint64]*models.Privilege, len(privileges))
-
-	for _, privilege := range privileges {
-		resp[privilege.ID] = privilege
-	}
-
-	s.logger.Debug("privileges has been synchronized",
-		zap.Int("num", len(privileges)),
-	)
-
-	return resp, nil
+	return map[uint64]*models.Privilege{
+		privilege.ID: privilege,
+	}, nil
 }
 
-func (s *PrivilegeSvc) syncPrivilegesByCode(ctx context.Context) (map[string]*models.Privilege, error) {
-	const op = "PrivilegeSvc.syncPrivilegesByCode"
+// Загрузка одной привилегии по коду, отсутствие привилегии кешируется
+func (s *PrivilegeSvc) loadPrivilegeByCode(ctx context.Context, code string) (map[string]*models.Privilege, error) {
+	const op = "PrivilegeSvc.loadPrivilegeByCode"
 
-	privileges, err := s.storage.GetPrivileges(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync privileges",
+	privilege, err := s.storage.GetPrivilege(ctx, code)
+
+	switch {
+	case errors.Is(err, dberrors.ErrPrivilegeNotFound):
+		return map[string]*models.Privilege{}, nil
+	case err != nil:
+		s.logger.Error("failed to load privilege",
+			zap.String("privilege_code", code),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync privileges | %s:%w", op, err)
+		return nil, fmt.Errorf("failed to load privilege | %s:%w", op, err)
 	}
 
-	resp := make(map[string]*models.Privilege, len(privileges))
-
-	for _, privilege := range privileges {
-		resp[privilege.Code] = privilege
-	}
-
-	s.logger.Debug("privileges has been synchronized",
-		zap.Int("num", len(privileges)),
-	)
-
-	return resp, nil
+	return map[string]*models.Privilege{
+		privilege.Code: privilege,
+	}, nil
 }
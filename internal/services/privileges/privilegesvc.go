@@ -17,27 +17,36 @@ type Privilege struct {
 }
 
 type Storage interface {
+	GetPrivilege(ctx context.Context, code string) (*models.Privilege, error)
+	GetPrivilegeByID(ctx context.Context, id uint64) (*models.Privilege, error)
 	GetPrivileges(ctx context.Context, pageSize, offset uint32) ([]*models.Privilege, error)
 }
 
 type PrivilegeSvcOpts struct {
-	Logger  *zap.Logger
-	Storage Storage
+	Logger   *zap.Logger
+	Storage  Storage
+	CacheBus cache.Bus
 }
 
 type PrivilegeSvc struct {
 	logger      *zap.Logger
 	storage     Storage
-	cacheByID   cache.Cache[uint64, *models.Privilege]
-	cacheByCode cache.Cache[string, *models.Privilege]
+	cacheByID   *cache.Cache[uint64, *models.Privilege]
+	cacheByCode *cache.Cache[string, *models.Privilege]
 }
 
 func New(opts *PrivilegeSvcOpts) *PrivilegeSvc {
 	return &PrivilegeSvc{
-		logger:      opts.Logger,
-		storage:     opts.Storage,
-		cacheByID:   cache.New[uint64, *models.Privilege](),
-		cacheByCode: cache.New[string, *models.Privilege](),
+		logger:  opts.Logger,
+		storage: opts.Storage,
+		cacheByID: cache.New[uint64, *models.Privilege](&cache.Opts{
+			Name: "privileges:id",
+			Bus:  opts.CacheBus,
+		}),
+		cacheByCode: cache.New[string, *models.Privilege](&cache.Opts{
+			Name: "privileges:code",
+			Bus:  opts.CacheBus,
+		}),
 	}
 }
 
@@ -0,0 +1,191 @@
+package propagationsvc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	roleusersvc "github.com/vtievsky/auth-id/internal/services/role-users"
+	"go.uber.org/zap"
+)
+
+type RoleUserSvc interface {
+	GetRoleUsers(ctx context.Context, code string, pageSize, offset uint32) ([]*roleusersvc.RoleUser, error)
+}
+
+type SessionSvc interface {
+	SyncUserSessions(ctx context.Context, login string) error
+	RevokeUserSessions(ctx context.Context, login, keepSessionID string) error
+}
+
+type PropagationSvcOpts struct {
+	Logger      *zap.Logger
+	RoleUserSvc RoleUserSvc
+	SessionSvc  SessionSvc
+}
+
+// Распространение изменений ролевой модели и пользователей на действующие сессии
+type PropagationSvc struct {
+	logger      *zap.Logger
+	roleUserSvc RoleUserSvc
+	sessionSvc  SessionSvc
+}
+
+func New(opts *PropagationSvcOpts) *PropagationSvc {
+	return &PropagationSvc{
+		logger:      opts.Logger,
+		roleUserSvc: opts.RoleUserSvc,
+		sessionSvc:  opts.SessionSvc,
+	}
+}
+
+// Изменение роли целиком: состава ее привилегий или признака блокировки.
+// Затрагивает сессии всех пользователей роли
+func (s *PropagationSvc) RoleChanged(ctx context.Context, code string) error {
+	const op = "PropagationSvc.RoleChanged"
+
+	logins, err := s.RoleUsers(ctx, code)
+	if err != nil {
+		return fmt.Errorf("failed to get role users | %s:%w", op, err)
+	}
+
+	if err = s.sync(ctx, logins...); err != nil {
+		return fmt.Errorf("failed to sync role sessions | %s:%w", op, err)
+	}
+
+	s.logger.Debug("role change has been propagated",
+		zap.String("role_code", code),
+		zap.Int("num", len(logins)),
+	)
+
+	return nil
+}
+
+// Логины пользователей роли. Запрашиваются до удаления роли, после него состав недоступен
+func (s *PropagationSvc) RoleUsers(ctx context.Context, code string) ([]string, error) {
+	const op = "PropagationSvc.RoleUsers"
+
+	roleUsers, err := s.roleUserSvc.GetRoleUsers(ctx, code, math.MaxUint32, 0)
+	if err != nil {
+		s.logger.Error("failed to get role users",
+			zap.String("role_code", code),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get role users | %s:%w", op, err)
+	}
+
+	logins := make([]string, 0, len(roleUsers))
+
+	for _, roleUser := range roleUsers {
+		logins = append(logins, roleUser.Login)
+	}
+
+	return logins, nil
+}
+
+// Удаление роли. Затрагивает сессии пользователей, входивших в роль до удаления
+func (s *PropagationSvc) RoleDeleted(ctx context.Context, code string, logins []string) error {
+	const op = "PropagationSvc.RoleDeleted"
+
+	if err := s.sync(ctx, logins...); err != nil {
+		return fmt.Errorf("failed to sync role sessions | %s:%w", op, err)
+	}
+
+	s.logger.Debug("role deletion has been propagated",
+		zap.String("role_code", code),
+		zap.Int("num", len(logins)),
+	)
+
+	return nil
+}
+
+// Изменение назначения роли пользователю. Затрагивает сессии только этого пользователя
+func (s *PropagationSvc) RoleUserChanged(ctx context.Context, code, login string) error {
+	const op = "PropagationSvc.RoleUserChanged"
+
+	if err := s.sync(ctx, login); err != nil {
+		return fmt.Errorf("failed to sync user sessions | %s:%w", op, err)
+	}
+
+	s.logger.Debug("role user change has been propagated",
+		zap.String("role_code", code),
+		zap.String("login", login),
+	)
+
+	return nil
+}
+
+// Смена или сброс пароля пользователя. Сессии, открытые со старым паролем, отзываются,
+// кроме сессии keepSessionID, если она указана
+func (s *PropagationSvc) UserPasswordChanged(ctx context.Context, login, keepSessionID string) error {
+	const op = "PropagationSvc.UserPasswordChanged"
+
+	if err := s.revoke(ctx, login, keepSessionID); err != nil {
+		return fmt.Errorf("failed to revoke user sessions | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Блокировка пользователя. Отзываются все сессии
+func (s *PropagationSvc) UserBlocked(ctx context.Context, login string) error {
+	const op = "PropagationSvc.UserBlocked"
+
+	if err := s.revoke(ctx, login, ""); err != nil {
+		return fmt.Errorf("failed to revoke user sessions | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Удаление пользователя. Отзываются все сессии
+func (s *PropagationSvc) UserDeleted(ctx context.Context, login string) error {
+	const op = "PropagationSvc.UserDeleted"
+
+	if err := s.revoke(ctx, login, ""); err != nil {
+		return fmt.Errorf("failed to revoke user sessions | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (s *PropagationSvc) revoke(ctx context.Context, login, keepSessionID string) error {
+	if err := s.sessionSvc.RevokeUserSessions(ctx, login, keepSessionID); err != nil {
+		s.logger.Error("failed to revoke user sessions",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return err //nolint:wrapcheck
+	}
+
+	s.logger.Debug("user change has been propagated",
+		zap.String("login", login),
+	)
+
+	return nil
+}
+
+func (s *PropagationSvc) sync(ctx context.Context, logins ...string) error {
+	synced := make(map[string]struct{}, len(logins))
+
+	for _, login := range logins {
+		if _, ok := synced[login]; ok {
+			continue
+		}
+
+		if err := s.sessionSvc.SyncUserSessions(ctx, login); err != nil {
+			s.logger.Error("failed to sync user sessions",
+				zap.String("login", login),
+				zap.Error(err),
+			)
+
+			return err //nolint:wrapcheck
+		}
+
+		synced[login] = struct{}{}
+	}
+
+	return nil
+}
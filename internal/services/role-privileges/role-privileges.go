@@ -52,6 +52,11 @@ type PrivilegeSvc interface {
 	GetPrivilegeByCode(ctx context.Context, code string) (*privilegesvc.Privilege, error)
 }
 
+// Распространение изменений привилегий ролей на действующие сессии
+type Propagation interface {
+	RoleChanged(ctx context.Context, code string) error
+}
+
 type RolePrivilegeSvcOpts struct {
 	Logger       *zap.Logger
 	Storage      Storage
@@ -64,6 +69,7 @@ type RolePrivilegeSvc struct {
 	storage      Storage
 	roleSvc      RoleSvc
 	privilegeSvc PrivilegeSvc
+	propagation  Propagation
 }
 
 func New(opts *RolePrivilegeSvcOpts) *RolePrivilegeSvc {
@@ -75,6 +81,11 @@ func New(opts *RolePrivilegeSvcOpts) *RolePrivilegeSvc {
 	}
 }
 
+// Подключение распространения изменений. Выполняется при запуске до обработки запросов
+func (s *RolePrivilegeSvc) SetPropagation(propagation Propagation) {
+	s.propagation = propagation
+}
+
 func (s *RolePrivilegeSvc) GetRolePrivileges(ctx context.Context, code string, pageSize, offset uint32) ([]*RolePrivilege, error) {
 	const op = "RolePrivilegeSvc.GetRolePrivileges"
 
@@ -175,6 +186,13 @@ func (s *RolePrivilegeSvc) AddRolePrivilege(ctx context.Context, rolePrivilege R
 		return fmt.Errorf("failed to add role to privilege | %s:%w", op, err)
 	}
 
+	// Изменение состава привилегий роли меняет привилегии действующих сессий ее пользователей
+	if s.propagation != nil {
+		if err := s.propagation.RoleChanged(ctx, rolePrivilege.RoleCode); err != nil {
+			return fmt.Errorf("failed to propagate role change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
 
@@ -238,6 +256,13 @@ func (s *RolePrivilegeSvc) UpdateRolePrivilege(ctx context.Context, rolePrivileg
 		return fmt.Errorf("failed to update role to privilege | %s:%w", op, err)
 	}
 
+	// Изменение состава привилегий роли меняет привилегии действующих сессий ее пользователей
+	if s.propagation != nil {
+		if err := s.propagation.RoleChanged(ctx, rolePrivilege.RoleCode); err != nil {
+			return fmt.Errorf("failed to propagate role change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
 
@@ -300,5 +325,12 @@ func (s *RolePrivilegeSvc) DeleteRolePrivilege(ctx context.Context, rolePrivileg
 		return fmt.Errorf("failed to delete role to privilege | %s:%w", op, err)
 	}
 
+	// Изменение состава привилегий роли меняет привилегии действующих сессий ее пользователей
+	if s.propagation != nil {
+		if err := s.propagation.RoleChanged(ctx, rolePrivilege.RoleCode); err != nil {
+			return fmt.Errorf("failed to propagate role change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
@@ -55,6 +55,11 @@ type RoleSvc interface {
 	GetRoleByCode(ctx context.Context, code string) (*rolesvc.Role, error)
 }
 
+// Распространение изменений назначений ролей на действующие сессии
+type Propagation interface {
+	RoleUserChanged(ctx context.Context, code, login string) error
+}
+
 type RoleUserSvcOpts struct {
 	Logger  *zap.Logger
 	Storage Storage
@@ -63,10 +68,11 @@ type RoleUserSvcOpts struct {
 }
 
 type RoleUserSvc struct {
-	logger  *zap.Logger
-	storage Storage
-	roleSvc RoleSvc
-	userSvc UserSvc
+	logger      *zap.Logger
+	storage     Storage
+	roleSvc     RoleSvc
+	userSvc     UserSvc
+	propagation Propagation
 }
 
 func New(opts *RoleUserSvcOpts) *RoleUserSvc {
@@ -78,6 +84,11 @@ func New(opts *RoleUserSvcOpts) *RoleUserSvc {
 	}
 }
 
+// Подключение распространения изменений. Выполняется при запуске до обработки запросов
+func (s *RoleUserSvc) SetPropagation(propagation Propagation) {
+	s.propagation = propagation
+}
+
 func (s *RoleUserSvc) GetRoleUsers(ctx context.Context, code string, pageSize, offset uint32) ([]*RoleUser, error) {
 	const op = "RoleUserSvc.GetRoleUsers"
 
@@ -191,6 +202,13 @@ func (s *RoleUserSvc) AddRoleUser(ctx context.Context, roleUser RoleUserCreated)
 		return fmt.Errorf("failed to add role to user | %s:%w", op, err)
 	}
 
+	// Изменение назначения роли меняет привилегии действующих сессий пользователя
+	if s.propagation != nil {
+		if err := s.propagation.RoleUserChanged(ctx, roleUser.RoleCode, roleUser.Login); err != nil {
+			return fmt.Errorf("failed to propagate role user change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
 
@@ -267,6 +285,13 @@ func (s *RoleUserSvc) UpdateRoleUser(ctx context.Context, roleUser RoleUserUpdat
 		return fmt.Errorf("failed to update role to user | %s:%w", op, err)
 	}
 
+	// Изменение назначения роли меняет привилегии действующих сессий пользователя
+	if s.propagation != nil {
+		if err := s.propagation.RoleUserChanged(ctx, roleUser.RoleCode, roleUser.Login); err != nil {
+			return fmt.Errorf("failed to propagate role user change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
 
@@ -329,5 +354,12 @@ func (s *RoleUserSvc) DeleteRoleUser(ctx context.Context, roleUser RoleUserDelet
 		return fmt.Errorf("failed to delete role to user | %s:%w", op, err)
 	}
 
+	// Изменение назначения роли меняет привилегии действующих сессий пользователя
+	if s.propagation != nil {
+		if err := s.propagation.RoleUserChanged(ctx, roleUser.RoleCode, roleUser.Login); err != nil {
+			return fmt.Errorf("failed to propagate role user change | %s:%w", op, err)
+		}
+	}
+
 	return nil
 }
@@ -2,20 +2,25 @@ package rolesvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 
 	dberrors "github.com/vtievsky/auth-id/internal/repositories"
 	"github.com/vtievsky/auth-id/internal/repositories/models"
+	"github.com/vtievsky/auth-id/pkg/cache"
 	"go.uber.org/zap"
 )
 
 func (s *RoleSvc) GetRoleByID(ctx context.Context, id uint64) (*Role, error) {
 	const op = "RoleSvc.GetRoleByID"
 
-	val, err := s.cacheByID.Get(ctx, id, s.syncRolesByID)
+	val, err := s.cacheByID.Get(ctx, id, s.loadRoleByID)
 	if err != nil {
-		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrRoleNotFound, err)
+		if errors.Is(err, cache.ErrValueNotFound) {
+			return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrRoleNotFound, err)
+		}
+
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, err)
 	}
 
 	return &Role{
@@ -30,13 +35,18 @@ func (s *RoleSvc) GetRoleByID(ctx context.Context, id uint64) (*Role, error) {
 func (s *RoleSvc) GetRoleByCode(ctx context.Context, code string) (*Role, error) {
 	const op = "RoleSvc.GetRoleByCode"
 
-	val, err := s.cacheByCode.Get(ctx, code, s.syncRolesByCode)
+	val, err := s.cacheByCode.Get(ctx, code, s.loadRoleByCode)
 	if err != nil {
+		// Недоступность хранилища не выдается за отсутствие роли
+		if errors.Is(err, cache.ErrValueNotFound) {
+			return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrRoleNotFound, err)
+		}
+
 		s.logger.Error("failed to get role",
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrRoleNotFound, err)
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, err)
 	}
 
 	return &Role{
@@ -48,52 +58,48 @@ func (s *RoleSvc) GetRoleByCode(ctx context.Context, code string) (*Role, error)
 	}, nil
 }
 
-func (s *RoleSvc) syncRolesByID(ctx context.Context) (map[uint64]*models.Role, error) {
-	const op = "RoleSvc.syncRolesByID"
+// Загрузка одной роли по идентификатору, отсутствие роли кешируется
+func (s *RoleSvc) loadRoleByID(ctx context.Context, id uint64) (map[uint64]*models.Role, error) {
+	const op = "RoleSvc.loadRoleByID"
 
-	roles, err := s.storage.GetRoles(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync roles",
+	role, err := s.storage.GetRoleByID(ctx, id)
+
+	switch {
+	case errors.Is(err, dberrors.ErrRoleNotFound):
+		return map[uint64]*models.Role{}, nil
+	case err != nil:
+		s.logger.Error("failed to load role",
+			zap.Uint64("role_id", id),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync roles | %s:%w", op, err)
+		return nil, fmt.Errorf("failed to load role | %s:%w", op, err)
 	}
 
-	resp := make(map[uint64]*models.Role, len(roles))
-
-	for _, role := range roles {
-		resp[role.ID] = role
-	}
-
-	s.logger.Debug("roles has been synchronized",
-		zap.Int("num", len(roles)),
-	)
-
-	return resp, nil
+	return map[uint64]*models.Role{
+		role.ID: role,
+	}, nil
 }
 
-func (s *RoleSvc) syncRolesByCode(ctx context.Context) (map[string]*models.Role, error) {
-	const op = "RoleSvc.syncRolesByCode"
+// Загрузка одной роли по коду, отсутствие роли кешируется
+func (s *RoleSvc) loadRoleByCode(ctx context.Context, code string) (map[string]*models.Role, error) {
+	const op = "RoleSvc.loadRoleByCode"
 
-	roles, err := s.storage.GetRoles(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync roles",
+	role, err := s.storage.GetRole(ctx, code)
+
+	switch {
+	case errors.Is(err, dberrors.ErrRoleNotFound):
+		return map[string]*models.Role{}, nil
+	case err != nil:
+		s.logger.Error("failed to load role",
+			zap.String("role_code", code),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync roles | %s:%w", op, err)
-	}
-
-	resp := make(map[string]*models.Role, len(roles))
-
-	for _, role := range roles {
-		resp[role.Code] = role
+		return nil, fmt.Errorf("failed to load role | %s:%w", op, err)
 	}
 
-	s.logger.Debug("roles has been synchronized",
-		zap.Int("num", len(roles)),
-	)
-
-	return resp, nil
+	return map[string]*models.Role{
+		role.Code: role,
+	}, nil
 }
@@ -32,33 +32,54 @@ type RoleUpdated struct {
 
 type Storage interface {
 	GetRole(ctx context.Context, code string) (*models.Role, error)
+	GetRoleByID(ctx context.Context, id uint64) (*models.Role, error)
 	GetRoles(ctx context.Context, pageSize, offset uint32) ([]*models.Role, error)
 	CreateRole(ctx context.Context, user models.RoleCreated) (*models.Role, error)
 	UpdateRole(ctx context.Context, user models.RoleUpdated) (*models.Role, error)
 	DeleteRole(ctx context.Context, code string) error
 }
 
+// Распространение изменений ролей на действующие сессии
+type Propagation interface {
+	RoleChanged(ctx context.Context, code string) error
+	RoleUsers(ctx context.Context, code string) ([]string, error)
+	RoleDeleted(ctx context.Context, code string, logins []string) error
+}
+
 type RoleSvcOpts struct {
-	Logger  *zap.Logger
-	Storage Storage
+	Logger   *zap.Logger
+	Storage  Storage
+	CacheBus cache.Bus
 }
 
 type RoleSvc struct {
 	logger      *zap.Logger
 	storage     Storage
-	cacheByID   cache.Cache[uint64, *models.Role]
-	cacheByCode cache.Cache[string, *models.Role]
+	propagation Propagation
+	cacheByID   *cache.Cache[uint64, *models.Role]
+	cacheByCode *cache.Cache[string, *models.Role]
 }
 
 func New(opts *RoleSvcOpts) *RoleSvc {
 	return &RoleSvc{
-		logger:      opts.Logger,
-		storage:     opts.Storage,
-		cacheByID:   cache.New[uint64, *models.Role](),
-		cacheByCode: cache.New[string, *models.Role](),
+		logger:  opts.Logger,
+		storage: opts.Storage,
+		cacheByID: cache.New[uint64, *models.Role](&cache.Opts{
+			Name: "roles:id",
+			Bus:  opts.CacheBus,
+		}),
+		cacheByCode: cache.New[string, *models.Role](&cache.Opts{
+			Name: "roles:code",
+			Bus:  opts.CacheBus,
+		}),
 	}
 }
 
+// Подключение распространения изменений. Выполняется при запуске до обработки запросов
+func (s *RoleSvc) SetPropagation(propagation Propagation) {
+	s.propagation = propagation
+}
+
 func (s *RoleSvc) GetRole(ctx context.Context, code string) (*Role, error) {
 	return s.GetRoleByCode(ctx, code)
 }
@@ -107,8 +128,8 @@ func (s *RoleSvc) CreateRole(ctx context.Context, role RoleCreated) (*Role, erro
 		return nil, fmt.Errorf("failed to create role | %s:%w", op, err)
 	}
 
-	s.cacheByID.Add(u.ID, u)
-	s.cacheByCode.Add(u.Code, u)
+	s.cacheByID.Add(ctx, u.ID, u)
+	s.cacheByCode.Add(ctx, u.Code, u)
 
 	return &Role{
 		ID:          u.ID,
@@ -122,6 +143,11 @@ func (s *RoleSvc) CreateRole(ctx context.Context, role RoleCreated) (*Role, erro
 func (s *RoleSvc) UpdateRole(ctx context.Context, role RoleUpdated) (*Role, error) {
 	const op = "RoleSvc.UpdateRole"
 
+	current, err := s.GetRoleByCode(ctx, role.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, err)
+	}
+
 	u, err := s.storage.UpdateRole(ctx, models.RoleUpdated{
 		Code:        role.Code,
 		Name:        role.Name,
@@ -137,6 +163,18 @@ func (s *RoleSvc) UpdateRole(ctx context.Context, role RoleUpdated) (*Role, erro
 		return nil, fmt.Errorf("failed to update role | %s:%w", op, err)
 	}
 
+	// Удалим старые данные роли из кеша
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByCode.Del(ctx, u.Code)
+
+	// Блокировка или разблокировка роли меняет привилегии действующих сессий ее пользователей.
+	// Кеш уже очищен, поэтому привилегии пересчитываются по новому состоянию роли
+	if current.Blocked != u.Blocked && s.propagation != nil {
+		if err = s.propagation.RoleChanged(ctx, u.Code); err != nil {
+			return nil, fmt.Errorf("failed to propagate role change | %s:%w", op, err)
+		}
+	}
+
 	return &Role{
 		ID:          u.ID,
 		Code:        u.Code,
@@ -159,7 +197,16 @@ func (s *RoleSvc) DeleteRole(ctx context.Context, code string) error {
 		return fmt.Errorf("failed to get role | %s:%w", op, err)
 	}
 
-	if err := s.storage.DeleteRole(ctx, code); err != nil {
+	// Состав роли запоминается до удаления, чтобы обновить сессии ее пользователей
+	var logins []string
+
+	if s.propagation != nil {
+		if logins, err = s.propagation.RoleUsers(ctx, u.Code); err != nil {
+			return fmt.Errorf("failed to get role users | %s:%w", op, err)
+		}
+	}
+
+	if err = s.storage.DeleteRole(ctx, code); err != nil {
 		s.logger.Error("failed to delete role",
 			zap.String("role_code", code),
 			zap.Error(err),
@@ -169,8 +216,14 @@ func (s *RoleSvc) DeleteRole(ctx context.Context, code string) error {
 	}
 
 	// Удалим данные роли из кеша
-	s.cacheByID.Del(u.ID)
-	s.cacheByCode.Del(u.Code)
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByCode.Del(ctx, u.Code)
+
+	if s.propagation != nil {
+		if err = s.propagation.RoleDeleted(ctx, u.Code, logins); err != nil {
+			return fmt.Errorf("failed to propagate role deletion | %s:%w", op, err)
+		}
+	}
 
 	return nil
 }
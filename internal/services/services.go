@@ -3,14 +3,20 @@ package services
 import (
 	"context"
 
+	mfasvc "github.com/vtievsky/auth-id/internal/services/mfa"
 	privilegesvc "github.com/vtievsky/auth-id/internal/services/privileges"
 	roleprivilegesvc "github.com/vtievsky/auth-id/internal/services/role-privileges"
 	roleusersvc "github.com/vtievsky/auth-id/internal/services/role-users"
 	rolesvc "github.com/vtievsky/auth-id/internal/services/roles"
 	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	signingkeysvc "github.com/vtievsky/auth-id/internal/services/signing-keys"
 	userprivilegesvc "github.com/vtievsky/auth-id/internal/services/user-privileges"
 	userrolesvc "github.com/vtievsky/auth-id/internal/services/user-roles"
 	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	"github.com/vtievsky/auth-id/pkg/authz"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
 )
 
 type SvcLayer struct {
@@ -22,6 +28,10 @@ type SvcLayer struct {
 	RolePrivilegeSvc RolePrivilegeService
 	PrivilegeSvc     PrivilegeService
 	SessionSvc       SessionService
+	MFASvc           MFAService
+	WebAuthnSvc      WebAuthnService
+	PassResetSvc     PassResetService
+	SigningKeySvc    SigningKeyService
 }
 
 type UserService interface {
@@ -31,8 +41,8 @@ type UserService interface {
 	UpdateUser(ctx context.Context, user usersvc.UserUpdated) (*usersvc.User, error)
 	DeleteUser(ctx context.Context, login string) error
 
-	ChangePass(ctx context.Context, login, current, changed string) error
-	ResetPass(ctx context.Context, login, changed string) error
+	ChangePass(ctx context.Context, login, current, changed, keepSessionID string) error
+	ResetPass(ctx context.Context, login, changed, keepSessionID string) error
 }
 
 type UserRoleService interface {
@@ -43,6 +53,24 @@ type UserPrivilegeService interface {
 	GetUserPrivileges(ctx context.Context, login string, pageSize, offset uint32) ([]*userprivilegesvc.UserPrivilege, error)
 }
 
+type MFAService interface {
+	Enroll(ctx context.Context, login string) (*mfasvc.Enrollment, error)
+	Confirm(ctx context.Context, login, code string) ([]string, error)
+	Disable(ctx context.Context, login string) error
+}
+
+type WebAuthnService interface {
+	BeginRegistration(ctx context.Context, login string) (*webauthnsvc.RegistrationStart, error)
+	FinishRegistration(ctx context.Context, login string, registration webauthnsvc.RegistrationCreated) (*webauthnsvc.Credential, error)
+	GetCredentials(ctx context.Context, login string) ([]*webauthnsvc.Credential, error)
+	DeleteCredential(ctx context.Context, login, credentialID string) error
+}
+
+type PassResetService interface {
+	Request(ctx context.Context, login string) error
+	Complete(ctx context.Context, login, token, password string) error
+}
+
 type RoleService interface {
 	GetRole(ctx context.Context, code string) (*rolesvc.Role, error)
 	GetRoles(ctx context.Context, pageSize, offset uint32) ([]*rolesvc.Role, error)
@@ -68,9 +96,27 @@ type RolePrivilegeService interface {
 type SessionService interface {
 	Get(ctx context.Context, sessionID string) (*sessionsvc.SessionCart, error)
 	Login(ctx context.Context, login, password string) (*sessionsvc.Tokens, error)
+	CompleteMFA(ctx context.Context, challenge, code string) (*sessionsvc.Tokens, error)
+	CompletePasswordChange(ctx context.Context, challenge, password string) (*sessionsvc.Tokens, error)
+	BeginWebAuthnLogin(ctx context.Context, login, mfaChallenge string) (*webauthnsvc.AssertionStart, error)
+	LoginWebAuthn(ctx context.Context, ceremony string, assertion *webauthn.Assertion) (*sessionsvc.Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*sessionsvc.Tokens, error)
 	GetUserSessions(ctx context.Context, login string, pageSize, offset uint32) ([]*sessionsvc.Session, error)
 	Delete(ctx context.Context, login, sessionID string) error
+	RevokeUserSessions(ctx context.Context, login, keepSessionID string) error
+	SyncUserSessions(ctx context.Context, login string) error
+	Unlock(ctx context.Context, login string) error
 	Search(ctx context.Context, sessionID, privilege string) error
+	Introspect(ctx context.Context, token string) (*sessionsvc.Introspection, error)
+	Authorize(ctx context.Context, token string, privilegeCodes []string) ([]*authz.Decision, error)
+}
+
+type SigningKeyService interface {
+	GetSigningKeys(ctx context.Context) ([]*signingkeysvc.SigningKey, error)
+	JWKS(ctx context.Context) authidjwt.JWKSet
+	KeySet() authidjwt.KeySet
+	Rotate(ctx context.Context, kid string) error
+	Generate(ctx context.Context) (*signingkeysvc.SigningKey, error)
 }
 
 type PrivilegeService interface {
@@ -0,0 +1,101 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	"github.com/vtievsky/auth-id/pkg/authz"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+// Решение о доступе сессии, указанной в токене, к каждой из запрошенных привилегий
+func (s *SessionSvc) Authorize(ctx context.Context, token string, privilegeCodes []string) ([]*authz.Decision, error) {
+	const op = "SessionSvc.Authorize"
+
+	ctx, span := tracer.Start(ctx, "authorize")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	deny := func(reason authz.Reason) []*authz.Decision {
+		ul := make([]*authz.Decision, 0, len(privilegeCodes))
+
+		for _, privilegeCode := range privilegeCodes {
+			ul = append(ul, &authz.Decision{
+				Privilege: privilegeCode,
+				Allowed:   false,
+				Reason:    reason,
+			})
+		}
+
+		return ul
+	}
+
+	parsed, err := authidjwt.ParseToken(s.keyRing, []byte(token))
+
+	switch {
+	case errors.Is(err, authidjwt.ErrTokenExpired):
+		return deny(authz.ReasonTokenExpired), nil
+	case err != nil, !parsed.Valid, !parsed.AccessOnly:
+		return deny(authz.ReasonTokenInvalid), nil
+	}
+
+	span.AddEvent("token has been parsed")
+
+	// Наличие корзины подтверждает, что сессия не отозвана
+	if _, err = s.storage.Get(ctx, parsed.SessionID); err != nil {
+		if errors.Is(err, reposessions.ErrSessionCartNotFound) {
+			return deny(authz.ReasonSessionNotFound), nil
+		}
+
+		s.logger.Error("failed to get session cart",
+			zap.String("session_id", parsed.SessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get session cart | %s:%w", op, err)
+	}
+
+	// Сессия могла быть отозвана после проверки корзины, либо действие всех ролей закончилось
+	privileges, err := s.sessionPrivileges(ctx, parsed.SessionID)
+	if errors.Is(err, ErrSessionPrivilegeNotFound) || errors.Is(err, reposessions.ErrSessionCartNotFound) {
+		return deny(authz.ReasonSessionNotFound), nil
+	}
+
+	if err != nil {
+		s.logger.Error("failed to get session privileges",
+			zap.String("session_id", parsed.SessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get session privileges | %s:%w", op, err)
+	}
+
+	span.AddEvent("privileges has been received")
+
+	ul := make([]*authz.Decision, 0, len(privilegeCodes))
+
+	for _, privilegeCode := range privilegeCodes {
+		if slices.Contains(privileges, privilegeCode) {
+			ul = append(ul, &authz.Decision{
+				Privilege: privilegeCode,
+				Allowed:   true,
+				Reason:    "",
+			})
+
+			continue
+		}
+
+		ul = append(ul, &authz.Decision{
+			Privilege: privilegeCode,
+			Allowed:   false,
+			Reason:    authz.ReasonPrivilegeNotFound,
+		})
+	}
+
+	return ul, nil
+}
@@ -0,0 +1,193 @@
+package sessionsvc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	sessionsvc "github.com/vtievsky/auth-id/internal/services/sessions"
+	userprivilegesvc "github.com/vtievsky/auth-id/internal/services/user-privileges"
+	"github.com/vtievsky/auth-id/pkg/authz"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	login     = "ivan"
+	sessionID = "session"
+)
+
+var errStorage = errors.New("storage unavailable")
+
+// Хранилище одной сессии. Сессия пропадает после указанного количества чтений корзины
+type storage struct {
+	sessionsvc.Storage
+
+	cart       *reposessions.SessionCart
+	available  int // Количество чтений корзины до отзыва сессии, 0 - без ограничений
+	reads      int
+	privileges []string
+	listErr    error
+	deleted    bool
+}
+
+func (s *storage) Get(_ context.Context, id string) (*reposessions.SessionCart, error) {
+	s.reads++
+
+	if id != s.cart.ID || s.deleted || (s.available > 0 && s.reads > s.available) {
+		return nil, reposessions.ErrSessionCartNotFound
+	}
+
+	return s.cart, nil
+}
+
+func (s *storage) ListSessionPrivileges(_ context.Context, _ string, _, _ uint32) ([]string, error) {
+	return s.privileges, s.listErr
+}
+
+func (s *storage) Delete(_ context.Context, _, _ string) error {
+	s.deleted = true
+
+	return nil
+}
+
+// Назначения ролей пользователя на момент пересчета
+type userPrivileges struct {
+	privileges []*userprivilegesvc.UserPrivilege
+}
+
+func (u *userPrivileges) GetUserPrivilegesAt(
+	_ context.Context,
+	_ string,
+	_ time.Time,
+) ([]*userprivilegesvc.UserPrivilege, time.Time, error) {
+	return u.privileges, time.Time{}, nil
+}
+
+func newSessionSvc(t *testing.T, st *storage, up *userPrivileges) (*sessionsvc.SessionSvc, string) {
+	t.Helper()
+
+	key := authidjwt.NewHMACKey("1", []byte("secret"))
+
+	token, err := authidjwt.NewAccessToken(key, &authidjwt.TokenOpts{
+		ID:        "1",
+		Subject:   login,
+		SessionID: sessionID,
+		ExpiredAt: time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	svc := sessionsvc.New(&sessionsvc.SessionSvcOpts{ //nolint:exhaustruct
+		Logger:           zap.NewNop(),
+		Storage:          st,
+		UserPrivilegeSvc: up,
+		KeyRing:          authidjwt.NewKeyRing(key),
+	})
+
+	return svc, string(token)
+}
+
+func newStorage(syncAt time.Time) *storage {
+	var sync int64
+
+	if !syncAt.IsZero() {
+		sync = syncAt.Unix()
+	}
+
+	return &storage{ //nolint:exhaustruct
+		cart: &reposessions.SessionCart{ //nolint:exhaustruct
+			ID:        sessionID,
+			Login:     login,
+			CreatedAt: time.Now(),
+			SyncAt:    sync,
+		},
+		privileges: []string{"report_read"},
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	st := newStorage(time.Time{})
+	svc, token := newSessionSvc(t, st, &userPrivileges{privileges: nil})
+
+	decisions, err := svc.Authorize(context.Background(), token, []string{"report_read", "report_delete"})
+	if err != nil {
+		t.Fatalf("failed to authorize: %v", err)
+	}
+
+	expected := []authz.Decision{
+		{Privilege: "report_read", Allowed: true, Reason: ""},
+		{Privilege: "report_delete", Allowed: false, Reason: authz.ReasonPrivilegeNotFound},
+	}
+
+	assertDecisions(t, decisions, expected)
+}
+
+// Сессия, пропавшая после проверки корзины, не приводит к внутренней ошибке
+func TestAuthorizeSessionGone(t *testing.T) {
+	tests := []struct {
+		name string
+		st   *storage
+		up   *userPrivileges
+	}{
+		{
+			name: "revoked after cart check",
+			st: func() *storage {
+				st := newStorage(time.Time{})
+				st.available = 1
+
+				return st
+			}(),
+			up: &userPrivileges{privileges: nil},
+		},
+		{
+			// Момент пересчета наступил, а действие всех назначений ролей закончилось
+			name: "privileges expired",
+			st:   newStorage(time.Now().Add(-time.Minute)),
+			up:   &userPrivileges{privileges: nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, token := newSessionSvc(t, tt.st, tt.up)
+
+			decisions, err := svc.Authorize(context.Background(), token, []string{"report_read"})
+			if err != nil {
+				t.Fatalf("failed to authorize: %v", err)
+			}
+
+			assertDecisions(t, decisions, []authz.Decision{
+				{Privilege: "report_read", Allowed: false, Reason: authz.ReasonSessionNotFound},
+			})
+		})
+	}
+}
+
+func TestAuthorizeStorageError(t *testing.T) {
+	st := newStorage(time.Time{})
+	st.listErr = errStorage
+
+	svc, token := newSessionSvc(t, st, &userPrivileges{privileges: nil})
+
+	if _, err := svc.Authorize(context.Background(), token, []string{"report_read"}); !errors.Is(err, errStorage) {
+		t.Fatalf("expected storage error, got %v", err)
+	}
+}
+
+func assertDecisions(t *testing.T, decisions []*authz.Decision, expected []authz.Decision) {
+	t.Helper()
+
+	if len(decisions) != len(expected) {
+		t.Fatalf("expected %d decisions, got %d", len(expected), len(decisions))
+	}
+
+	for i, decision := range decisions {
+		if *decision != expected[i] {
+			t.Errorf("expected decision %+v, got %+v", expected[i], *decision)
+		}
+	}
+}
@@ -6,31 +6,18 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"sync/atomic"
+	"time"
 
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	"github.com/vtievsky/auth-id/pkg/cache"
 	"go.uber.org/zap"
 )
 
 func (s *SessionSvc) Search(ctx context.Context, sessionID, privilegeCode string) error {
 	const op = "SessionSvc.Search"
 
-	privileges, err := s.cacheByID.Get(ctx, sessionID,
-		func(ctx context.Context) (map[string][]string, error) {
-			privileges, err := s.storage.ListSessionPrivileges(ctx, sessionID, math.MaxUint32, 0)
-
-			switch {
-			case errors.Is(err, nil):
-				s.logger.Debug("session privileges has been synchronized",
-					zap.String("session_id", sessionID),
-					zap.Int("num", len(privileges)),
-				)
-
-				return map[string][]string{
-					sessionID: privileges,
-				}, nil
-			default:
-				return nil, err //nolint:wrapcheck
-			}
-		})
+	privileges, err := s.sessionPrivileges(ctx, sessionID)
 	if err != nil {
 		s.logger.Error("failed to search session privilege",
 			zap.String("session_id", sessionID),
@@ -41,7 +28,8 @@ func (s *SessionSvc) Search(ctx context.Context, sessionID, privilegeCode string
 		return fmt.Errorf("failed to search session privilege | %s:%w", op, err)
 	}
 
-	if slices.Contains(privileges, privilegeCode) {
+	// Пустой код привилегии - достаточно действующей сессии
+	if privilegeCode == "" || slices.Contains(privileges, privilegeCode) {
 		return nil
 	}
 
@@ -53,3 +41,128 @@ func (s *SessionSvc) Search(ctx context.Context, sessionID, privilegeCode string
 
 	return ErrSessionPrivilegeNotFound
 }
+
+// Состояние сессии в локальном кеше
+type sessionState struct {
+	login      string
+	privileges []string
+	syncAt     time.Time    // Момент пересчета привилегий, нулевое время - не требуется
+	seenAt     atomic.Int64 // Unix-время последней записи обращения к сессии
+}
+
+// Привилегии сессии из локального кеша с синхронизацией по хранилищу.
+// При наступлении момента начала или окончания действия назначения роли
+// привилегии сессии пересчитываются без повторного входа пользователя
+func (s *SessionSvc) sessionPrivileges(ctx context.Context, sessionID string) ([]string, error) {
+	const op = "SessionSvc.sessionPrivileges"
+
+	state, err := s.cacheByID.Get(ctx, sessionID, s.loadSession)
+	if errors.Is(err, cache.ErrValueNotFound) {
+		return nil, fmt.Errorf("failed to get session cart | %s:%w", op, reposessions.ErrSessionCartNotFound)
+	}
+
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if state.syncAt.IsZero() || time.Now().Before(state.syncAt) {
+		return state.privileges, nil
+	}
+
+	return s.resync(ctx, state.login, sessionID)
+}
+
+// Загрузка состояния одной сессии, отсутствие сессии кешируется
+func (s *SessionSvc) loadSession(ctx context.Context, sessionID string) (map[string]*sessionState, error) {
+	cart, err := s.storage.Get(ctx, sessionID)
+
+	switch {
+	case errors.Is(err, reposessions.ErrSessionCartNotFound):
+		return map[string]*sessionState{}, nil
+	case err != nil:
+		return nil, err //nolint:wrapcheck
+	}
+
+	privileges, err := s.storage.ListSessionPrivileges(ctx, sessionID, math.MaxUint32, 0)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	s.logger.Debug("session privileges has been loaded",
+		zap.String("session_id", sessionID),
+		zap.Int("num", len(privileges)),
+	)
+
+	var syncAt time.Time
+
+	if cart.SyncAt > 0 {
+		syncAt = time.Unix(cart.SyncAt, 0)
+	}
+
+	return map[string]*sessionState{
+		sessionID: {
+			login:      cart.Login,
+			privileges: privileges,
+			syncAt:     syncAt,
+		},
+	}, nil
+}
+
+// Пересчет привилегий сессии на текущий момент
+func (s *SessionSvc) resync(ctx context.Context, login, sessionID string) ([]string, error) {
+	const op = "SessionSvc.resync"
+
+	privileges, syncAt, err := s.userPrivilegeSvc.GetUserPrivilegesAt(ctx, login, time.Now())
+	if err != nil {
+		s.logger.Error("failed to fetch user privileges",
+			zap.String("login", login),
+			zap.String("session_id", sessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to fetch user privileges | %s:%w", op, err)
+	}
+
+	// Действие всех назначений ролей закончилось
+	if len(privileges) < 1 {
+		if err = s.revoke(ctx, login, sessionID); err != nil {
+			s.logger.Error("failed to revoke session",
+				zap.String("login", login),
+				zap.String("session_id", sessionID),
+				zap.Error(err),
+			)
+		}
+
+		return nil, fmt.Errorf("empty user privileges | %s:%w", op, ErrSessionPrivilegeNotFound)
+	}
+
+	sessionPrivileges := make([]string, 0, len(privileges))
+
+	for _, privilege := range privileges {
+		sessionPrivileges = append(sessionPrivileges, privilege.Code)
+	}
+
+	if err = s.storage.ReplaceSessionPrivileges(ctx, sessionID, sessionPrivileges, syncAt); err != nil {
+		s.logger.Error("failed to replace session privileges",
+			zap.String("login", login),
+			zap.String("session_id", sessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to replace session privileges | %s:%w", op, err)
+	}
+
+	s.cacheByID.Add(ctx, sessionID, &sessionState{
+		login:      login,
+		privileges: sessionPrivileges,
+		syncAt:     syncAt,
+	})
+
+	s.logger.Debug("session privileges has been recomputed",
+		zap.String("login", login),
+		zap.String("session_id", sessionID),
+		zap.Int("num", len(sessionPrivileges)),
+	)
+
+	return sessionPrivileges, nil
+}
@@ -0,0 +1,97 @@
+package sessionsvc
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+)
+
+// Способы входа, сохраняемые в сессии
+const (
+	LoginMethodPassword       = "password"
+	LoginMethodMFA            = "mfa"             // Пароль и код второго фактора или код восстановления
+	LoginMethodWebAuthn       = "webauthn"        // Ключ WebAuthn без пароля или вторым фактором
+	LoginMethodPasswordChange = "password_change" // Смена истекшего пароля
+)
+
+const (
+	maxUserAgentLen = 512
+	maxDeviceLen    = 128
+)
+
+// Сведения о клиенте, от имени которого открывается сессия
+type Client struct {
+	IP        string
+	UserAgent string
+	Device    string // Название устройства, указанное клиентом
+}
+
+type clientCtxKey struct{}
+
+// Контекст со сведениями о клиенте для сохранения в открываемой сессии
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientCtxKey{}, client)
+}
+
+func clientFrom(ctx context.Context) Client {
+	client, _ := ctx.Value(clientCtxKey{}).(Client)
+
+	return Client{
+		IP:        client.IP,
+		UserAgent: truncate(client.UserAgent, maxUserAgentLen),
+		Device:    truncate(strings.TrimSpace(client.Device), maxDeviceLen),
+	}
+}
+
+// Отметка обращения к сессии. Время записывается в хранилище не чаще интервала,
+// чтобы обращения к сервису не приводили к записи на каждый запрос.
+// Запись выполняется в фоне и не задерживает обработку запроса
+func (s *SessionSvc) Touch(ctx context.Context, sessionID string) {
+	if s.lastSeenInterval <= 0 {
+		return
+	}
+
+	state, err := s.cacheByID.Get(ctx, sessionID, s.loadSession)
+	if err != nil {
+		return
+	}
+
+	current := time.Now()
+	seenAt := state.seenAt.Load()
+
+	if current.Sub(time.Unix(seenAt, 0)) < s.lastSeenInterval {
+		return
+	}
+
+	// Запись выполняет только один из одновременных запросов
+	if !state.seenAt.CompareAndSwap(seenAt, current.Unix()) {
+		return
+	}
+
+	go s.touch(context.WithoutCancel(ctx), sessionID, current)
+}
+
+func (s *SessionSvc) touch(ctx context.Context, sessionID string, seenAt time.Time) {
+	if err := s.storage.Touch(ctx, sessionID, seenAt); err != nil {
+		s.logger.Error("failed to touch session",
+			zap.String("session_id", sessionID),
+			zap.Error(err),
+		)
+	}
+}
+
+// Обрезка строки до указанного числа байт без разрыва символа
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+
+	for limit > 0 && !utf8.RuneStart(value[limit]) {
+		limit--
+	}
+
+	return value[:limit]
+}
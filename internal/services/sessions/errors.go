@@ -1,8 +1,35 @@
 package sessionsvc
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrInvalidAccessTokenTTL    = errors.New("duration of the access token is less than the duration of the refresh token")
 	ErrSessionPrivilegeNotFound = errors.New("session privilege not found")
+	ErrSessionNotFound          = errors.New("session not found")
+	ErrRefreshTokenInvalid      = errors.New("refresh token invalid")
+	ErrRefreshTokenExpected     = errors.New("refresh token expected")
+	ErrRefreshTokenReused       = errors.New("refresh token reused, session revoked")
+	ErrUserBlocked              = errors.New("user blocked")
+	ErrLoginLocked              = errors.New("login temporarily locked")
+	ErrLoginThrottled           = errors.New("login attempt too early")
+	ErrMFAChallengeInvalid      = errors.New("mfa challenge invalid or expired")
+	ErrPasswordChangeInvalid    = errors.New("password change challenge invalid or expired")
 )
+
+// Отказ во входе до указанного момента после неудачных попыток
+type LockoutError struct {
+	Err   error
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("%s until %s", e.Err, e.Until.Format(time.RFC3339))
+}
+
+func (e *LockoutError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,95 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+type Introspection struct {
+	Active    bool
+	Subject   string
+	SessionID string
+	Scope     []string
+	IssuedAt  time.Time
+	ExpiredAt time.Time
+}
+
+// Проверка токена по запросу сторонних сервисов (RFC 7662).
+// Недействительный токен не является ошибкой: возвращается неактивный результат
+func (s *SessionSvc) Introspect(ctx context.Context, token string) (*Introspection, error) {
+	const op = "SessionSvc.Introspect"
+
+	ctx, span := tracer.Start(ctx, "introspect")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	inactive := &Introspection{} //nolint:exhaustruct
+
+	parsed, err := authidjwt.ParseToken(s.keyRing, []byte(token))
+	if err != nil {
+		s.logger.Debug("failed to parse introspected token",
+			zap.Error(err),
+		)
+
+		return inactive, nil
+	}
+
+	if !parsed.Valid || !parsed.AccessOnly {
+		return inactive, nil
+	}
+
+	span.AddEvent("token has been parsed")
+
+	cart, err := s.storage.Get(ctx, parsed.SessionID)
+	if err != nil {
+		if errors.Is(err, reposessions.ErrSessionCartNotFound) {
+			return inactive, nil
+		}
+
+		s.logger.Error("failed to get session cart",
+			zap.String("session_id", parsed.SessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get session cart | %s:%w", op, err)
+	}
+
+	span.AddEvent("session has been received")
+
+	privileges, err := s.sessionPrivileges(ctx, cart.ID)
+	if errors.Is(err, ErrSessionPrivilegeNotFound) {
+		return inactive, nil
+	}
+
+	if err != nil {
+		s.logger.Error("failed to get session privileges",
+			zap.String("session_id", cart.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get session privileges | %s:%w", op, err)
+	}
+
+	// Срок действия привилегий сессии истек
+	if len(privileges) < 1 {
+		return inactive, nil
+	}
+
+	span.AddEvent("privileges has been received")
+
+	return &Introspection{
+		Active:    true,
+		Subject:   cart.Login,
+		SessionID: cart.ID,
+		Scope:     privileges,
+		IssuedAt:  parsed.IssuedAt,
+		ExpiredAt: parsed.ExpiredAt,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	repolockouts "github.com/vtievsky/auth-id/internal/repositories/sessions/lockouts"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"go.uber.org/zap"
+)
+
+const (
+	maxDelayShift = 30 // Ограничение степени двойки задержки во избежание переполнения
+)
+
+type LockoutStorage interface {
+	Reserve(ctx context.Context, login string, rule repolockouts.Rule) (*repolockouts.Reservation, error)
+	Release(ctx context.Context, login string, reservation *repolockouts.Reservation) error
+	Reset(ctx context.Context, login string) error
+}
+
+// Правила ограничения неудачных попыток входа
+type LockoutPolicy struct {
+	Threshold          int64         // Неудачных попыток до временной блокировки, 0 - не блокировать
+	Duration           time.Duration // Длительность временной блокировки
+	BaseDelay          time.Duration // Задержка после первой неудачной попытки, далее удваивается
+	MaxDelay           time.Duration // Максимальная задержка между попытками
+	Window             time.Duration // Срок хранения счетчика с момента последней неудачной попытки
+	PermanentThreshold int64         // Неудачных попыток до блокировки пользователя, 0 - не блокировать
+}
+
+// Отказ во входе во время блокировки или до истечения задержки после неудачной попытки.
+// Разрешенная попытка резервируется как неудачная в той же операции хранилища,
+// по ее итогу резервирование сбрасывается, возвращается или остается учтенным
+func (s *SessionSvc) checkLockout(ctx context.Context, login string) (*repolockouts.Reservation, error) {
+	reservation, err := s.lockouts.Reserve(ctx, login, repolockouts.Rule{
+		Threshold:     s.lockout.Threshold,
+		Duration:      s.lockout.Duration,
+		BaseDelay:     s.lockout.BaseDelay,
+		MaxDelay:      s.lockout.MaxDelay,
+		MaxDelayShift: maxDelayShift,
+		TTL:           max(s.lockout.Window, s.lockout.Duration),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	switch reservation.Status {
+	case repolockouts.Locked:
+		return nil, &LockoutError{
+			Err:   ErrLoginLocked,
+			Until: reservation.LockedUntil,
+		}
+	case repolockouts.Throttled:
+		return nil, &LockoutError{
+			Err:   ErrLoginThrottled,
+			Until: reservation.NextAt,
+		}
+	}
+
+	return reservation, nil
+}
+
+// Учет неудачной попытки, совершенной без предварительного резервирования
+func (s *SessionSvc) countFailure(ctx context.Context, login string, u *usersvc.User) {
+	reservation, err := s.checkLockout(ctx, login)
+	if err != nil {
+		// Во время блокировки или задержки попытка не учитывается повторно
+		if !errors.Is(err, ErrLoginLocked) && !errors.Is(err, ErrLoginThrottled) {
+			s.logger.Error("failed to register login failure",
+				zap.String("login", login),
+				zap.Error(err),
+			)
+		}
+
+		return
+	}
+
+	s.registerFailure(ctx, login, u, reservation)
+}
+
+// Учет неудачной попытки входа, зарезервированной при проверке. Пользователь равен nil,
+// если логин не найден: попытки с несуществующим логином ограничиваются так же,
+// чтобы не раскрывать его отсутствие
+func (s *SessionSvc) registerFailure(ctx context.Context, login string, u *usersvc.User, reservation *repolockouts.Reservation) {
+	failures := reservation.Failures
+
+	if !reservation.LockedUntil.IsZero() {
+		incrSecurityEvent(ctx, SecurityKindLoginLockout)
+
+		s.logger.Warn("login has been locked",
+			zap.String("login", login),
+			zap.Int64("failures", failures),
+			zap.Time("locked_until", reservation.LockedUntil),
+		)
+	}
+
+	if u == nil || u.Blocked || s.lockout.PermanentThreshold < 1 || failures < s.lockout.PermanentThreshold {
+		return
+	}
+
+	if err := s.blockUser(ctx, u); err != nil {
+		s.logger.Error("failed to block user",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	incrSecurityEvent(ctx, SecurityKindUserAutoBlocked)
+
+	s.logger.Warn("user has been blocked after login failures",
+		zap.String("login", login),
+		zap.Int64("failures", failures),
+	)
+}
+
+// Возврат зарезервированной попытки, которая не была неудачной: верный пароль
+// до подтверждения вторым фактором или ошибка, не связанная с подбором
+func (s *SessionSvc) releaseLockout(ctx context.Context, login string, reservation *repolockouts.Reservation) {
+	if err := s.lockouts.Release(ctx, login, reservation); err != nil {
+		s.logger.Error("failed to release login attempt",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+	}
+}
+
+// Сброс счетчика неудачных попыток после успешного входа
+func (s *SessionSvc) resetLockout(ctx context.Context, login string) {
+	if err := s.lockouts.Reset(ctx, login); err != nil {
+		s.logger.Error("failed to reset lockout",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+	}
+}
+
+// Снятие временной блокировки входа администратором.
+// Блокировка пользователя после превышения порога снимается изменением пользователя
+func (s *SessionSvc) Unlock(ctx context.Context, login string) error {
+	const op = "SessionSvc.Unlock"
+
+	if err := s.lockouts.Reset(ctx, login); err != nil {
+		s.logger.Error("failed to reset lockout",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to reset lockout | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Сессии заблокированного пользователя отзывает сервис пользователей
+func (s *SessionSvc) blockUser(ctx context.Context, u *usersvc.User) error {
+	if _, err := s.userSvc.UpdateUser(ctx, usersvc.UserUpdated{
+		Name:    u.Name,
+		Login:   u.Login,
+		Blocked: true,
+		Email:   nil,
+	}); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return nil
+}
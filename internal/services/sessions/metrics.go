@@ -11,8 +11,10 @@ import (
 )
 
 var (
-	meter           = otel.Meter("sessionsvc") //nolint:gochecknoglobals
-	authCallCounter metric.Int64Counter        //nolint:gochecknoglobals
+	meter              = otel.Meter("sessionsvc") //nolint:gochecknoglobals
+	authCallCounter    metric.Int64Counter        //nolint:gochecknoglobals
+	refreshCallCounter metric.Int64Counter        //nolint:gochecknoglobals
+	securityCounter    metric.Int64Counter        //nolint:gochecknoglobals
 )
 
 func init() { //nolint:gochecknoinits
@@ -26,6 +28,28 @@ func init() { //nolint:gochecknoinits
 	}
 
 	authCallCounter = totalCounter
+
+	refreshCounter, err := meter.Int64Counter(
+		"refresh_calls",
+		metric.WithDescription("The count of refresh calls"),
+		metric.WithUnit(""),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error while create refresh_calls metric | %w", err))
+	}
+
+	refreshCallCounter = refreshCounter
+
+	eventsCounter, err := meter.Int64Counter(
+		"security_events",
+		metric.WithDescription("The count of security events"),
+		metric.WithUnit(""),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error while create security_events metric | %w", err))
+	}
+
+	securityCounter = eventsCounter
 }
 
 // Инкремент счетчика успешного логина
@@ -54,3 +78,43 @@ func incrLoginFail(ctx context.Context, callKind string) {
 		),
 	)
 }
+
+// Инкремент счетчика успешного обновления токенов
+func incrRefreshSuccess(ctx context.Context) {
+	refreshCallCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.Bool("success", true),
+			),
+		),
+	)
+}
+
+// Инкремент счетчика неудачного обновления токенов
+func incrRefreshFail(ctx context.Context, callKind string) {
+	refreshCallCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.Bool("success", false),
+				attribute.String("call_kind", strings.ToLower(callKind)),
+			),
+		),
+	)
+}
+
+// Инкремент счетчика событий безопасности
+func incrSecurityEvent(ctx context.Context, eventKind string) {
+	securityCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.String("event_kind", strings.ToLower(eventKind)),
+			),
+		),
+	)
+}
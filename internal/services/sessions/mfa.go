@@ -0,0 +1,282 @@
+package sessionsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	repochallenges "github.com/vtievsky/auth-id/internal/repositories/sessions/challenges"
+	mfasvc "github.com/vtievsky/auth-id/internal/services/mfa"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+const (
+	challengeSize = 32
+)
+
+type MFASvc interface {
+	Enabled(ctx context.Context, login string) (bool, error)
+	Verify(ctx context.Context, login, code string) error
+}
+
+type ChallengeStorage interface {
+	Store(ctx context.Context, challengeID, kind, login string, ttl time.Duration) error
+	Get(ctx context.Context, challengeID string) (*repochallenges.Challenge, error)
+	Attempt(ctx context.Context, challengeID string) (int64, error)
+	Delete(ctx context.Context, challengeID string) (bool, error)
+}
+
+// Правила подтверждения входа вторым фактором
+type MFAPolicy struct {
+	ChallengeTTL      time.Duration // Время на ввод кода после проверки пароля
+	ChallengeAttempts int64         // Попыток ввода кода до отмены входа
+}
+
+// Подключен ли у пользователя код TOTP или ключ WebAuthn
+func (s *SessionSvc) secondFactorEnabled(ctx context.Context, login string) (bool, error) {
+	enabled, err := s.mfaSvc.Enabled(ctx, login)
+	if err != nil || enabled {
+		return enabled, err //nolint:wrapcheck
+	}
+
+	return s.webAuthnSvc.HasCredentials(ctx, login) //nolint:wrapcheck
+}
+
+// Выпуск непрозрачного токена незавершенного входа
+func (s *SessionSvc) startChallenge(ctx context.Context, kind, login string) (string, error) {
+	raw := make([]byte, challengeSize)
+
+	if _, err := rand.Read(raw); err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	challengeID := base64.RawURLEncoding.EncodeToString(raw)
+
+	if err := s.challenges.Store(ctx, challengeID, kind, login, s.mfa.ChallengeTTL); err != nil {
+		s.logger.Error("failed to store challenge",
+			zap.String("login", login),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+
+		return "", err //nolint:wrapcheck
+	}
+
+	s.logger.Debug("challenge has been issued",
+		zap.String("login", login),
+		zap.String("kind", kind),
+	)
+
+	return challengeID, nil
+}
+
+// Завершение входа кодом второго фактора или кодом восстановления
+func (s *SessionSvc) CompleteMFA(ctx context.Context, challengeID, code string) (*Tokens, error) {
+	const op = "SessionSvc.CompleteMFA"
+
+	ctx, span := tracer.Start(ctx, "complete_mfa")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	challenge, err := s.challenges.Get(ctx, challengeID)
+	if err == nil && challenge.Kind != repochallenges.KindMFA {
+		err = ErrMFAChallengeInvalid
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+		s.logger.Error("failed to get mfa challenge",
+			zap.Error(err),
+		)
+
+		if errors.Is(err, repochallenges.ErrChallengeNotFound) {
+			err = ErrMFAChallengeInvalid
+		}
+
+		return nil, fmt.Errorf("failed to get mfa challenge | %s:%w", op, err)
+	}
+
+	login := challenge.Login
+
+	span.AddEvent("challenge has been received")
+
+	// Попытка резервируется до проверки кода, чтобы параллельные запросы не превысили ограничение
+	attempts, err := s.reserveAttempt(ctx, challengeID, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+		return nil, fmt.Errorf("failed to reserve mfa attempt | %s:%w", op, err)
+	}
+
+	// Неудачные попытки ввода кода учитываются наравне с неверным паролем
+	lockout, err := s.checkLockout(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		s.logger.Error("failed to check lockout",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check lockout | %s:%w", op, err)
+	}
+
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindFailedGetUser)
+
+		s.releaseLockout(ctx, login, lockout)
+
+		s.logger.Error("failed to get user",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if err = s.mfaSvc.Verify(ctx, login, code); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		s.logger.Error("failed to verify mfa code",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		if errors.Is(err, mfasvc.ErrMFACodeInvalid) || errors.Is(err, mfasvc.ErrMFACodeReused) {
+			incrLoginFail(ctx, MetricKindInvalidMFACode)
+
+			s.registerFailure(ctx, login, u, lockout)
+			s.failChallenge(ctx, challengeID, login, attempts)
+		} else {
+			s.releaseLockout(ctx, login, lockout)
+		}
+
+		return nil, fmt.Errorf("failed to verify mfa code | %s:%w", op, err)
+	}
+
+	span.AddEvent("code has been verified")
+
+	// Токен входа одноразовый: при параллельном завершении сессию получит только один запрос
+	deleted, err := s.challenges.Delete(ctx, challengeID)
+	if err == nil && !deleted {
+		err = ErrMFAChallengeInvalid
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+		s.releaseLockout(ctx, login, lockout)
+
+		s.logger.Error("failed to delete mfa challenge",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to delete mfa challenge | %s:%w", op, err)
+	}
+
+	s.resetLockout(ctx, login)
+
+	// Пользователь мог быть заблокирован, пока вводился код
+	if u.Blocked {
+		err = ErrUserBlocked
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindUserBlocked)
+
+		s.logger.Error("user blocked",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("user blocked | %s:%w", op, err)
+	}
+
+	tokens, err := s.openSession(ctx, u.Login, LoginMethodMFA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session | %s:%w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Резервирование попытки ввода кода. Вход, исчерпавший попытки, отменяется
+func (s *SessionSvc) reserveAttempt(ctx context.Context, challengeID, login string) (int64, error) {
+	attempts, err := s.challenges.Attempt(ctx, challengeID)
+
+	switch {
+	case errors.Is(err, repochallenges.ErrChallengeNotFound):
+		return 0, ErrMFAChallengeInvalid
+	case err != nil:
+		s.logger.Error("failed to reserve mfa challenge attempt",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return 0, err //nolint:wrapcheck
+	}
+
+	if s.mfa.ChallengeAttempts < 1 || attempts <= s.mfa.ChallengeAttempts {
+		return attempts, nil
+	}
+
+	s.cancelChallenge(ctx, challengeID, login, attempts)
+
+	return 0, ErrMFAChallengeInvalid
+}
+
+// Учет неверного кода. После исчерпания попыток вход начинается заново с пароля
+func (s *SessionSvc) failChallenge(ctx context.Context, challengeID, login string, attempts int64) {
+	if s.mfa.ChallengeAttempts < 1 || attempts < s.mfa.ChallengeAttempts {
+		return
+	}
+
+	s.cancelChallenge(ctx, challengeID, login, attempts)
+}
+
+func (s *SessionSvc) cancelChallenge(ctx context.Context, challengeID, login string, attempts int64) {
+	deleted, err := s.challenges.Delete(ctx, challengeID)
+	if err != nil {
+		s.logger.Error("failed to delete mfa challenge",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	// Событие учитывается один раз, даже если попытки исчерпаны несколькими запросами
+	if !deleted {
+		return
+	}
+
+	incrSecurityEvent(ctx, SecurityKindMFAExhausted)
+
+	s.logger.Warn("mfa challenge attempts exhausted",
+		zap.String("login", login),
+		zap.Int64("attempts", attempts),
+	)
+}
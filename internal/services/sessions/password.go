@@ -0,0 +1,175 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	repochallenges "github.com/vtievsky/auth-id/internal/repositories/sessions/challenges"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// Завершение входа сменой истекшего пароля
+func (s *SessionSvc) CompletePasswordChange(ctx context.Context, challengeID, password string) (*Tokens, error) {
+	const op = "SessionSvc.CompletePasswordChange"
+
+	ctx, span := tracer.Start(ctx, "complete_password_change")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	challenge, err := s.challenges.Get(ctx, challengeID)
+	if err == nil && challenge.Kind != repochallenges.KindPasswordChange {
+		err = ErrPasswordChangeInvalid
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+		s.logger.Error("failed to get password change challenge",
+			zap.Error(err),
+		)
+
+		if errors.Is(err, repochallenges.ErrChallengeNotFound) {
+			err = ErrPasswordChangeInvalid
+		}
+
+		return nil, fmt.Errorf("failed to get password change challenge | %s:%w", op, err)
+	}
+
+	login := challenge.Login
+
+	span.AddEvent("challenge has been received")
+
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindFailedGetUser)
+
+		s.logger.Error("failed to get user",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if u.Blocked {
+		err = ErrUserBlocked
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindUserBlocked)
+
+		s.logger.Error("user blocked",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("user blocked | %s:%w", op, err)
+	}
+
+	// Токен смены не удаляется при нарушении правил, чтобы пользователь мог выбрать другой пароль
+	if err = s.userSvc.CheckPassword(ctx, login, password); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, usersvc.ErrPasswordPolicy) {
+			incrLoginFail(ctx, MetricKindPasswordRejected)
+		}
+
+		s.logger.Error("failed to check expired password change",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check password | %s:%w", op, err)
+	}
+
+	// Токен погашается до смены пароля, поэтому одновременные запросы с одним токеном
+	// не могут сменить пароль и открыть сессию дважды
+	deleted, err := s.challenges.Delete(ctx, challengeID)
+	if err == nil && !deleted {
+		err = ErrPasswordChangeInvalid
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+		s.logger.Error("failed to delete password change challenge",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to delete password change challenge | %s:%w", op, err)
+	}
+
+	// Сессии, открытые со старым паролем, отзываются сервисом пользователей до открытия новой
+	if err = s.userSvc.ResetPass(ctx, login, password, ""); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, usersvc.ErrPasswordPolicy) {
+			incrLoginFail(ctx, MetricKindPasswordRejected)
+		}
+
+		s.logger.Error("failed to change expired password",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to change expired password | %s:%w", op, err)
+	}
+
+	span.AddEvent("password has been changed")
+
+	tokens, err := s.openSession(ctx, u.Login, LoginMethodPasswordChange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session | %s:%w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Пересчет хеша с устаревшими параметрами, пока известен проверенный пароль.
+// Ошибка не препятствует входу, хеш будет пересчитан при следующем входе
+func (s *SessionSvc) rehashPassword(ctx context.Context, u *usersvc.User, password string) {
+	if !s.userSvc.NeedsRehash(u.Password) {
+		return
+	}
+
+	if err := s.userSvc.RehashPassword(ctx, u.Login, u.Password, password); err != nil {
+		s.logger.Error("failed to rehash password",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	s.logger.Info("password hash has been upgraded",
+		zap.String("login", u.Login),
+	)
+}
+
+// Начало отсчета срока действия пароля пользователя, созданного до ограничения срока.
+// Ошибка не препятствует входу, отсчет начнется при следующем входе
+func (s *SessionSvc) startPasswordAge(ctx context.Context, login string) {
+	if err := s.userSvc.StartPasswordAge(ctx, login); err != nil {
+		s.logger.Error("failed to start password age",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+	}
+}
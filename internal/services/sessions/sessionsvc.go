@@ -2,11 +2,14 @@ package sessionsvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	repochallenges "github.com/vtievsky/auth-id/internal/repositories/sessions/challenges"
 	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
 	userprivilegesvc "github.com/vtievsky/auth-id/internal/services/user-privileges"
 	usersvc "github.com/vtievsky/auth-id/internal/services/users"
@@ -27,17 +30,48 @@ const (
 	MetricKindEmptyPrivileges       = "empty_privileges"
 	MetricKindFailedGenerateToken   = "failed_generate_token"
 	MetricKindFailedStoreSession    = "failed_store_session"
+	MetricKindInvalidRefreshToken   = "invalid_refresh_token"
+	MetricKindUnknownSession        = "unknown_session"
+	MetricKindRefreshTokenReused    = "refresh_token_reused"
+	MetricKindFailedRotateToken     = "failed_rotate_token"
+	MetricKindUserBlocked           = "user_blocked"
+	MetricKindLoginLocked           = "login_locked"
+	MetricKindLoginThrottled        = "login_throttled"
+	MetricKindFailedCheckLockout    = "failed_check_lockout"
+	MetricKindFailedCheckMFA        = "failed_check_mfa"
+	MetricKindFailedStoreChallenge  = "failed_store_challenge"
+	MetricKindInvalidChallenge      = "invalid_challenge"
+	MetricKindInvalidMFACode        = "invalid_mfa_code"
+	MetricKindInvalidAssertion      = "invalid_webauthn_assertion"
+	MetricKindFailedCheckPassword   = "failed_check_password_age"
+	MetricKindPasswordExpired       = "password_expired"
+	MetricKindPasswordRejected      = "password_rejected"
+)
+
+const (
+	SecurityKindRefreshTokenReuse = "refresh_token_reuse"
+	SecurityKindLoginLockout      = "login_lockout"
+	SecurityKindUserAutoBlocked   = "user_auto_blocked"
+	SecurityKindMFAExhausted      = "mfa_attempts_exhausted"
 )
 
 type Tokens struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken    string
+	RefreshToken   string
+	Challenge      string // Заполняется вместо токенов, если требуется подтверждение вторым фактором
+	PasswordChange string // Заполняется вместо токенов, если истек срок действия пароля
+	refreshTokenID string
 }
 
 type Session struct {
-	ID        []byte
-	CreatedAt time.Time
-	ExpiredAt time.Time
+	ID         []byte
+	CreatedAt  time.Time
+	ExpiredAt  time.Time
+	IP         string
+	UserAgent  string
+	Device     string
+	Method     string
+	LastSeenAt time.Time // Нулевое время - обращений не было
 }
 
 type SessionCart struct {
@@ -50,53 +84,97 @@ type Storage interface {
 	Get(ctx context.Context, sessionID string) (*reposessions.SessionCart, error)
 	List(ctx context.Context, login string, pageSize, offset uint32) ([]*reposessions.Session, error)
 	ListSessionPrivileges(ctx context.Context, sessionID string, pageSize, offset uint32) ([]string, error)
-	Store(ctx context.Context, login, sessionID string, privileges []string, ttl time.Duration) error
+	Store(
+		ctx context.Context,
+		login, sessionID, refreshTokenID string,
+		privileges []string,
+		syncAt time.Time,
+		meta reposessions.SessionMeta,
+		ttl time.Duration,
+	) error
+	Touch(ctx context.Context, sessionID string, seenAt time.Time) error
+	RotateRefreshToken(ctx context.Context, sessionID, usedID, newID string) error
+	ListSessionIDs(ctx context.Context, login string) ([]string, error)
+	DeleteUserSessions(ctx context.Context, login, keepSessionID string) ([]string, error)
+	ReplaceSessionPrivileges(ctx context.Context, sessionID string, privileges []string, syncAt time.Time) error
 	Delete(ctx context.Context, login, sessionID string) error
 }
 
 type UserSvc interface {
 	GetUser(ctx context.Context, login string) (*usersvc.User, error)
+	UpdateUser(ctx context.Context, user usersvc.UserUpdated) (*usersvc.User, error)
 	ComparePassword(password, current []byte) error
+	NeedsRehash(hash string) bool
+	RehashPassword(ctx context.Context, login, hash, password string) error
+	CheckPassword(ctx context.Context, login, password string) error
+	ResetPass(ctx context.Context, login, changed, keepSessionID string) error
+	StartPasswordAge(ctx context.Context, login string) error
+	PasswordExpired(ctx context.Context, login string) (bool, error)
 }
 
 type UserPrivilegeSvc interface {
-	GetUserPrivileges(ctx context.Context, login string, pageSize, offset uint32) ([]*userprivilegesvc.UserPrivilege, error)
+	GetUserPrivilegesAt(ctx context.Context, login string, at time.Time) ([]*userprivilegesvc.UserPrivilege, time.Time, error)
 }
 
 type SessionSvcOpts struct {
 	Logger           *zap.Logger
 	Storage          Storage
+	CacheBus         cache.Bus
+	Lockouts         LockoutStorage
+	Lockout          LockoutPolicy
+	Challenges       ChallengeStorage
+	MFA              MFAPolicy
+	MFASvc           MFASvc
+	WebAuthnSvc      WebAuthnSvc
 	UserSvc          UserSvc
 	UserPrivilegeSvc UserPrivilegeSvc
 	SessionTTL       time.Duration
 	AccessTokenTTL   time.Duration
 	RefreshTokenTTL  time.Duration
-	SigningKey       string
+	LastSeenInterval time.Duration // Минимальный интервал записи времени обращения, 0 - не записывать
+	KeyRing          *authidjwt.KeyRing
 }
 
 type SessionSvc struct {
 	logger           *zap.Logger
 	storage          Storage
+	lockouts         LockoutStorage
+	lockout          LockoutPolicy
+	challenges       ChallengeStorage
+	mfa              MFAPolicy
+	mfaSvc           MFASvc
+	webAuthnSvc      WebAuthnSvc
 	userSvc          UserSvc
 	userPrivilegeSvc UserPrivilegeSvc
 	sessionTTL       time.Duration
 	accessTokenTTL   time.Duration
 	refreshTokenTTL  time.Duration
-	signingKey       string
-	cacheByID        cache.Cache[string, []string]
+	lastSeenInterval time.Duration
+	keyRing          *authidjwt.KeyRing
+	cacheByID        *cache.Cache[string, *sessionState]
 }
 
 func New(opts *SessionSvcOpts) *SessionSvc {
 	return &SessionSvc{
 		logger:           opts.Logger,
 		storage:          opts.Storage,
+		lockouts:         opts.Lockouts,
+		lockout:          opts.Lockout,
+		challenges:       opts.Challenges,
+		mfa:              opts.MFA,
+		mfaSvc:           opts.MFASvc,
+		webAuthnSvc:      opts.WebAuthnSvc,
 		userSvc:          opts.UserSvc,
 		userPrivilegeSvc: opts.UserPrivilegeSvc,
 		accessTokenTTL:   opts.AccessTokenTTL,
 		refreshTokenTTL:  opts.RefreshTokenTTL,
 		sessionTTL:       opts.SessionTTL,
-		signingKey:       opts.SigningKey,
-		cacheByID:        cache.New[string, []string](),
+		lastSeenInterval: opts.LastSeenInterval,
+		keyRing:          opts.KeyRing,
+		cacheByID: cache.New[string, *sessionState](&cache.Opts{
+			Name: "sessions:id",
+			Bus:  opts.CacheBus,
+		}),
 	}
 }
 
@@ -128,6 +206,31 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 
 	span.AddEvent("start")
 
+	// Ограничение неудачных попыток входа
+	lockout, err := s.checkLockout(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		switch {
+		case errors.Is(err, ErrLoginLocked):
+			incrLoginFail(ctx, MetricKindLoginLocked)
+		case errors.Is(err, ErrLoginThrottled):
+			incrLoginFail(ctx, MetricKindLoginThrottled)
+		default:
+			incrLoginFail(ctx, MetricKindFailedCheckLockout)
+		}
+
+		s.logger.Error("failed to check lockout",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check lockout | %s:%w", op, err)
+	}
+
+	span.AddEvent("lockout has been checked")
+
 	u, err := s.userSvc.GetUser(ctx, login)
 	if err != nil {
 		span.RecordError(err)
@@ -135,6 +238,13 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 
 		incrLoginFail(ctx, MetricKindFailedGetUser)
 
+		// Подбором считается только несуществующий логин, а не недоступность хранилища
+		if errors.Is(err, dberrors.ErrUserNotFound) {
+			s.registerFailure(ctx, login, nil, lockout)
+		} else {
+			s.releaseLockout(ctx, login, lockout)
+		}
+
 		s.logger.Error("failed to get user",
 			zap.String("login", login),
 			zap.Error(err),
@@ -152,6 +262,8 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 
 		incrLoginFail(ctx, MetricKindInvalidPassword)
 
+		s.registerFailure(ctx, login, u, lockout)
+
 		s.logger.Error("failed to compare password",
 			zap.String("login", login),
 			zap.Error(err),
@@ -162,8 +274,133 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 
 	span.AddEvent("password has been compared")
 
-	// Получение привилегий пользователя и создание сессии
-	privileges, err := s.userPrivilegeSvc.GetUserPrivileges(ctx, u.Login, math.MaxUint32, 0)
+	s.rehashPassword(ctx, u, password)
+
+	// При подключенном втором факторе токены выдаются только после подтверждения кодом.
+	// Счетчик неудачных попыток в этом случае сбрасывается после проверки кода,
+	// иначе знание пароля позволило бы перебирать коды без ограничения
+	mfaEnabled, err := s.secondFactorEnabled(ctx, u.Login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindFailedCheckMFA)
+
+		s.releaseLockout(ctx, login, lockout)
+
+		s.logger.Error("failed to check mfa",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check mfa | %s:%w", op, err)
+	}
+
+	if mfaEnabled {
+		s.releaseLockout(ctx, login, lockout)
+	} else {
+		s.resetLockout(ctx, login)
+	}
+
+	// Блокировка проверяется после пароля, чтобы не раскрывать ее без знания пароля
+	if u.Blocked {
+		err = ErrUserBlocked
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindUserBlocked)
+
+		s.logger.Error("user blocked",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("user blocked | %s:%w", op, err)
+	}
+
+	if mfaEnabled {
+		challengeID, err := s.startChallenge(ctx, repochallenges.KindMFA, u.Login)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			incrLoginFail(ctx, MetricKindFailedStoreChallenge)
+
+			return nil, fmt.Errorf("failed to start mfa challenge | %s:%w", op, err)
+		}
+
+		span.AddEvent("mfa challenge has been issued")
+
+		return &Tokens{
+			AccessToken:    "",
+			RefreshToken:   "",
+			Challenge:      challengeID,
+			PasswordChange: "",
+			refreshTokenID: "",
+		}, nil
+	}
+
+	tokens, err := s.openSession(ctx, u.Login, LoginMethodPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session | %s:%w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Получение привилегий пользователя, выпуск токенов и сохранение новой сессии
+func (s *SessionSvc) openSession(ctx context.Context, login, method string) (*Tokens, error) {
+	const op = "SessionSvc.openSession"
+
+	ctx, span := tracer.Start(ctx, "open_session")
+	defer span.End()
+
+	s.startPasswordAge(ctx, login)
+
+	// Истекший пароль меняется до открытия сессии, вместо токенов выдается токен смены пароля.
+	// Проверка выполняется после второго фактора, чтобы смена пароля не заменяла его
+	expired, err := s.userSvc.PasswordExpired(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindFailedCheckPassword)
+
+		s.logger.Error("failed to check password age",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check password age | %s:%w", op, err)
+	}
+
+	if expired {
+		challengeID, err := s.startChallenge(ctx, repochallenges.KindPasswordChange, login)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			incrLoginFail(ctx, MetricKindFailedStoreChallenge)
+
+			return nil, fmt.Errorf("failed to start password change | %s:%w", op, err)
+		}
+
+		incrLoginFail(ctx, MetricKindPasswordExpired)
+
+		span.AddEvent("password change has been required")
+
+		return &Tokens{
+			AccessToken:    "",
+			RefreshToken:   "",
+			Challenge:      "",
+			PasswordChange: challengeID,
+			refreshTokenID: "",
+		}, nil
+	}
+
+	// Момент начала или окончания действия ближайшего назначения роли
+	privileges, syncAt, err := s.userPrivilegeSvc.GetUserPrivilegesAt(ctx, login, time.Now())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -209,7 +446,7 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 		}
 	}
 
-	tokens, err := s.generateTokens(ctx, sessionID)
+	tokens, err := s.generateTokens(ctx, login, sessionID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -234,7 +471,15 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 	// общей длительности сессии пользователя (refreshTokenTTL)
 	sessionDuration = s.compareSessionWithRefreshTokenTTL(sessionDuration, s.refreshTokenTTL)
 
-	if err = s.storage.Store(ctx, login, sessionID, sessionPrivileges, sessionDuration); err != nil {
+	client := clientFrom(ctx)
+	meta := reposessions.SessionMeta{
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		Device:    client.Device,
+		Method:    method,
+	}
+
+	if err = s.storage.Store(ctx, login, sessionID, tokens.refreshTokenID, sessionPrivileges, syncAt, meta, sessionDuration); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
@@ -260,6 +505,143 @@ func (s *SessionSvc) Login(ctx context.Context, login, password string) (*Tokens
 	return tokens, nil
 }
 
+// Выпуск новой пары токенов для существующей сессии
+func (s *SessionSvc) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	const op = "SessionSvc.Refresh"
+
+	ctx, span := tracer.Start(ctx, "refresh")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	token, err := authidjwt.ParseToken(s.keyRing, []byte(refreshToken))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindInvalidRefreshToken)
+
+		s.logger.Error("failed to parse refresh token",
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to parse refresh token | %s:%w", op, ErrRefreshTokenInvalid)
+	}
+
+	switch {
+	case !token.Valid:
+		err = ErrRefreshTokenInvalid
+	case token.AccessOnly:
+		// Access-токен не может использоваться для продления сессии
+		err = ErrRefreshTokenExpected
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindInvalidRefreshToken)
+
+		s.logger.Error("failed to validate refresh token",
+			zap.String("session_id", token.SessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to validate refresh token | %s:%w", op, err)
+	}
+
+	span.AddEvent("token has been parsed")
+
+	// Сессия могла быть удалена или истечь
+	cart, err := s.storage.Get(ctx, token.SessionID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindUnknownSession)
+
+		s.logger.Error("failed to get session cart",
+			zap.String("session_id", token.SessionID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get session cart | %s:%w", op, err)
+	}
+
+	span.AddEvent("session has been received")
+
+	tokens, err := s.generateTokens(ctx, cart.Login, cart.ID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindFailedGenerateToken)
+
+		s.logger.Error("failed to generate tokens",
+			zap.String("login", cart.Login),
+			zap.String("session_id", cart.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to generate tokens | %s:%w", op, err)
+	}
+
+	span.AddEvent("token has been generated")
+
+	// Ротация refresh-токена. Повторное предъявление уже использованного токена
+	// означает его компрометацию, поэтому сессия отзывается целиком
+	err = s.storage.RotateRefreshToken(ctx, cart.ID, token.ID, tokens.refreshTokenID)
+	if errors.Is(err, reposessions.ErrRefreshTokenReused) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindRefreshTokenReused)
+		incrSecurityEvent(ctx, SecurityKindRefreshTokenReuse)
+
+		s.logger.Warn("refresh token reuse detected, session will be revoked",
+			zap.String("login", cart.Login),
+			zap.String("session_id", cart.ID),
+			zap.String("token_id", token.ID),
+		)
+
+		if err = s.revoke(ctx, cart.Login, cart.ID); err != nil {
+			s.logger.Error("failed to revoke session",
+				zap.String("login", cart.Login),
+				zap.String("session_id", cart.ID),
+				zap.Error(err),
+			)
+		}
+
+		return nil, fmt.Errorf("failed to rotate refresh token | %s:%w", op, ErrRefreshTokenReused)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrRefreshFail(ctx, MetricKindFailedRotateToken)
+
+		s.logger.Error("failed to rotate refresh token",
+			zap.String("login", cart.Login),
+			zap.String("session_id", cart.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to rotate refresh token | %s:%w", op, err)
+	}
+
+	span.AddEvent("token has been rotated")
+
+	s.logger.Debug("Refresh was successful",
+		zap.String("login", cart.Login),
+		zap.String("session_id", cart.ID),
+	)
+
+	incrRefreshSuccess(ctx)
+
+	return tokens, nil
+}
+
 func (s *SessionSvc) GetUserSessions(ctx context.Context, login string, pageSize, offset uint32) ([]*Session, error) {
 	const op = "SessionSvc.GetUserSessions"
 
@@ -294,9 +676,14 @@ func (s *SessionSvc) GetUserSessions(ctx context.Context, login string, pageSize
 		expiredAt = current.Add(session.TTL)
 
 		ul = append(ul, &Session{
-			ID:        []byte(session.ID),
-			CreatedAt: session.CreatedAt,
-			ExpiredAt: expiredAt,
+			ID:         []byte(session.ID),
+			CreatedAt:  session.CreatedAt,
+			ExpiredAt:  expiredAt,
+			IP:         session.IP,
+			UserAgent:  session.UserAgent,
+			Device:     session.Device,
+			Method:     session.Method,
+			LastSeenAt: session.LastSeenAt,
 		})
 	}
 
@@ -316,7 +703,24 @@ func (s *SessionSvc) Delete(ctx context.Context, login, sessionID string) error
 		return fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
-	if err := s.storage.Delete(ctx, u.Login, sessionID); err != nil {
+	// Сессия другого пользователя не удаляется.
+	// Без карточки сессия недействительна, и ее остатки удаляются из списка сессий пользователя
+	cart, err := s.storage.Get(ctx, sessionID)
+
+	switch {
+	case errors.Is(err, reposessions.ErrSessionCartNotFound):
+	case err != nil:
+		return fmt.Errorf("failed to get session cart | %s:%w", op, err)
+	case !strings.EqualFold(cart.Login, u.Login):
+		s.logger.Warn("attempt to delete session of another user",
+			zap.String("login", login),
+			zap.String("session_id", sessionID),
+		)
+
+		return fmt.Errorf("failed to delete session | %s:%w", op, ErrSessionNotFound)
+	}
+
+	if err := s.revoke(ctx, u.Login, sessionID); err != nil {
 		s.logger.Error("failed to delete session",
 			zap.String("login", login),
 			zap.String("session_id", sessionID),
@@ -329,7 +733,18 @@ func (s *SessionSvc) Delete(ctx context.Context, login, sessionID string) error
 	return nil
 }
 
-func (s *SessionSvc) generateTokens(_ context.Context, sessionID string) (*Tokens, error) {
+// Удаление сессии из хранилища и локального кеша
+func (s *SessionSvc) revoke(ctx context.Context, login, sessionID string) error {
+	if err := s.storage.Delete(ctx, login, sessionID); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.cacheByID.Del(ctx, sessionID)
+
+	return nil
+}
+
+func (s *SessionSvc) generateTokens(_ context.Context, login, sessionID string) (*Tokens, error) {
 	const op = "SessionSvc.generateTokens"
 
 	if s.refreshTokenTTL < s.accessTokenTTL {
@@ -337,10 +752,11 @@ func (s *SessionSvc) generateTokens(_ context.Context, sessionID string) (*Token
 	}
 
 	var (
-		accessToken  []byte
-		refreshToken []byte
-		signingKey   = []byte(s.signingKey)
-		current      = time.Now()
+		accessToken    []byte
+		refreshToken   []byte
+		refreshTokenID = uuid.NewString()
+		signingKey     = s.keyRing.Active()
+		current        = time.Now()
 	)
 
 	g := errgroup.Group{}
@@ -349,6 +765,8 @@ func (s *SessionSvc) generateTokens(_ context.Context, sessionID string) (*Token
 		var err error
 
 		accessToken, err = authidjwt.NewAccessToken(signingKey, &authidjwt.TokenOpts{
+			ID:        uuid.NewString(),
+			Subject:   login,
 			SessionID: sessionID,
 			ExpiredAt: current.Add(s.accessTokenTTL),
 		})
@@ -363,6 +781,8 @@ func (s *SessionSvc) generateTokens(_ context.Context, sessionID string) (*Token
 		var err error
 
 		refreshToken, err = authidjwt.NewRefreshToken(signingKey, &authidjwt.TokenOpts{
+			ID:        refreshTokenID,
+			Subject:   login,
 			SessionID: sessionID,
 			ExpiredAt: current.Add(s.refreshTokenTTL),
 		})
@@ -378,8 +798,9 @@ func (s *SessionSvc) generateTokens(_ context.Context, sessionID string) (*Token
 	}
 
 	return &Tokens{
-		AccessToken:  string(accessToken),
-		RefreshToken: string(refreshToken),
+		AccessToken:    string(accessToken),
+		RefreshToken:   string(refreshToken),
+		refreshTokenID: refreshTokenID,
 	}, nil
 }
 
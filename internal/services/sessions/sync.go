@@ -0,0 +1,107 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	reposessions "github.com/vtievsky/auth-id/internal/repositories/sessions/sessions"
+	"go.uber.org/zap"
+)
+
+// Отзыв всех сессий пользователя, например, при его блокировке или смене пароля.
+// Сессия keepSessionID сохраняется, пусто - отзываются все сессии
+func (s *SessionSvc) RevokeUserSessions(ctx context.Context, login, keepSessionID string) error {
+	const op = "SessionSvc.RevokeUserSessions"
+
+	sessionIDs, err := s.storage.DeleteUserSessions(ctx, login, keepSessionID)
+
+	// Удаленные до ошибки сессии исключаются из кеша в любом случае
+	for _, sessionID := range sessionIDs {
+		s.cacheByID.Del(ctx, sessionID)
+	}
+
+	if err != nil {
+		s.logger.Error("failed to revoke user sessions",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to revoke user sessions | %s:%w", op, err)
+	}
+
+	s.logger.Debug("user sessions has been revoked",
+		zap.String("login", login),
+		zap.Int("num", len(sessionIDs)),
+		zap.Bool("keep_current", keepSessionID != ""),
+	)
+
+	return nil
+}
+
+// Пересчет привилегий действующих сессий пользователя после изменения его ролей.
+// Сессии пользователя, лишившегося всех привилегий, отзываются
+func (s *SessionSvc) SyncUserSessions(ctx context.Context, login string) error {
+	const op = "SessionSvc.SyncUserSessions"
+
+	sessionIDs, err := s.storage.ListSessionIDs(ctx, login)
+	if err != nil {
+		s.logger.Error("failed to get user sessions",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to get user sessions | %s:%w", op, err)
+	}
+
+	if len(sessionIDs) < 1 {
+		return nil
+	}
+
+	privileges, syncAt, err := s.userPrivilegeSvc.GetUserPrivilegesAt(ctx, login, time.Now())
+	if err != nil {
+		s.logger.Error("failed to fetch user privileges",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to fetch user privileges | %s:%w", op, err)
+	}
+
+	if len(privileges) < 1 {
+		return s.RevokeUserSessions(ctx, login, "")
+	}
+
+	sessionPrivileges := make([]string, 0, len(privileges))
+
+	for _, privilege := range privileges {
+		sessionPrivileges = append(sessionPrivileges, privilege.Code)
+	}
+
+	for _, sessionID := range sessionIDs {
+		err = s.storage.ReplaceSessionPrivileges(ctx, sessionID, sessionPrivileges, syncAt)
+
+		switch {
+		case errors.Is(err, reposessions.ErrSessionCartNotFound):
+			// Сессия истекла, но еще числится в списке сессий пользователя
+		case err != nil:
+			s.logger.Error("failed to replace session privileges",
+				zap.String("login", login),
+				zap.String("session_id", sessionID),
+				zap.Error(err),
+			)
+
+			return fmt.Errorf("failed to replace session privileges | %s:%w", op, err)
+		}
+	}
+
+	s.cacheByID.Del(ctx, sessionIDs...)
+
+	s.logger.Debug("user sessions has been synchronized",
+		zap.String("login", login),
+		zap.Int("num", len(sessionIDs)),
+	)
+
+	return nil
+}
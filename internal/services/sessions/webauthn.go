@@ -0,0 +1,166 @@
+package sessionsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	repochallenges "github.com/vtievsky/auth-id/internal/repositories/sessions/challenges"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+type WebAuthnSvc interface {
+	HasCredentials(ctx context.Context, login string) (bool, error)
+	BeginAssertion(ctx context.Context, login, mfaChallenge string) (*webauthnsvc.AssertionStart, error)
+	FinishAssertion(ctx context.Context, ceremonyID string, assertion *webauthn.Assertion) (*webauthnsvc.AssertionResult, error)
+}
+
+// Начало входа ключом WebAuthn: без пароля или для подтверждения входа по паролю
+func (s *SessionSvc) BeginWebAuthnLogin(ctx context.Context, login, mfaChallenge string) (*webauthnsvc.AssertionStart, error) {
+	const op = "SessionSvc.BeginWebAuthnLogin"
+
+	if mfaChallenge != "" {
+		challenge, err := s.challenges.Get(ctx, mfaChallenge)
+
+		switch {
+		case errors.Is(err, repochallenges.ErrChallengeNotFound):
+			return nil, fmt.Errorf("failed to get mfa challenge | %s:%w", op, ErrMFAChallengeInvalid)
+		case err != nil:
+			return nil, fmt.Errorf("failed to get mfa challenge | %s:%w", op, err)
+		case challenge.Kind != repochallenges.KindMFA || !strings.EqualFold(challenge.Login, login):
+			return nil, fmt.Errorf("failed to get mfa challenge | %s:%w", op, ErrMFAChallengeInvalid)
+		}
+	}
+
+	start, err := s.webAuthnSvc.BeginAssertion(ctx, login, mfaChallenge)
+	if err != nil {
+		s.logger.Error("failed to begin webauthn assertion",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to begin webauthn assertion | %s:%w", op, err)
+	}
+
+	return start, nil
+}
+
+// Завершение входа ключом WebAuthn
+func (s *SessionSvc) LoginWebAuthn(ctx context.Context, ceremonyID string, assertion *webauthn.Assertion) (*Tokens, error) {
+	const op = "SessionSvc.LoginWebAuthn"
+
+	ctx, span := tracer.Start(ctx, "login_webauthn")
+	defer span.End()
+
+	span.AddEvent("start")
+
+	result, err := s.webAuthnSvc.FinishAssertion(ctx, ceremonyID, assertion)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindInvalidAssertion)
+
+		// Неверная подпись учитывается наравне с неверным паролем
+		var assertionErr *webauthnsvc.AssertionError
+
+		if errors.As(err, &assertionErr) {
+			u, _ := s.userSvc.GetUser(ctx, assertionErr.Login)
+
+			s.countFailure(ctx, assertionErr.Login, u)
+		}
+
+		s.logger.Error("failed to finish webauthn assertion",
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to finish webauthn assertion | %s:%w", op, err)
+	}
+
+	login := result.Login
+
+	span.AddEvent("assertion has been verified")
+
+	lockout, err := s.checkLockout(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		s.logger.Error("failed to check lockout",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to check lockout | %s:%w", op, err)
+	}
+
+	// Незавершенный вход по паролю одноразовый, как и при подтверждении кодом
+	if result.MFAChallenge != "" {
+		deleted, err := s.challenges.Delete(ctx, result.MFAChallenge)
+		if err == nil && !deleted {
+			err = ErrMFAChallengeInvalid
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			incrLoginFail(ctx, MetricKindInvalidChallenge)
+
+			s.releaseLockout(ctx, login, lockout)
+
+			s.logger.Error("failed to delete mfa challenge",
+				zap.String("login", login),
+				zap.Error(err),
+			)
+
+			return nil, fmt.Errorf("failed to delete mfa challenge | %s:%w", op, err)
+		}
+	}
+
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindFailedGetUser)
+
+		s.releaseLockout(ctx, login, lockout)
+
+		s.logger.Error("failed to get user",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	s.resetLockout(ctx, login)
+
+	if u.Blocked {
+		err = ErrUserBlocked
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		incrLoginFail(ctx, MetricKindUserBlocked)
+
+		s.logger.Error("user blocked",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("user blocked | %s:%w", op, err)
+	}
+
+	tokens, err := s.openSession(ctx, u.Login, LoginMethodWebAuthn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session | %s:%w", op, err)
+	}
+
+	return tokens, nil
+}
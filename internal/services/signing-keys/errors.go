@@ -0,0 +1,8 @@
+package signingkeysvc
+
+import "errors"
+
+var (
+	ErrEncryptionNotConfigured = errors.New("signing key encryption key not configured")
+	ErrKeySealInvalid          = errors.New("signing key seal invalid")
+)
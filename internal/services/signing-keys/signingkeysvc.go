@@ -0,0 +1,413 @@
+package signingkeysvc
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	reposigningkeys "github.com/vtievsky/auth-id/internal/repositories/sessions/signingkeys"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Active    bool
+}
+
+type Storage interface {
+	GetActive(ctx context.Context) (string, error)
+	SetActive(ctx context.Context, kid string) error
+	GetKeys(ctx context.Context) (map[string][]byte, error)
+	AddKey(ctx context.Context, kid string, key []byte) error
+	DeleteKey(ctx context.Context, kid string) error
+	RetireKey(ctx context.Context, kid string, at time.Time) error
+	GetRetired(ctx context.Context) (map[string]time.Time, error)
+}
+
+type SigningKeySvcOpts struct {
+	Logger       *zap.Logger
+	Storage      Storage
+	KeyRing      *authidjwt.KeyRing
+	Cipher       cipher.AEAD // Шифрование закрытых ключей в хранилище, nil - создание ключей недоступно
+	SyncInterval time.Duration
+	Retention    time.Duration // Срок хранения выведенного ключа, не меньше времени жизни refresh-токена
+}
+
+type SigningKeySvc struct {
+	logger       *zap.Logger
+	storage      Storage
+	keyRing      *authidjwt.KeyRing
+	cipher       cipher.AEAD
+	syncInterval time.Duration
+	retention    time.Duration
+
+	mu     sync.Mutex
+	stored map[string]struct{} // Ключи связки, загруженные из хранилища
+}
+
+func New(opts *SigningKeySvcOpts) *SigningKeySvc {
+	return &SigningKeySvc{
+		logger:       opts.Logger,
+		storage:      opts.Storage,
+		keyRing:      opts.KeyRing,
+		cipher:       opts.Cipher,
+		syncInterval: opts.SyncInterval,
+		retention:    opts.Retention,
+		mu:           sync.Mutex{},
+		stored:       map[string]struct{}{},
+	}
+}
+
+func (s *SigningKeySvc) GetSigningKeys(_ context.Context) ([]*SigningKey, error) {
+	var (
+		keys   = s.keyRing.Keys()
+		active = s.keyRing.Active()
+	)
+
+	resp := make([]*SigningKey, 0, len(keys))
+
+	for _, key := range keys {
+		resp = append(resp, &SigningKey{
+			ID:        key.ID,
+			Algorithm: key.Method.Alg(),
+			Active:    key == active,
+		})
+	}
+
+	return resp, nil
+}
+
+// Открытые ключи для проверки токенов на стороне других сервисов
+func (s *SigningKeySvc) JWKS(_ context.Context) authidjwt.JWKSet {
+	return authidjwt.NewJWKSet(s.keyRing.Keys()...)
+}
+
+// Ключи для проверки токенов, включая выведенные из подписи
+func (s *SigningKeySvc) KeySet() authidjwt.KeySet {
+	return s.keyRing
+}
+
+// Назначение действующего ключа подписи. Ключ должен быть заранее
+// загружен всеми экземплярами приложения, которые подхватят его при синхронизации
+func (s *SigningKeySvc) Rotate(ctx context.Context, kid string) error {
+	const op = "SigningKeySvc.Rotate"
+
+	if _, err := s.keyRing.Lookup(kid); err != nil {
+		s.logger.Error("failed to find signing key",
+			zap.String("kid", kid),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to find signing key | %s:%w", op, err)
+	}
+
+	previous := s.keyRing.Active().ID
+
+	if err := s.storage.SetActive(ctx, kid); err != nil {
+		s.logger.Error("failed to store active signing key",
+			zap.String("kid", kid),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to store active signing key | %s:%w", op, err)
+	}
+
+	if err := s.keyRing.Promote(kid); err != nil {
+		return fmt.Errorf("failed to promote signing key | %s:%w", op, err)
+	}
+
+	// Без отметки ключ не будет удален, но ротация уже выполнена
+	if previous != kid {
+		if err := s.storage.RetireKey(ctx, previous, time.Now()); err != nil {
+			s.logger.Error("failed to retire signing key",
+				zap.String("kid", previous),
+				zap.Error(err),
+			)
+		}
+	}
+
+	s.logger.Info("signing key has been rotated",
+		zap.String("kid", kid),
+	)
+
+	return nil
+}
+
+// Создание нового ключа подписи и назначение его действующим. Закрытый ключ
+// сохраняется в хранилище, откуда его загружают остальные экземпляры приложения
+func (s *SigningKeySvc) Generate(ctx context.Context) (*SigningKey, error) {
+	const op = "SigningKeySvc.Generate"
+
+	if s.cipher == nil {
+		return nil, fmt.Errorf("failed to generate signing key | %s:%w", op, ErrEncryptionNotConfigured)
+	}
+
+	key, err := authidjwt.GenerateKey()
+	if err != nil {
+		s.logger.Error("failed to generate signing key",
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to generate signing key | %s:%w", op, err)
+	}
+
+	encoded, err := key.MarshalPrivateKeyPEM()
+	if err != nil {
+		s.logger.Error("failed to marshal signing key",
+			zap.String("kid", key.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to marshal signing key | %s:%w", op, err)
+	}
+
+	sealed, err := s.seal(key.ID, encoded)
+	if err != nil {
+		s.logger.Error("failed to seal signing key",
+			zap.String("kid", key.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to seal signing key | %s:%w", op, err)
+	}
+
+	// Ключ сохраняется до назначения, чтобы экземпляры, получившие новый
+	// идентификатор действующего ключа, могли его загрузить
+	if err = s.storage.AddKey(ctx, key.ID, sealed); err != nil {
+		s.logger.Error("failed to store signing key",
+			zap.String("kid", key.ID),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to store signing key | %s:%w", op, err)
+	}
+
+	s.keyRing.Add(key)
+	s.markStored(key.ID)
+
+	if err = s.Rotate(ctx, key.ID); err != nil {
+		return nil, fmt.Errorf("failed to rotate signing key | %s:%w", op, err)
+	}
+
+	return &SigningKey{
+		ID:        key.ID,
+		Algorithm: key.Method.Alg(),
+		Active:    true,
+	}, nil
+}
+
+// Синхронизация ключей и действующего ключа с другими экземплярами приложения
+func (s *SigningKeySvc) Sync(ctx context.Context) error {
+	const op = "SigningKeySvc.Sync"
+
+	keys, err := s.load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing keys | %s:%w", op, err)
+	}
+
+	if err = s.activate(ctx); err != nil {
+		return fmt.Errorf("failed to activate signing key | %s:%w", op, err)
+	}
+
+	// Удаление выполняется после назначения, так как действующий ключ из связки не удаляется
+	s.forget(keys)
+
+	if err = s.prune(ctx); err != nil {
+		return fmt.Errorf("failed to prune signing keys | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Назначение ключа, действующего у других экземпляров приложения
+func (s *SigningKeySvc) activate(ctx context.Context) error {
+	kid, err := s.storage.GetActive(ctx)
+
+	switch {
+	case errors.Is(err, reposigningkeys.ErrActiveKeyNotFound):
+		// Ротация еще не выполнялась, используется ключ из конфигурации
+		return nil
+	case err != nil:
+		return err //nolint:wrapcheck
+	}
+
+	if s.keyRing.Active().ID == kid {
+		return nil
+	}
+
+	if err = s.keyRing.Promote(kid); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.logger.Info("signing key has been synchronized",
+		zap.String("kid", kid),
+	)
+
+	return nil
+}
+
+// Загрузка ключей, созданных другими экземплярами приложения, возвращает все ключи хранилища
+func (s *SigningKeySvc) load(ctx context.Context) (map[string][]byte, error) {
+	keys, err := s.storage.GetKeys(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var (
+		key     *authidjwt.Key
+		encoded []byte
+	)
+
+	for kid, sealed := range keys {
+		if _, err = s.keyRing.Lookup(kid); err == nil {
+			continue
+		}
+
+		encoded, err = s.open(kid, sealed)
+		if err != nil {
+			s.logger.Error("failed to open signing key",
+				zap.String("kid", kid),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		key, err = authidjwt.ParsePrivateKeyPEM(kid, encoded)
+		if err != nil {
+			s.logger.Error("failed to parse signing key",
+				zap.String("kid", kid),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		if s.keyRing.Add(key) {
+			s.logger.Info("signing key has been loaded",
+				zap.String("kid", kid),
+			)
+		}
+
+		s.markStored(kid)
+	}
+
+	return keys, nil
+}
+
+// Удаление ключей, выведенных из оборота раньше срока хранения:
+// подписанные ими токены к этому времени истекли
+func (s *SigningKeySvc) prune(ctx context.Context) error {
+	retired, err := s.storage.GetRetired(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	for kid, at := range retired {
+		if time.Since(at) < s.retention || s.keyRing.Active().ID == kid {
+			continue
+		}
+
+		if err = s.storage.DeleteKey(ctx, kid); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		s.keyRing.Remove(kid)
+
+		s.mu.Lock()
+		delete(s.stored, kid)
+		s.mu.Unlock()
+
+		s.logger.Info("retired signing key has been deleted",
+			zap.String("kid", kid),
+		)
+	}
+
+	return nil
+}
+
+func (s *SigningKeySvc) markStored(kid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stored[kid] = struct{}{}
+}
+
+// Удаление из связки ранее загруженных ключей, которые удалены из хранилища другим экземпляром
+func (s *SigningKeySvc) forget(keys map[string][]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for kid := range s.stored {
+		if _, ok := keys[kid]; ok {
+			continue
+		}
+
+		if s.keyRing.Remove(kid) {
+			s.logger.Info("signing key has been unloaded",
+				zap.String("kid", kid),
+			)
+		}
+
+		delete(s.stored, kid)
+	}
+}
+
+// Шифрование закрытого ключа с привязкой к идентификатору: nonce || ciphertext в base64
+func (s *SigningKeySvc) seal(kid string, key []byte) ([]byte, error) {
+	nonce := make([]byte, s.cipher.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	sealed := s.cipher.Seal(nonce, nonce, key, []byte(kid))
+
+	return base64.StdEncoding.AppendEncode(nil, sealed), nil
+}
+
+func (s *SigningKeySvc) open(kid string, value []byte) ([]byte, error) {
+	if s.cipher == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+
+	sealed, err := base64.StdEncoding.AppendDecode(nil, value)
+	if err != nil || len(sealed) < s.cipher.NonceSize() {
+		return nil, ErrKeySealInvalid
+	}
+
+	nonceSize := s.cipher.NonceSize()
+
+	key, err := s.cipher.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(kid))
+	if err != nil {
+		return nil, ErrKeySealInvalid
+	}
+
+	return key, nil
+}
+
+func (s *SigningKeySvc) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.syncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.logger.Error("failed to sync signing key",
+				zap.Error(err),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
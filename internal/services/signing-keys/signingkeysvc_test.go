@@ -0,0 +1,157 @@
+package signingkeysvc_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	clientredis "github.com/vtievsky/auth-id/internal/repositories/sessions/client/redis"
+	reposigningkeys "github.com/vtievsky/auth-id/internal/repositories/sessions/signingkeys"
+	signingkeysvc "github.com/vtievsky/auth-id/internal/services/signing-keys"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const configKeyID = "config"
+
+func newCipher(t *testing.T, secret byte) cipher.AEAD {
+	t.Helper()
+
+	block, err := aes.NewCipher([]byte(strings.Repeat(string(secret), 32)))
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+
+	return aead
+}
+
+// Экземпляр приложения со своей связкой ключей и общим хранилищем
+func newInstance(t *testing.T, server *miniredis.Miniredis, aead cipher.AEAD, retention time.Duration) *signingkeysvc.SigningKeySvc {
+	t.Helper()
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()}) //nolint:exhaustruct
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return signingkeysvc.New(&signingkeysvc.SigningKeySvcOpts{
+		Logger: zap.NewNop(),
+		Storage: reposigningkeys.New(&reposigningkeys.SigningKeysOpts{
+			Client: &clientredis.Client{UniversalClient: client},
+		}),
+		KeyRing:      authidjwt.NewKeyRing(authidjwt.NewHMACKey(configKeyID, []byte("secret"))),
+		Cipher:       aead,
+		SyncInterval: time.Second,
+		Retention:    retention,
+	})
+}
+
+func hasKey(t *testing.T, svc *signingkeysvc.SigningKeySvc, kid string) bool {
+	t.Helper()
+
+	_, err := svc.KeySet().Lookup(kid)
+
+	return err == nil
+}
+
+// Закрытый ключ хранится зашифрованным и загружается другим экземпляром с тем же ключом шифрования
+func TestGenerateSealsKey(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	aead := newCipher(t, 'k')
+
+	first := newInstance(t, server, aead, time.Hour)
+	second := newInstance(t, server, aead, time.Hour)
+
+	key, err := first.Generate(ctx)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	stored := server.HGet("jwk:keys", key.ID)
+	if stored == "" || strings.Contains(stored, "PRIVATE KEY") {
+		t.Fatalf("private key stored in plain text: %q", stored)
+	}
+
+	if err = second.Sync(ctx); err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	if !hasKey(t, second, key.ID) {
+		t.Fatal("generated key was not loaded")
+	}
+
+	// Экземпляр с другим ключом шифрования не может загрузить ключ
+	other := newInstance(t, server, newCipher(t, 'x'), time.Hour)
+
+	if err = other.Sync(ctx); err == nil || hasKey(t, other, key.ID) {
+		t.Fatalf("key sealed with another cipher must not be loaded, err %v", err)
+	}
+}
+
+func TestGenerateWithoutCipher(t *testing.T) {
+	svc := newInstance(t, miniredis.RunT(t), nil, time.Hour)
+
+	if _, err := svc.Generate(context.Background()); !errors.Is(err, signingkeysvc.ErrEncryptionNotConfigured) {
+		t.Fatalf("expected ErrEncryptionNotConfigured, got %v", err)
+	}
+}
+
+// Выведенный ключ удаляется из хранилища и связок всех экземпляров после срока хранения
+func TestRetiredKeyPruned(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	aead := newCipher(t, 'k')
+
+	first := newInstance(t, server, aead, 0)
+	second := newInstance(t, server, aead, time.Hour)
+
+	retired, err := first.Generate(ctx)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if err = second.Sync(ctx); err != nil || !hasKey(t, second, retired.ID) {
+		t.Fatalf("failed to load generated key: %v", err)
+	}
+
+	active, err := first.Generate(ctx)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if err = first.Sync(ctx); err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	if server.HGet("jwk:keys", retired.ID) != "" || hasKey(t, first, retired.ID) {
+		t.Fatal("retired key was not pruned")
+	}
+
+	// Ключ конфигурации тоже выведен при первой ротации
+	if hasKey(t, first, configKeyID) {
+		t.Fatal("retired configuration key was not pruned")
+	}
+
+	if err = second.Sync(ctx); err != nil {
+		t.Fatalf("failed to sync: %v", err)
+	}
+
+	if hasKey(t, second, retired.ID) {
+		t.Fatal("pruned key was not unloaded by another instance")
+	}
+
+	if !hasKey(t, first, active.ID) || !hasKey(t, second, active.ID) {
+		t.Fatal("active key must stay loaded")
+	}
+}
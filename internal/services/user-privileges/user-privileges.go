@@ -61,7 +61,25 @@ func New(opts *UserPrivilegeSvcOpts) *UserPrivilegeSvc {
 	}
 }
 
+// Действующие на текущий момент привилегии пользователя
 func (s *UserPrivilegeSvc) GetUserPrivileges(ctx context.Context, login string, pageSize, offset uint32) ([]*UserPrivilege, error) {
+	privileges, _, err := s.getUserPrivileges(ctx, login, time.Now(), pageSize, offset)
+
+	return privileges, err
+}
+
+// Привилегии пользователя на момент at и ближайший момент изменения их состава
+// (начало или окончание действия назначения роли). Нулевое время - изменений не ожидается
+func (s *UserPrivilegeSvc) GetUserPrivilegesAt(ctx context.Context, login string, at time.Time) ([]*UserPrivilege, time.Time, error) {
+	return s.getUserPrivileges(ctx, login, at, math.MaxUint32, 0)
+}
+
+func (s *UserPrivilegeSvc) getUserPrivileges(
+	ctx context.Context,
+	login string,
+	at time.Time,
+	pageSize, offset uint32,
+) ([]*UserPrivilege, time.Time, error) {
 	const op = "UserPrivilegeSvc.GetUserPrivileges"
 
 	fetchRolePrivileges := func(actx context.Context, acombine chan<- userPrivilegeStats,
@@ -94,7 +112,36 @@ func (s *UserPrivilegeSvc) GetUserPrivileges(ctx context.Context, login string,
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to get user roles | %s:%w", op, err)
+		return nil, time.Time{}, fmt.Errorf("failed to get user roles | %s:%w", op, err)
+	}
+
+	var nextChangeAt time.Time
+
+	nextChange := func(t time.Time) {
+		if nextChangeAt.IsZero() || t.Before(nextChangeAt) {
+			nextChangeAt = t
+		}
+	}
+
+	activeRoles := make([]*userrolesvc.UserRole, 0, len(userRoles))
+
+	for _, role := range userRoles {
+		// Заблокированная роль не предоставляет привилегий
+		if role.Blocked {
+			continue
+		}
+
+		switch {
+		case at.Before(role.DateIn):
+			// Назначение еще не вступило в силу
+			nextChange(role.DateIn)
+		case role.DateOut.IsZero():
+			activeRoles = append(activeRoles, role)
+		case at.Before(role.DateOut):
+			activeRoles = append(activeRoles, role)
+
+			nextChange(role.DateOut)
+		}
 	}
 
 	combineStats := make(chan userPrivilegeStats)
@@ -103,7 +150,7 @@ func (s *UserPrivilegeSvc) GetUserPrivileges(ctx context.Context, login string,
 	g.SetLimit(threadsLimit + 1)
 
 	g.Go(func() error {
-		for _, role := range userRoles {
+		for _, role := range activeRoles {
 			g.Go(fetchRolePrivileges(gCtx, combineStats, role.Code, role.DateIn, role.DateOut))
 		}
 
@@ -151,7 +198,7 @@ func (s *UserPrivilegeSvc) GetUserPrivileges(ctx context.Context, login string,
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("%s:%w", op, err)
+		return nil, time.Time{}, fmt.Errorf("%s:%w", op, err)
 	}
 
 	resp := make([]*UserPrivilege, 0)
@@ -170,5 +217,5 @@ func (s *UserPrivilegeSvc) GetUserPrivileges(ctx context.Context, login string,
 		})
 	}
 
-	return resp, nil
+	return resp, nextChangeAt, nil
 }
@@ -2,9 +2,11 @@ package userrolesvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
 	"github.com/vtievsky/auth-id/internal/repositories/models"
 	rolesvc "github.com/vtievsky/auth-id/internal/services/roles"
 	"go.uber.org/zap"
@@ -14,6 +16,7 @@ type UserRole struct {
 	Code        string
 	Name        string
 	Description string
+	Blocked     bool
 	DateIn      time.Time
 	DateOut     time.Time
 }
@@ -66,6 +69,11 @@ func (s *UserRoleSvc) GetUserRoles(ctx context.Context, login string, pageSize,
 
 	for _, role := range ul {
 		p, err = s.roleSvc.GetRoleByID(ctx, role.RoleID)
+		if errors.Is(err, dberrors.ErrRoleNotFound) {
+			// Назначение удаленной роли не предоставляет привилегий
+			continue
+		}
+
 		if err != nil {
 			s.logger.Error("failed to parse role",
 				zap.String("login", login),
@@ -80,6 +88,7 @@ func (s *UserRoleSvc) GetUserRoles(ctx context.Context, login string, pageSize,
 			Code:        p.Code,
 			Name:        p.Name,
 			Description: p.Description,
+			Blocked:     p.Blocked,
 			DateIn:      role.DateIn,
 			DateOut:     role.DateOut,
 		})
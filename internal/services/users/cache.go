@@ -2,20 +2,25 @@ package usersvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 
 	dberrors "github.com/vtievsky/auth-id/internal/repositories"
 	"github.com/vtievsky/auth-id/internal/repositories/models"
+	"github.com/vtievsky/auth-id/pkg/cache"
 	"go.uber.org/zap"
 )
 
 func (s *UserSvc) GetUserByID(ctx context.Context, id uint64) (*User, error) {
 	const op = "UserSvc.GetUserByID"
 
-	val, err := s.cacheByID.Get(ctx, id, s.syncUsersByID)
+	val, err := s.cacheByID.Get(ctx, id, s.loadUserByID)
 	if err != nil {
-		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrUserNotFound, err)
+		if errors.Is(err, cache.ErrValueNotFound) {
+			return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrUserNotFound, err)
+		}
+
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
 	return &User{
@@ -24,19 +29,25 @@ func (s *UserSvc) GetUserByID(ctx context.Context, id uint64) (*User, error) {
 		Login:    val.Login,
 		Password: val.Password,
 		Blocked:  val.Blocked,
+		Email:    val.Email,
 	}, nil
 }
 
 func (s *UserSvc) GetUserByLogin(ctx context.Context, login string) (*User, error) {
 	const op = "UserSvc.GetUserByLogin"
 
-	val, err := s.cacheByLogin.Get(ctx, login, s.syncUsersByLogin)
+	val, err := s.cacheByLogin.Get(ctx, login, s.loadUserByLogin)
 	if err != nil {
+		// Недоступность хранилища не выдается за отсутствие пользователя
+		if errors.Is(err, cache.ErrValueNotFound) {
+			return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrUserNotFound, err)
+		}
+
 		s.logger.Error("failed to get user",
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("%s:%w | %v", op, dberrors.ErrUserNotFound, err)
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
 	return &User{
@@ -45,55 +56,52 @@ func (s *UserSvc) GetUserByLogin(ctx context.Context, login string) (*User, erro
 		Login:    val.Login,
 		Password: val.Password,
 		Blocked:  val.Blocked,
+		Email:    val.Email,
 	}, nil
 }
 
-func (s *UserSvc) syncUsersByID(ctx context.Context) (map[uint64]*models.User, error) {
-	const op = "UserSvc.syncUsersByID"
+// Загрузка одного пользователя по идентификатору, отсутствие пользователя кешируется
+func (s *UserSvc) loadUserByID(ctx context.Context, id uint64) (map[uint64]*models.User, error) {
+	const op = "UserSvc.loadUserByID"
 
-	users, err := s.storage.GetUsers(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync users",
+	user, err := s.storage.GetUserByID(ctx, id)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserNotFound):
+		return map[uint64]*models.User{}, nil
+	case err != nil:
+		s.logger.Error("failed to load user",
+			zap.Uint64("user_id", id),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync users | %s:%w", op, err)
+		return nil, fmt.Errorf("failed to load user | %s:%w", op, err)
 	}
 
-	resp := make(map[uint64]*models.User, len(users))
-
-	for _, user := range users {
-		resp[user.ID] = user
-	}
-
-	s.logger.Debug("users has been synchronized",
-		zap.Int("num", len(users)),
-	)
-
-	return resp, nil
+	return map[uint64]*models.User{
+		user.ID: user,
+	}, nil
 }
 
-func (s *UserSvc) syncUsersByLogin(ctx context.Context) (map[string]*models.User, error) {
-	const op = "UserSvc.syncUsersByLogin"
+// Загрузка одного пользователя по логину, отсутствие пользователя кешируется
+func (s *UserSvc) loadUserByLogin(ctx context.Context, login string) (map[string]*models.User, error) {
+	const op = "UserSvc.loadUserByLogin"
 
-	users, err := s.storage.GetUsers(ctx, math.MaxUint32, 0)
-	if err != nil {
-		s.logger.Error("failed to sync users",
+	user, err := s.storage.GetUser(ctx, login)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserNotFound):
+		return map[string]*models.User{}, nil
+	case err != nil:
+		s.logger.Error("failed to load user",
+			zap.String("login", login),
 			zap.Error(err),
 		)
 
-		return nil, fmt.Errorf("failed to sync users | %s:%w", op, err)
-	}
-
-	resp := make(map[string]*models.User, len(users))
-
-	for _, user := range users {
-		resp[user.Login] = user
+		return nil, fmt.Errorf("failed to load user | %s:%w", op, err)
 	}
 
-	s.logger.Debug("users has been synchronized",
-		zap.Int("num", len(users)),
-	)
-
-	return resp, nil
+	return map[string]*models.User{
+		user.Login: user,
+	}, nil
 }
@@ -1,10 +1,36 @@
 package usersvc
 
-import "errors"
+import (
+	"errors"
+	"strings"
+
+	"github.com/vtievsky/auth-id/pkg/passpolicy"
+)
 
 var (
 	ErrInvalidName      = errors.New("invalid name error")
 	ErrInvalidLogin     = errors.New("invalid login error")
 	ErrInvalidPassword  = errors.New("invalid password error")
+	ErrInvalidEmail     = errors.New("invalid email error")
 	ErrGeneratePassword = errors.New("generate password error")
+	ErrPasswordPolicy   = errors.New("password policy violation")
 )
+
+// Пароль не соответствует правилам, перечень нарушений возвращается клиенту
+type PolicyError struct {
+	Violations []passpolicy.Violation
+}
+
+func (e *PolicyError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+
+	return ErrPasswordPolicy.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *PolicyError) Unwrap() error {
+	return ErrPasswordPolicy
+}
@@ -0,0 +1,224 @@
+package usersvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+	"github.com/vtievsky/auth-id/pkg/passpolicy"
+	"go.uber.org/zap"
+)
+
+// Правила выбора и смены паролей
+type PasswordPolicy struct {
+	Rules       passpolicy.Policy
+	HistorySize int           // Запрет повтора указанного количества последних паролей, текущий пароль запрещен всегда
+	MaxAge      time.Duration // Срок действия пароля, 0 - без ограничения
+}
+
+// Истек ли срок действия пароля. Пароль без времени смены не считается истекшим
+func (s *UserSvc) PasswordExpired(ctx context.Context, login string) (bool, error) {
+	const op = "UserSvc.PasswordExpired"
+
+	if s.policy.MaxAge <= 0 {
+		return false, nil
+	}
+
+	u, err := s.GetUserByLogin(ctx, login)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	password, err := s.storage.GetUserPassword(ctx, u.ID)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserPasswordNotFound):
+		return false, nil
+	case err != nil:
+		s.logger.Error("failed to get user password",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return false, fmt.Errorf("failed to get user password | %s:%w", op, err)
+	}
+
+	return time.Since(password.ChangedAt) > s.policy.MaxAge, nil
+}
+
+// Начало отсчета срока действия пароля для пользователей, созданных до ограничения срока.
+// Сохраненное время смены не изменяется
+func (s *UserSvc) StartPasswordAge(ctx context.Context, login string) error {
+	const op = "UserSvc.StartPasswordAge"
+
+	if s.policy.MaxAge <= 0 {
+		return nil
+	}
+
+	u, err := s.GetUserByLogin(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	_, err = s.storage.GetUserPassword(ctx, u.ID)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserPasswordNotFound):
+		s.rememberPassword(ctx, u, "")
+	case err != nil:
+		s.logger.Error("failed to get user password",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to get user password | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Проверка нового пароля пользователя по правилам без его смены
+func (s *UserSvc) CheckPassword(ctx context.Context, login, password string) error {
+	const op = "UserSvc.CheckPassword"
+
+	u, err := s.GetUserByLogin(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if err = s.checkPassword(ctx, u, u.Login, u.Name, password); err != nil {
+		return fmt.Errorf("failed to check password | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Проверка нового пароля по правилам. Пользователь равен nil при создании
+func (s *UserSvc) checkPassword(ctx context.Context, u *User, login, name, password string) error {
+	violations := s.policy.Rules.Check(password, login, name)
+
+	// Смена пароля на текущий не считается сменой при любом размере истории
+	if u != nil {
+		reused, err := s.passwordReused(ctx, u, password)
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			message := "password must differ from the current password"
+
+			if s.policy.HistorySize > 1 {
+				message = fmt.Sprintf("password must differ from the last %d passwords", s.policy.HistorySize)
+			}
+
+			violations = append(violations, passpolicy.Violation{
+				Code:    passpolicy.CodeReused,
+				Message: message,
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+func (s *UserSvc) passwordReused(ctx context.Context, u *User, password string) (bool, error) {
+	hashes := []string{u.Password}
+
+	if s.policy.HistorySize < 2 { //nolint:mnd
+		return s.ComparePassword([]byte(u.Password), []byte(password)) == nil, nil
+	}
+
+	history, err := s.storage.GetUserPassword(ctx, u.ID)
+
+	switch {
+	case errors.Is(err, dberrors.ErrUserPasswordNotFound):
+	case err != nil:
+		return false, err //nolint:wrapcheck
+	default:
+		hashes = append(hashes, history.History[:min(len(history.History), s.policy.HistorySize-1)]...)
+	}
+
+	for _, hash := range hashes {
+		if s.ComparePassword([]byte(hash), []byte(password)) == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Сохранение хеша прежнего пароля в истории и времени смены.
+// Пароль уже изменен, поэтому ошибка только журналируется
+func (s *UserSvc) rememberPassword(ctx context.Context, u *User, previous string) {
+	history := make([]string, 0, s.policy.HistorySize)
+
+	if previous != "" && s.policy.HistorySize > 1 {
+		history = append(history, previous)
+
+		password, err := s.storage.GetUserPassword(ctx, u.ID)
+		if err == nil {
+			history = append(history, password.History[:min(len(password.History), s.policy.HistorySize-2)]...) //nolint:mnd
+		}
+	}
+
+	if err := s.storage.SaveUserPassword(ctx, models.UserPassword{
+		UserID:    u.ID,
+		History:   history,
+		ChangedAt: time.Now(),
+	}); err != nil {
+		s.logger.Error("failed to save user password",
+			zap.String("login", u.Login),
+			zap.Error(err),
+		)
+	}
+}
+
+// Нужен ли пересчет хеша пароля с текущими алгоритмом, параметрами и перцем
+func (s *UserSvc) NeedsRehash(hash string) bool {
+	return s.hasher.NeedsRehash(hash)
+}
+
+// Пересчет хеша проверенного пароля без изменения истории и срока действия.
+// Хеш заменяется, только если пароль не был изменен после проверки
+func (s *UserSvc) RehashPassword(ctx context.Context, login, hash, password string) error {
+	const op = "UserSvc.RehashPassword"
+
+	u, err := s.storage.GetUser(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	if u.Password != hash {
+		return nil
+	}
+
+	rehashed, err := s.generateHashPassword([]byte(password))
+	if err != nil {
+		return fmt.Errorf("failed to generate hash password | %s:%w", op, err)
+	}
+
+	if _, err = s.updateUser(ctx, UserUpdatedWithPass{
+		Name:     u.Name,
+		Login:    u.Login,
+		Password: string(rehashed),
+		Blocked:  u.Blocked,
+		Email:    u.Email,
+	}); err != nil {
+		return fmt.Errorf("failed to update password | %s:%w", op, err)
+	}
+
+	// Удалим старые данные пользователя из кеша
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByLogin.Del(ctx, u.Login)
+
+	return nil
+}
@@ -2,13 +2,15 @@ package usersvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/mail"
 	"strings"
 
 	"github.com/vtievsky/auth-id/internal/repositories/models"
 	"github.com/vtievsky/auth-id/pkg/cache"
+	"github.com/vtievsky/auth-id/pkg/passhash"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type User struct {
@@ -17,6 +19,7 @@ type User struct {
 	Login    string
 	Password string
 	Blocked  bool
+	Email    string // Пусто, если адрес не указан
 }
 
 type UserCreated struct {
@@ -24,12 +27,14 @@ type UserCreated struct {
 	Login    string
 	Password string
 	Blocked  bool
+	Email    string
 }
 
 type UserUpdated struct {
 	Name    string
 	Login   string
 	Blocked bool
+	Email   *string // nil - адрес не изменяется
 }
 
 type UserUpdatedWithPass struct {
@@ -37,37 +42,78 @@ type UserUpdatedWithPass struct {
 	Login    string
 	Password string
 	Blocked  bool
+	Email    string
 }
 
 type Storage interface {
 	GetUser(ctx context.Context, login string) (*models.User, error)
+	GetUserByID(ctx context.Context, id uint64) (*models.User, error)
 	GetUsers(ctx context.Context, pageSize, offset uint32) ([]*models.User, error)
 	CreateUser(ctx context.Context, user models.UserCreated) (*models.User, error)
 	UpdateUser(ctx context.Context, user models.UserUpdated) (*models.User, error)
 	DeleteUser(ctx context.Context, login string) error
+	GetUserPassword(ctx context.Context, userID uint64) (*models.UserPassword, error)
+	SaveUserPassword(ctx context.Context, password models.UserPassword) error
+}
+
+// Распространение изменений пользователей на действующие сессии
+type Propagation interface {
+	UserPasswordChanged(ctx context.Context, login, keepSessionID string) error
+	UserBlocked(ctx context.Context, login string) error
+	UserDeleted(ctx context.Context, login string) error
 }
 
 type UserSvcOpts struct {
-	Logger  *zap.Logger
-	Storage Storage
+	Logger   *zap.Logger
+	Storage  Storage
+	CacheBus cache.Bus
+	Policy   PasswordPolicy
+	Hasher   *passhash.Hasher // По умолчанию bcrypt без перца
 }
 
 type UserSvc struct {
 	logger       *zap.Logger
 	storage      Storage
-	cacheByID    cache.Cache[uint64, *models.User]
-	cacheByLogin cache.Cache[string, *models.User]
+	policy       PasswordPolicy
+	hasher       *passhash.Hasher
+	propagation  Propagation
+	cacheByID    *cache.Cache[uint64, *models.User]
+	cacheByLogin *cache.Cache[string, *models.User]
 }
 
 func New(opts *UserSvcOpts) *UserSvc {
+	hasher := opts.Hasher
+
+	if hasher == nil {
+		hasher = passhash.New(&passhash.Opts{
+			Algorithm: passhash.DefaultBcrypt(),
+			PepperID:  "",
+			Peppers:   nil,
+		})
+	}
+
 	return &UserSvc{
-		logger:       opts.Logger,
-		storage:      opts.Storage,
-		cacheByID:    cache.New[uint64, *models.User](),
-		cacheByLogin: cache.New[string, *models.User](),
+		logger:  opts.Logger,
+		storage: opts.Storage,
+		policy:  opts.Policy,
+		hasher:  hasher,
+		cacheByID: cache.New[uint64, *models.User](&cache.Opts{
+			Name: "users:id",
+			Bus:  opts.CacheBus,
+		}),
+		cacheByLogin: cache.New[string, *models.User](&cache.Opts{
+			Name: "users:login",
+			Bus:  opts.CacheBus,
+		}),
 	}
 }
 
+// Подключение распространения изменений. Выполняется при запуске до обработки запросов,
+// так как сервис сессий, через который отзываются сессии, сам зависит от сервиса пользователей
+func (s *UserSvc) SetPropagation(propagation Propagation) {
+	s.propagation = propagation
+}
+
 func (s *UserSvc) GetUser(ctx context.Context, login string) (*User, error) {
 	return s.GetUserByLogin(ctx, login)
 }
@@ -93,6 +139,7 @@ func (s *UserSvc) GetUsers(ctx context.Context, pageSize, offset uint32) ([]*Use
 			Login:    user.Login,
 			Password: user.Password,
 			Blocked:  user.Blocked,
+			Email:    user.Email,
 		})
 	}
 
@@ -129,6 +176,26 @@ func (s *UserSvc) CreateUser(ctx context.Context, user UserCreated) (*User, erro
 		return nil, fmt.Errorf("failed to create user | %s:%w", op, ErrInvalidPassword)
 	}
 
+	user.Email = strings.TrimSpace(user.Email)
+
+	if !validEmail(user.Email) {
+		s.logger.Error("failed to create user",
+			zap.String("login", user.Login),
+			zap.Error(ErrInvalidEmail),
+		)
+
+		return nil, fmt.Errorf("failed to create user | %s:%w", op, ErrInvalidEmail)
+	}
+
+	if err := s.checkPassword(ctx, nil, user.Login, user.Name, user.Password); err != nil {
+		s.logger.Error("failed to create user",
+			zap.String("login", user.Login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to create user | %s:%w", op, err)
+	}
+
 	hash, err := s.generateHashPassword([]byte(user.Password))
 	if err != nil {
 		s.logger.Error("failed to create user",
@@ -144,6 +211,7 @@ func (s *UserSvc) CreateUser(ctx context.Context, user UserCreated) (*User, erro
 		Login:    user.Login,
 		Password: string(hash),
 		Blocked:  user.Blocked,
+		Email:    user.Email,
 	})
 	if err != nil {
 		s.logger.Error("failed to create user",
@@ -154,16 +222,21 @@ func (s *UserSvc) CreateUser(ctx context.Context, user UserCreated) (*User, erro
 		return nil, fmt.Errorf("failed to create user | %s:%w", op, err)
 	}
 
-	s.cacheByID.Add(u.ID, u)
-	s.cacheByLogin.Add(u.Login, u)
+	s.cacheByID.Add(ctx, u.ID, u)
+	s.cacheByLogin.Add(ctx, u.Login, u)
 
-	return &User{
+	userCreated := &User{
 		ID:       u.ID,
 		Name:     u.Name,
 		Login:    u.Login,
 		Password: u.Password,
 		Blocked:  u.Blocked,
-	}, nil
+		Email:    u.Email,
+	}
+
+	s.rememberPassword(ctx, userCreated, "")
+
+	return userCreated, nil
 }
 
 // При "обычном" изменении пользователя пароль не изменяется
@@ -180,11 +253,18 @@ func (s *UserSvc) UpdateUser(ctx context.Context, user UserUpdated) (*User, erro
 		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
+	email := u.Email
+
+	if user.Email != nil {
+		email = strings.TrimSpace(*user.Email)
+	}
+
 	userUpdated, err := s.updateUser(ctx, UserUpdatedWithPass{
 		Name:     user.Name,
 		Login:    user.Login,
 		Password: u.Password,
 		Blocked:  user.Blocked,
+		Email:    email,
 	})
 	if err != nil {
 		s.logger.Error("failed to update user",
@@ -195,11 +275,23 @@ func (s *UserSvc) UpdateUser(ctx context.Context, user UserUpdated) (*User, erro
 		return nil, fmt.Errorf("failed to update user | %s:%w", op, err)
 	}
 
+	// Удалим старые данные пользователя из кеша
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByLogin.Del(ctx, u.Login)
+
+	// Сессии заблокированного пользователя отзываются немедленно
+	if userUpdated.Blocked && s.propagation != nil {
+		if err = s.propagation.UserBlocked(ctx, userUpdated.Login); err != nil {
+			return nil, fmt.Errorf("failed to propagate user blocking | %s:%w", op, err)
+		}
+	}
+
 	return userUpdated, nil
 }
 
-// Смена текущего пароля пользователя
-func (s *UserSvc) ChangePass(ctx context.Context, login, current, changed string) error {
+// Смена текущего пароля пользователя. Сессии, открытые со старым паролем, отзываются,
+// кроме сессии keepSessionID, если она указана
+func (s *UserSvc) ChangePass(ctx context.Context, login, current, changed, keepSessionID string) error {
 	const op = "UserSvc.ChangePass"
 
 	u, err := s.GetUserByLogin(ctx, login)
@@ -222,6 +314,15 @@ func (s *UserSvc) ChangePass(ctx context.Context, login, current, changed string
 		return fmt.Errorf("failed to compare password | %s:%w", op, err)
 	}
 
+	if err = s.checkPassword(ctx, u, u.Login, u.Name, changed); err != nil {
+		s.logger.Error("failed to check password",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to check password | %s:%w", op, err)
+	}
+
 	hash, err := s.generateHashPassword([]byte(changed))
 	if err != nil {
 		s.logger.Error("failed to generate hash password",
@@ -237,6 +338,7 @@ func (s *UserSvc) ChangePass(ctx context.Context, login, current, changed string
 		Login:    u.Login,
 		Password: string(hash),
 		Blocked:  u.Blocked,
+		Email:    u.Email,
 	}); err != nil {
 		s.logger.Error("failed to update password",
 			zap.String("login", login),
@@ -247,14 +349,22 @@ func (s *UserSvc) ChangePass(ctx context.Context, login, current, changed string
 	}
 
 	// Удалим старые данные пользователя из кеша
-	s.cacheByID.Del(u.ID)
-	s.cacheByLogin.Del(u.Login)
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByLogin.Del(ctx, u.Login)
+
+	s.rememberPassword(ctx, u, u.Password)
+
+	if s.propagation != nil {
+		if err = s.propagation.UserPasswordChanged(ctx, u.Login, keepSessionID); err != nil {
+			return fmt.Errorf("failed to propagate password change | %s:%w", op, err)
+		}
+	}
 
 	return nil
 }
 
-// Сброс текущего пароля пользователя
-func (s *UserSvc) ResetPass(ctx context.Context, login, changed string) error {
+// Сброс текущего пароля пользователя с отзывом сессий, кроме сессии keepSessionID
+func (s *UserSvc) ResetPass(ctx context.Context, login, changed, keepSessionID string) error {
 	const op = "UserSvc.ResetPass"
 
 	u, err := s.GetUserByLogin(ctx, login)
@@ -267,6 +377,15 @@ func (s *UserSvc) ResetPass(ctx context.Context, login, changed string) error {
 		return fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
+	if err = s.checkPassword(ctx, u, u.Login, u.Name, changed); err != nil {
+		s.logger.Error("failed to check password",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to check password | %s:%w", op, err)
+	}
+
 	hash, err := s.generateHashPassword([]byte(changed))
 	if err != nil {
 		s.logger.Error("failed to generate hash password",
@@ -282,6 +401,7 @@ func (s *UserSvc) ResetPass(ctx context.Context, login, changed string) error {
 		Login:    u.Login,
 		Password: string(hash),
 		Blocked:  u.Blocked,
+		Email:    u.Email,
 	}); err != nil {
 		s.logger.Error("failed to update password",
 			zap.String("login", login),
@@ -292,8 +412,16 @@ func (s *UserSvc) ResetPass(ctx context.Context, login, changed string) error {
 	}
 
 	// Удалим старые данные пользователя из кеша
-	s.cacheByID.Del(u.ID)
-	s.cacheByLogin.Del(u.Login)
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByLogin.Del(ctx, u.Login)
+
+	s.rememberPassword(ctx, u, u.Password)
+
+	if s.propagation != nil {
+		if err = s.propagation.UserPasswordChanged(ctx, u.Login, keepSessionID); err != nil {
+			return fmt.Errorf("failed to propagate password change | %s:%w", op, err)
+		}
+	}
 
 	return nil
 }
@@ -311,7 +439,7 @@ func (s *UserSvc) DeleteUser(ctx context.Context, login string) error {
 		return fmt.Errorf("failed to get user | %s:%w", op, err)
 	}
 
-	if err := s.storage.DeleteUser(ctx, login); err != nil {
+	if err = s.storage.DeleteUser(ctx, login); err != nil {
 		s.logger.Error("failed to delete user",
 			zap.String("login", login),
 			zap.Error(err),
@@ -321,15 +449,27 @@ func (s *UserSvc) DeleteUser(ctx context.Context, login string) error {
 	}
 
 	// Удалим данные пользователя из кеша
-	s.cacheByID.Del(u.ID)
-	s.cacheByLogin.Del(u.Login)
+	s.cacheByID.Del(ctx, u.ID)
+	s.cacheByLogin.Del(ctx, u.Login)
+
+	// Сессии удаленного пользователя больше не могут быть продлены
+	if s.propagation != nil {
+		if err = s.propagation.UserDeleted(ctx, u.Login); err != nil {
+			return fmt.Errorf("failed to propagate user deletion | %s:%w", op, err)
+		}
+	}
 
 	return nil
 }
 
 func (s *UserSvc) ComparePassword(password, current []byte) error {
-	if err := bcrypt.CompareHashAndPassword(password, current); err != nil {
+	err := s.hasher.Verify(string(password), current)
+
+	switch {
+	case errors.Is(err, passhash.ErrMismatch):
 		return ErrInvalidPassword
+	case err != nil:
+		return fmt.Errorf("%w:%v", ErrInvalidPassword, err)
 	}
 
 	return nil
@@ -348,11 +488,16 @@ func (s *UserSvc) updateUser(ctx context.Context, user UserUpdatedWithPass) (*Us
 		return nil, ErrInvalidPassword
 	}
 
+	if !validEmail(user.Email) {
+		return nil, ErrInvalidEmail
+	}
+
 	u, err := s.storage.UpdateUser(ctx, models.UserUpdated{
 		Name:     user.Name,
 		Login:    user.Login,
 		Password: user.Password,
 		Blocked:  user.Blocked,
+		Email:    user.Email,
 	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck
@@ -364,14 +509,26 @@ func (s *UserSvc) updateUser(ctx context.Context, user UserUpdatedWithPass) (*Us
 		Login:    u.Login,
 		Password: u.Password,
 		Blocked:  u.Blocked,
+		Email:    u.Email,
 	}, nil
 }
 
+// Адрес электронной почты необязателен, указанный адрес должен быть без имени получателя
+func validEmail(email string) bool {
+	if email == "" {
+		return true
+	}
+
+	addr, err := mail.ParseAddress(email)
+
+	return err == nil && addr.Address == email
+}
+
 func (s *UserSvc) generateHashPassword(password []byte) ([]byte, error) {
-	hash, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+	hash, err := s.hasher.Hash(password)
 	if err != nil {
 		return nil, fmt.Errorf("%w:%v", ErrGeneratePassword, err)
 	}
 
-	return hash, nil
+	return []byte(hash), nil
 }
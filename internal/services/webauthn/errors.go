@@ -0,0 +1,27 @@
+package webauthnsvc
+
+import "errors"
+
+var (
+	ErrWebAuthnNotConfigured     = errors.New("webauthn is not configured")
+	ErrCeremonyInvalid           = errors.New("webauthn ceremony invalid or expired")
+	ErrCredentialAlreadyExists   = errors.New("webauthn credential already exists")
+	ErrCredentialNotFound        = errors.New("webauthn credential not found")
+	ErrCredentialsLimitExceeded  = errors.New("webauthn credentials limit exceeded")
+	ErrCredentialEncodingInvalid = errors.New("webauthn credential encoding invalid")
+)
+
+// Отказ в проверке ключа для известного пользователя,
+// позволяет учесть неудачную попытку входа
+type AssertionError struct {
+	Login string
+	Err   error
+}
+
+func (e *AssertionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AssertionError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,618 @@
+package webauthnsvc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+	repoceremonies "github.com/vtievsky/auth-id/internal/repositories/sessions/ceremonies"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+	"go.uber.org/zap"
+)
+
+const (
+	KindRegistration = "registration"
+	KindLogin        = "login" // Вход без пароля
+	KindMFA          = "mfa"   // Подтверждение входа по паролю
+
+	UserVerificationRequired  = "required"
+	UserVerificationPreferred = "preferred"
+
+	ResidentKeyPreferred = "preferred"
+
+	ceremonyIDSize = 32
+	decoyKeySize   = 32
+	maxCredentials = 20
+	maxNameLength  = 64
+)
+
+type Storage interface {
+	GetWebAuthnCredentials(ctx context.Context, userID uint64) ([]*models.WebAuthnCredential, error)
+	GetWebAuthnCredential(ctx context.Context, credentialID string) (*models.WebAuthnCredential, error)
+	SaveWebAuthnCredential(ctx context.Context, credential models.WebAuthnCredential) error
+	DeleteWebAuthnCredential(ctx context.Context, credentialID string) error
+}
+
+type CeremonyStorage interface {
+	Store(ctx context.Context, ceremonyID string, ceremony repoceremonies.Ceremony, ttl time.Duration) error
+	Take(ctx context.Context, ceremonyID string) (*repoceremonies.Ceremony, error)
+}
+
+type UserSvc interface {
+	GetUser(ctx context.Context, login string) (*usersvc.User, error)
+}
+
+type Credential struct {
+	ID         string
+	Name       string
+	Transports []string
+	CreatedAt  time.Time
+}
+
+type CredentialDescriptor struct {
+	ID         string
+	Transports []string
+}
+
+// Параметры navigator.credentials.create()
+type CreationOptions struct {
+	RPID               string
+	RPName             string
+	UserID             string // Идентификатор пользователя в base64url, не содержит персональных данных
+	UserName           string
+	UserDisplayName    string
+	Challenge          string
+	Algorithms         []int64
+	Timeout            time.Duration
+	ExcludeCredentials []CredentialDescriptor
+	ResidentKey        string
+	UserVerification   string
+}
+
+// Параметры navigator.credentials.get()
+type RequestOptions struct {
+	RPID             string
+	Challenge        string
+	Timeout          time.Duration
+	AllowCredentials []CredentialDescriptor
+	UserVerification string
+}
+
+type RegistrationStart struct {
+	Ceremony string
+	Options  CreationOptions
+}
+
+type AssertionStart struct {
+	Ceremony string
+	Options  RequestOptions
+}
+
+type RegistrationCreated struct {
+	Ceremony          string
+	Name              string
+	ClientDataJSON    []byte
+	AttestationObject []byte
+	Transports        []string
+}
+
+type AssertionResult struct {
+	Login        string
+	MFAChallenge string // Пусто при входе без пароля
+}
+
+type WebAuthnSvcOpts struct {
+	Logger       *zap.Logger
+	Storage      Storage
+	Ceremonies   CeremonyStorage
+	UserSvc      UserSvc
+	RelyingParty *webauthn.RelyingParty // nil - ключи недоступны
+	Timeout      time.Duration          // Время на выполнение церемонии
+	DecoyKey     []byte                 // Секрет для ключей-приманок неизвестных логинов, пусто - случайный
+}
+
+type WebAuthnSvc struct {
+	logger     *zap.Logger
+	storage    Storage
+	ceremonies CeremonyStorage
+	userSvc    UserSvc
+	rp         *webauthn.RelyingParty
+	timeout    time.Duration
+	decoyKey   []byte
+}
+
+func New(opts *WebAuthnSvcOpts) *WebAuthnSvc {
+	decoyKey := opts.DecoyKey
+
+	// Случайный секрет меняется при перезапуске, поэтому для нескольких экземпляров задается явно
+	if len(decoyKey) < 1 {
+		decoyKey = make([]byte, decoyKeySize)
+
+		_, _ = rand.Read(decoyKey)
+	}
+
+	return &WebAuthnSvc{
+		logger:     opts.Logger,
+		storage:    opts.Storage,
+		ceremonies: opts.Ceremonies,
+		userSvc:    opts.UserSvc,
+		rp:         opts.RelyingParty,
+		timeout:    opts.Timeout,
+		decoyKey:   decoyKey,
+	}
+}
+
+// Начало регистрации нового ключа пользователя
+func (s *WebAuthnSvc) BeginRegistration(ctx context.Context, login string) (*RegistrationStart, error) {
+	const op = "WebAuthnSvc.BeginRegistration"
+
+	if s.rp == nil {
+		return nil, fmt.Errorf("failed to begin registration | %s:%w", op, ErrWebAuthnNotConfigured)
+	}
+
+	u, credentials, err := s.userCredentials(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user credentials | %s:%w", op, err)
+	}
+
+	if len(credentials) >= maxCredentials {
+		return nil, fmt.Errorf("failed to begin registration | %s:%w", op, ErrCredentialsLimitExceeded)
+	}
+
+	ceremonyID, challenge, err := s.startCeremony(ctx, repoceremonies.Ceremony{
+		Login:            u.Login,
+		Kind:             KindRegistration,
+		Challenge:        "",
+		UserVerification: false,
+		MFAChallenge:     "",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start ceremony | %s:%w", op, err)
+	}
+
+	return &RegistrationStart{
+		Ceremony: ceremonyID,
+		Options: CreationOptions{
+			RPID:               s.rp.ID,
+			RPName:             s.rp.Name,
+			UserID:             webauthn.Encode(binary.BigEndian.AppendUint64(nil, u.ID)),
+			UserName:           u.Login,
+			UserDisplayName:    u.Name,
+			Challenge:          challenge,
+			Algorithms:         webauthn.Algorithms,
+			Timeout:            s.timeout,
+			ExcludeCredentials: descriptors(credentials),
+			ResidentKey:        ResidentKeyPreferred,
+			UserVerification:   UserVerificationPreferred,
+		},
+	}, nil
+}
+
+// Проверка ответа аутентификатора и сохранение нового ключа
+func (s *WebAuthnSvc) FinishRegistration(ctx context.Context, login string, registration RegistrationCreated) (*Credential, error) {
+	const op = "WebAuthnSvc.FinishRegistration"
+
+	if s.rp == nil {
+		return nil, fmt.Errorf("failed to finish registration | %s:%w", op, ErrWebAuthnNotConfigured)
+	}
+
+	ceremony, err := s.takeCeremony(ctx, registration.Ceremony, KindRegistration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take ceremony | %s:%w", op, err)
+	}
+
+	if !strings.EqualFold(ceremony.Login, login) {
+		return nil, fmt.Errorf("failed to finish registration | %s:%w", op, ErrCeremonyInvalid)
+	}
+
+	challenge, err := webauthn.Decode(ceremony.Challenge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode challenge | %s:%w", op, err)
+	}
+
+	result, err := s.rp.VerifyRegistration(challenge, registration.ClientDataJSON, registration.AttestationObject, false)
+	if err != nil {
+		s.logger.Error("failed to verify registration",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to verify registration | %s:%w", op, err)
+	}
+
+	u, credentials, err := s.userCredentials(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user credentials | %s:%w", op, err)
+	}
+
+	if len(credentials) >= maxCredentials {
+		return nil, fmt.Errorf("failed to finish registration | %s:%w", op, ErrCredentialsLimitExceeded)
+	}
+
+	credentialID := webauthn.Encode(result.CredentialID)
+
+	// Ключ мог быть уже зарегистрирован этим или другим пользователем
+	_, err = s.storage.GetWebAuthnCredential(ctx, credentialID)
+
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("failed to finish registration | %s:%w", op, ErrCredentialAlreadyExists)
+	case !errors.Is(err, dberrors.ErrCredentialNotFound):
+		return nil, fmt.Errorf("failed to get credential | %s:%w", op, err)
+	}
+
+	credential := models.WebAuthnCredential{
+		ID:         credentialID,
+		UserID:     u.ID,
+		Name:       credentialName(registration.Name, len(credentials)),
+		PublicKey:  webauthn.Encode(result.PublicKey),
+		SignCount:  uint64(result.SignCount),
+		Transports: registration.Transports,
+		CreatedAt:  time.Now(),
+	}
+
+	if err = s.storage.SaveWebAuthnCredential(ctx, credential); err != nil {
+		s.logger.Error("failed to save credential",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to save credential | %s:%w", op, err)
+	}
+
+	return &Credential{
+		ID:         credential.ID,
+		Name:       credential.Name,
+		Transports: credential.Transports,
+		CreatedAt:  credential.CreatedAt,
+	}, nil
+}
+
+func (s *WebAuthnSvc) GetCredentials(ctx context.Context, login string) ([]*Credential, error) {
+	const op = "WebAuthnSvc.GetCredentials"
+
+	_, credentials, err := s.userCredentials(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user credentials | %s:%w", op, err)
+	}
+
+	ul := make([]*Credential, 0, len(credentials))
+
+	for _, credential := range credentials {
+		ul = append(ul, &Credential{
+			ID:         credential.ID,
+			Name:       credential.Name,
+			Transports: credential.Transports,
+			CreatedAt:  credential.CreatedAt,
+		})
+	}
+
+	return ul, nil
+}
+
+func (s *WebAuthnSvc) DeleteCredential(ctx context.Context, login, credentialID string) error {
+	const op = "WebAuthnSvc.DeleteCredential"
+
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	credential, err := s.storage.GetWebAuthnCredential(ctx, credentialID)
+	if err != nil {
+		if errors.Is(err, dberrors.ErrCredentialNotFound) {
+			err = ErrCredentialNotFound
+		}
+
+		return fmt.Errorf("failed to get credential | %s:%w", op, err)
+	}
+
+	// Ключ другого пользователя не раскрывается
+	if credential.UserID != u.ID {
+		return fmt.Errorf("failed to get credential | %s:%w", op, ErrCredentialNotFound)
+	}
+
+	if err = s.storage.DeleteWebAuthnCredential(ctx, credentialID); err != nil {
+		s.logger.Error("failed to delete credential",
+			zap.String("login", login),
+			zap.String("credential_id", credentialID),
+			zap.Error(err),
+		)
+
+		return fmt.Errorf("failed to delete credential | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Есть ли у пользователя ключи, которыми можно подтвердить вход
+func (s *WebAuthnSvc) HasCredentials(ctx context.Context, login string) (bool, error) {
+	const op = "WebAuthnSvc.HasCredentials"
+
+	if s.rp == nil {
+		return false, nil
+	}
+
+	_, credentials, err := s.userCredentials(ctx, login)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user credentials | %s:%w", op, err)
+	}
+
+	return len(credentials) > 0, nil
+}
+
+// Начало входа ключом. Без незавершенного входа по паролю ключ используется
+// как единственный фактор и должен подтвердить пользователя сам
+func (s *WebAuthnSvc) BeginAssertion(ctx context.Context, login, mfaChallenge string) (*AssertionStart, error) {
+	const op = "WebAuthnSvc.BeginAssertion"
+
+	if s.rp == nil {
+		return nil, fmt.Errorf("failed to begin assertion | %s:%w", op, ErrWebAuthnNotConfigured)
+	}
+
+	u, credentials, err := s.userCredentials(ctx, login)
+
+	switch {
+	case mfaChallenge == "" && (errors.Is(err, dberrors.ErrUserNotFound) || err == nil && len(credentials) < 1):
+		// Вход без пароля не раскрывает, существует ли логин и есть ли у него ключи:
+		// выдается церемония с постоянным вымышленным ключом, которая завершится отказом
+		return s.beginDecoyAssertion(ctx, login)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user credentials | %s:%w", op, err)
+	case len(credentials) < 1:
+		return nil, fmt.Errorf("failed to begin assertion | %s:%w", op, ErrCredentialNotFound)
+	}
+
+	ceremony := repoceremonies.Ceremony{
+		Login:            u.Login,
+		Kind:             KindLogin,
+		Challenge:        "",
+		UserVerification: true,
+		MFAChallenge:     mfaChallenge,
+	}
+
+	userVerification := UserVerificationRequired
+
+	if mfaChallenge != "" {
+		ceremony.Kind = KindMFA
+		ceremony.UserVerification = false
+		userVerification = UserVerificationPreferred
+	}
+
+	ceremonyID, challenge, err := s.startCeremony(ctx, ceremony)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start ceremony | %s:%w", op, err)
+	}
+
+	return &AssertionStart{
+		Ceremony: ceremonyID,
+		Options: RequestOptions{
+			RPID:             s.rp.ID,
+			Challenge:        challenge,
+			Timeout:          s.timeout,
+			AllowCredentials: descriptors(credentials),
+			UserVerification: userVerification,
+		},
+	}, nil
+}
+
+// Церемония входа с ключом-приманкой, неотличимая от церемонии пользователя с одним ключом
+func (s *WebAuthnSvc) beginDecoyAssertion(ctx context.Context, login string) (*AssertionStart, error) {
+	const op = "WebAuthnSvc.beginDecoyAssertion"
+
+	ceremonyID, challenge, err := s.startCeremony(ctx, repoceremonies.Ceremony{
+		Login:            login,
+		Kind:             KindLogin,
+		Challenge:        "",
+		UserVerification: true,
+		MFAChallenge:     "",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start ceremony | %s:%w", op, err)
+	}
+
+	mac := hmac.New(sha256.New, s.decoyKey)
+	mac.Write([]byte(strings.ToLower(login)))
+
+	return &AssertionStart{
+		Ceremony: ceremonyID,
+		Options: RequestOptions{
+			RPID:      s.rp.ID,
+			Challenge: challenge,
+			Timeout:   s.timeout,
+			AllowCredentials: []CredentialDescriptor{
+				{
+					ID:         webauthn.Encode(mac.Sum(nil)),
+					Transports: nil,
+				},
+			},
+			UserVerification: UserVerificationRequired,
+		},
+	}, nil
+}
+
+// Проверка подписи ключа. Ошибки после определения пользователя возвращаются
+// как AssertionError, чтобы неудачная попытка учитывалась при блокировке входа
+func (s *WebAuthnSvc) FinishAssertion(ctx context.Context, ceremonyID string, assertion *webauthn.Assertion) (*AssertionResult, error) {
+	const op = "WebAuthnSvc.FinishAssertion"
+
+	if s.rp == nil {
+		return nil, fmt.Errorf("failed to finish assertion | %s:%w", op, ErrWebAuthnNotConfigured)
+	}
+
+	ceremony, err := s.takeCeremony(ctx, ceremonyID, KindLogin, KindMFA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take ceremony | %s:%w", op, err)
+	}
+
+	if err = s.verifyAssertion(ctx, ceremony, assertion); err != nil {
+		s.logger.Error("failed to verify assertion",
+			zap.String("login", ceremony.Login),
+			zap.Error(err),
+		)
+
+		return nil, fmt.Errorf("failed to verify assertion | %s:%w", op, &AssertionError{
+			Login: ceremony.Login,
+			Err:   err,
+		})
+	}
+
+	return &AssertionResult{
+		Login:        ceremony.Login,
+		MFAChallenge: ceremony.MFAChallenge,
+	}, nil
+}
+
+func (s *WebAuthnSvc) verifyAssertion(ctx context.Context, ceremony *repoceremonies.Ceremony, assertion *webauthn.Assertion) error {
+	u, err := s.userSvc.GetUser(ctx, ceremony.Login)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	credentialID := webauthn.Encode(assertion.CredentialID)
+
+	credential, err := s.storage.GetWebAuthnCredential(ctx, credentialID)
+	if err != nil {
+		if errors.Is(err, dberrors.ErrCredentialNotFound) {
+			err = ErrCredentialNotFound
+		}
+
+		return err //nolint:wrapcheck
+	}
+
+	if credential.UserID != u.ID {
+		return ErrCredentialNotFound
+	}
+
+	challenge, err := webauthn.Decode(ceremony.Challenge)
+	if err != nil {
+		return ErrCeremonyInvalid
+	}
+
+	publicKey, err := webauthn.Decode(credential.PublicKey)
+	if err != nil {
+		return ErrCredentialEncodingInvalid
+	}
+
+	signCount, err := s.rp.VerifyAssertion(
+		challenge,
+		publicKey,
+		uint32(credential.SignCount), //nolint:gosec
+		assertion,
+		ceremony.UserVerification,
+	)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrSignCountInvalid) {
+			s.logger.Warn("webauthn sign count did not increase, credential may be cloned",
+				zap.String("login", u.Login),
+				zap.String("credential_id", credentialID),
+			)
+		}
+
+		return err //nolint:wrapcheck
+	}
+
+	credential.SignCount = uint64(signCount)
+
+	if err = s.storage.SaveWebAuthnCredential(ctx, *credential); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return nil
+}
+
+func (s *WebAuthnSvc) userCredentials(ctx context.Context, login string) (*usersvc.User, []*models.WebAuthnCredential, error) {
+	u, err := s.userSvc.GetUser(ctx, login)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	credentials, err := s.storage.GetWebAuthnCredentials(ctx, u.ID)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	return u, credentials, nil
+}
+
+// Сохранение церемонии со случайным вызовом, возвращает идентификатор церемонии и вызов
+func (s *WebAuthnSvc) startCeremony(ctx context.Context, ceremony repoceremonies.Ceremony) (string, string, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return "", "", err //nolint:wrapcheck
+	}
+
+	raw := make([]byte, ceremonyIDSize)
+
+	if _, err = rand.Read(raw); err != nil {
+		return "", "", err //nolint:wrapcheck
+	}
+
+	ceremonyID := webauthn.Encode(raw)
+	ceremony.Challenge = webauthn.Encode(challenge)
+
+	if err = s.ceremonies.Store(ctx, ceremonyID, ceremony, s.timeout); err != nil {
+		s.logger.Error("failed to store ceremony",
+			zap.String("login", ceremony.Login),
+			zap.Error(err),
+		)
+
+		return "", "", err //nolint:wrapcheck
+	}
+
+	return ceremonyID, ceremony.Challenge, nil
+}
+
+func (s *WebAuthnSvc) takeCeremony(ctx context.Context, ceremonyID string, kinds ...string) (*repoceremonies.Ceremony, error) {
+	ceremony, err := s.ceremonies.Take(ctx, ceremonyID)
+	if err != nil {
+		if errors.Is(err, repoceremonies.ErrCeremonyNotFound) {
+			err = ErrCeremonyInvalid
+		}
+
+		return nil, err
+	}
+
+	if !slices.Contains(kinds, ceremony.Kind) {
+		return nil, ErrCeremonyInvalid
+	}
+
+	return ceremony, nil
+}
+
+func descriptors(credentials []*models.WebAuthnCredential) []CredentialDescriptor {
+	ul := make([]CredentialDescriptor, 0, len(credentials))
+
+	for _, credential := range credentials {
+		ul = append(ul, CredentialDescriptor{
+			ID:         credential.ID,
+			Transports: credential.Transports,
+		})
+	}
+
+	return ul
+}
+
+func credentialName(name string, num int) string {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return fmt.Sprintf("Ключ %d", num+1)
+	}
+
+	if runes := []rune(name); len(runes) > maxNameLength {
+		return string(runes[:maxNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,421 @@
+package webauthnsvc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	dberrors "github.com/vtievsky/auth-id/internal/repositories"
+	"github.com/vtievsky/auth-id/internal/repositories/models"
+	repoceremonies "github.com/vtievsky/auth-id/internal/repositories/sessions/ceremonies"
+	usersvc "github.com/vtievsky/auth-id/internal/services/users"
+	webauthnsvc "github.com/vtievsky/auth-id/internal/services/webauthn"
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+	"github.com/vtievsky/auth-id/pkg/webauthn/webauthntest"
+	"go.uber.org/zap"
+)
+
+const (
+	rpID   = "id.example.com"
+	origin = "https://id.example.com"
+)
+
+type storage struct {
+	mu          sync.Mutex
+	credentials map[string]models.WebAuthnCredential
+}
+
+func (s *storage) GetWebAuthnCredentials(_ context.Context, userID uint64) ([]*models.WebAuthnCredential, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ul := make([]*models.WebAuthnCredential, 0)
+
+	for _, credential := range s.credentials {
+		if credential.UserID == userID {
+			ul = append(ul, &credential)
+		}
+	}
+
+	return ul, nil
+}
+
+func (s *storage) GetWebAuthnCredential(_ context.Context, credentialID string) (*models.WebAuthnCredential, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credential, ok := s.credentials[credentialID]
+	if !ok {
+		return nil, dberrors.ErrCredentialNotFound
+	}
+
+	return &credential, nil
+}
+
+func (s *storage) SaveWebAuthnCredential(_ context.Context, credential models.WebAuthnCredential) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.credentials[credential.ID] = credential
+
+	return nil
+}
+
+func (s *storage) DeleteWebAuthnCredential(_ context.Context, credentialID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.credentials, credentialID)
+
+	return nil
+}
+
+type ceremonies struct {
+	mu         sync.Mutex
+	ceremonies map[string]repoceremonies.Ceremony
+}
+
+func (s *ceremonies) Store(_ context.Context, ceremonyID string, ceremony repoceremonies.Ceremony, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ceremonies[ceremonyID] = ceremony
+
+	return nil
+}
+
+func (s *ceremonies) Take(_ context.Context, ceremonyID string) (*repoceremonies.Ceremony, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ceremony, ok := s.ceremonies[ceremonyID]
+	if !ok {
+		return nil, repoceremonies.ErrCeremonyNotFound
+	}
+
+	delete(s.ceremonies, ceremonyID)
+
+	return &ceremony, nil
+}
+
+type users map[string]*usersvc.User
+
+func (s users) GetUser(_ context.Context, login string) (*usersvc.User, error) {
+	u, ok := s[login]
+	if !ok {
+		return nil, dberrors.ErrUserNotFound
+	}
+
+	return u, nil
+}
+
+type fixture struct {
+	svc     *webauthnsvc.WebAuthnSvc
+	storage *storage
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		storage: &storage{credentials: map[string]models.WebAuthnCredential{}},
+	}
+
+	f.svc = webauthnsvc.New(&webauthnsvc.WebAuthnSvcOpts{
+		Logger:     zap.NewNop(),
+		Storage:    f.storage,
+		Ceremonies: &ceremonies{ceremonies: map[string]repoceremonies.Ceremony{}},
+		UserSvc: users{
+			"ivan": {ID: 1, Login: "ivan", Name: "Иван"},
+			"petr": {ID: 2, Login: "petr", Name: "Петр"},
+		},
+		RelyingParty: &webauthn.RelyingParty{
+			ID:      rpID,
+			Name:    "auth-id",
+			Origins: []string{origin},
+		},
+		Timeout:  time.Minute,
+		DecoyKey: []byte("decoy"),
+	})
+
+	return f
+}
+
+func decode(t *testing.T, value string) []byte {
+	t.Helper()
+
+	raw, err := webauthn.Decode(value)
+	if err != nil {
+		t.Fatalf("failed to decode %q: %v", value, err)
+	}
+
+	return raw
+}
+
+func (f *fixture) register(t *testing.T, login string, alg int64) *webauthntest.Authenticator {
+	t.Helper()
+
+	authenticator, err := webauthntest.New(alg, rpID, origin)
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %v", err)
+	}
+
+	start, err := f.svc.BeginRegistration(context.Background(), login)
+	if err != nil {
+		t.Fatalf("failed to begin registration: %v", err)
+	}
+
+	clientDataJSON, attestationObject := authenticator.Register(decode(t, start.Options.Challenge))
+
+	if _, err = f.svc.FinishRegistration(context.Background(), login, webauthnsvc.RegistrationCreated{
+		Ceremony:          start.Ceremony,
+		Name:              "",
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+		Transports:        []string{"internal"},
+	}); err != nil {
+		t.Fatalf("failed to finish registration: %v", err)
+	}
+
+	return authenticator
+}
+
+func (f *fixture) beginAssertion(t *testing.T, login string) *webauthnsvc.AssertionStart {
+	t.Helper()
+
+	start, err := f.svc.BeginAssertion(context.Background(), login, "")
+	if err != nil {
+		t.Fatalf("failed to begin assertion: %v", err)
+	}
+
+	return start
+}
+
+func TestRegistrationAndLogin(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		alg  int64
+	}{
+		{name: "ES256", alg: webauthn.AlgES256},
+		{name: "EdDSA", alg: webauthn.AlgEdDSA},
+		{name: "RS256", alg: webauthn.AlgRS256},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			authenticator := f.register(t, "ivan", tt.alg)
+
+			credentials, err := f.svc.GetCredentials(context.Background(), "ivan")
+			if err != nil || len(credentials) != 1 {
+				t.Fatalf("expected 1 credential, got %d (%v)", len(credentials), err)
+			}
+
+			for range 2 {
+				start := f.beginAssertion(t, "ivan")
+
+				if len(start.Options.AllowCredentials) != 1 || start.Options.AllowCredentials[0].ID != credentials[0].ID {
+					t.Fatalf("unexpected allowed credentials %v", start.Options.AllowCredentials)
+				}
+
+				result, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, authenticator.Assert(decode(t, start.Options.Challenge)))
+				if err != nil {
+					t.Fatalf("failed to finish assertion: %v", err)
+				}
+
+				if result.Login != "ivan" {
+					t.Errorf("unexpected login %q", result.Login)
+				}
+			}
+
+			stored, _ := f.storage.GetWebAuthnCredential(context.Background(), credentials[0].ID)
+			if stored.SignCount != uint64(authenticator.SignCount) {
+				t.Errorf("sign count not saved: %d, want %d", stored.SignCount, authenticator.SignCount)
+			}
+		})
+	}
+}
+
+func TestRegistrationDuplicateCredential(t *testing.T) {
+	f := newFixture()
+	authenticator := f.register(t, "ivan", webauthn.AlgES256)
+
+	start, err := f.svc.BeginRegistration(context.Background(), "petr")
+	if err != nil {
+		t.Fatalf("failed to begin registration: %v", err)
+	}
+
+	clientDataJSON, attestationObject := authenticator.Register(decode(t, start.Options.Challenge))
+
+	_, err = f.svc.FinishRegistration(context.Background(), "petr", webauthnsvc.RegistrationCreated{
+		Ceremony:          start.Ceremony,
+		Name:              "",
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+		Transports:        nil,
+	})
+	if !errors.Is(err, webauthnsvc.ErrCredentialAlreadyExists) {
+		t.Fatalf("expected ErrCredentialAlreadyExists, got %v", err)
+	}
+}
+
+func TestAssertionReplay(t *testing.T) {
+	f := newFixture()
+	authenticator := f.register(t, "ivan", webauthn.AlgES256)
+
+	start := f.beginAssertion(t, "ivan")
+	assertion := authenticator.Assert(decode(t, start.Options.Challenge))
+
+	if _, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, assertion); err != nil {
+		t.Fatalf("failed to finish assertion: %v", err)
+	}
+
+	// Церемония одноразовая
+	if _, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, assertion); !errors.Is(err, webauthnsvc.ErrCeremonyInvalid) {
+		t.Fatalf("expected ErrCeremonyInvalid, got %v", err)
+	}
+
+	// Ответ на прежний вызов не подходит для новой церемонии
+	start = f.beginAssertion(t, "ivan")
+
+	_, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, assertion)
+	if !errors.Is(err, webauthn.ErrChallengeMismatch) {
+		t.Fatalf("expected ErrChallengeMismatch, got %v", err)
+	}
+
+	var assertionErr *webauthnsvc.AssertionError
+
+	if !errors.As(err, &assertionErr) || assertionErr.Login != "ivan" {
+		t.Fatalf("expected AssertionError for ivan, got %v", err)
+	}
+}
+
+func TestAssertionSignCountRegression(t *testing.T) {
+	f := newFixture()
+	authenticator := f.register(t, "ivan", webauthn.AlgEdDSA)
+
+	authenticator.SignCount = 10
+
+	start := f.beginAssertion(t, "ivan")
+	if _, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, authenticator.Assert(decode(t, start.Options.Challenge))); err != nil {
+		t.Fatalf("failed to finish assertion: %v", err)
+	}
+
+	// Клон ключа с отставшим счетчиком
+	authenticator.SignCount = 3
+
+	start = f.beginAssertion(t, "ivan")
+
+	_, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, authenticator.Assert(decode(t, start.Options.Challenge)))
+	if !errors.Is(err, webauthn.ErrSignCountInvalid) {
+		t.Fatalf("expected ErrSignCountInvalid, got %v", err)
+	}
+}
+
+func TestAssertionMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(a *webauthntest.Authenticator)
+		err    error
+	}{
+		{
+			name:   "origin",
+			modify: func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example.com" },
+			err:    webauthn.ErrOriginMismatch,
+		},
+		{
+			name:   "rp id",
+			modify: func(a *webauthntest.Authenticator) { a.RPID = "evil.example.com" },
+			err:    webauthn.ErrRPIDMismatch,
+		},
+		{
+			name:   "user verification",
+			modify: func(a *webauthntest.Authenticator) { a.UserVerified = false },
+			err:    webauthn.ErrUserNotVerified,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			authenticator := f.register(t, "ivan", webauthn.AlgRS256)
+			tt.modify(authenticator)
+
+			start := f.beginAssertion(t, "ivan")
+
+			_, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, authenticator.Assert(decode(t, start.Options.Challenge)))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestAssertionForeignCredential(t *testing.T) {
+	f := newFixture()
+	f.register(t, "ivan", webauthn.AlgES256)
+	foreign := f.register(t, "petr", webauthn.AlgES256)
+
+	start := f.beginAssertion(t, "ivan")
+
+	_, err := f.svc.FinishAssertion(context.Background(), start.Ceremony, foreign.Assert(decode(t, start.Options.Challenge)))
+	if !errors.Is(err, webauthnsvc.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+}
+
+func TestDecoyAssertion(t *testing.T) {
+	f := newFixture()
+	f.register(t, "ivan", webauthn.AlgES256)
+
+	known := f.beginAssertion(t, "ivan")
+
+	// Неизвестный логин и пользователь без ключей неотличимы от пользователя с ключом
+	for _, login := range []string{"unknown", "petr"} {
+		t.Run(login, func(t *testing.T) {
+			first := f.beginAssertion(t, login)
+			second := f.beginAssertion(t, login)
+
+			if len(first.Options.AllowCredentials) != len(known.Options.AllowCredentials) {
+				t.Fatalf("unexpected allowed credentials %v", first.Options.AllowCredentials)
+			}
+
+			if first.Options.AllowCredentials[0].ID != second.Options.AllowCredentials[0].ID {
+				t.Error("decoy credential changes between requests")
+			}
+
+			if len(first.Options.AllowCredentials[0].ID) != len(known.Options.AllowCredentials[0].ID) {
+				t.Error("decoy credential id length differs")
+			}
+
+			if first.Options.UserVerification != known.Options.UserVerification || first.Options.RPID != known.Options.RPID {
+				t.Errorf("decoy options differ: %+v", first.Options)
+			}
+
+			authenticator, err := webauthntest.New(webauthn.AlgES256, rpID, origin)
+			if err != nil {
+				t.Fatalf("failed to create authenticator: %v", err)
+			}
+
+			_, err = f.svc.FinishAssertion(context.Background(), first.Ceremony, authenticator.Assert(decode(t, first.Options.Challenge)))
+
+			var assertionErr *webauthnsvc.AssertionError
+
+			if !errors.As(err, &assertionErr) || assertionErr.Login != login {
+				t.Fatalf("expected AssertionError for %s, got %v", login, err)
+			}
+		})
+	}
+
+	if f.beginAssertion(t, "unknown").Options.AllowCredentials[0].ID == f.beginAssertion(t, "other").Options.AllowCredentials[0].ID {
+		t.Error("decoy credential does not depend on login")
+	}
+}
+
+func TestMFAAssertionWithoutCredentials(t *testing.T) {
+	f := newFixture()
+
+	// При подтверждении входа по паролю логин уже известен, приманка не нужна
+	_, err := f.svc.BeginAssertion(context.Background(), "petr", "challenge")
+	if !errors.Is(err, webauthnsvc.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	authorizePath   = "/v1/authorize"
+	defaultCacheTTL = time.Second * 5
+	maxCacheEntries = 4096
+)
+
+type RemoteAuthorizerOpts struct {
+	URL         string                                    // Адрес сервиса auth-id
+	TokenSource func(ctx context.Context) (string, error) // Токен сервиса с привилегией session_authorize
+	Client      *http.Client                              // По умолчанию http.Client с таймаутом 5s
+	CacheTTL    time.Duration                             // Время жизни решения в локальном кеше
+}
+
+// Проверка привилегий через POST /v1/authorize с кешированием решений
+type RemoteAuthorizer struct {
+	url         string
+	tokenSource func(ctx context.Context) (string, error)
+	client      *http.Client
+	cacheTTL    time.Duration
+
+	mu    sync.Mutex
+	cache map[decisionKey]decision
+}
+
+type decisionKey struct {
+	token         string
+	privilegeCode string
+}
+
+type decision struct {
+	allowed   bool
+	expiredAt time.Time
+}
+
+func NewRemoteAuthorizer(opts *RemoteAuthorizerOpts) *RemoteAuthorizer {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultRequestTimeout} //nolint:exhaustruct
+	}
+
+	cacheTTL := opts.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = defaultCacheTTL
+	}
+
+	return &RemoteAuthorizer{
+		url:         opts.URL + authorizePath,
+		tokenSource: opts.TokenSource,
+		client:      client,
+		cacheTTL:    cacheTTL,
+		mu:          sync.Mutex{},
+		cache:       map[decisionKey]decision{},
+	}
+}
+
+func (a *RemoteAuthorizer) Allowed(ctx context.Context, token, privilegeCode string) (bool, error) {
+	const op = "RemoteAuthorizer.Allowed"
+
+	key := decisionKey{
+		token:         token,
+		privilegeCode: privilegeCode,
+	}
+
+	if allowed, ok := a.cached(key); ok {
+		return allowed, nil
+	}
+
+	decisions, err := a.authorize(ctx, token, []string{privilegeCode})
+	if err != nil {
+		return false, fmt.Errorf("failed to authorize | %s:%w", op, err)
+	}
+
+	var allowed bool
+
+	for _, d := range decisions {
+		if d.Privilege == privilegeCode {
+			allowed = d.Allowed
+		}
+	}
+
+	a.store(key, allowed)
+
+	return allowed, nil
+}
+
+func (a *RemoteAuthorizer) authorize(
+	ctx context.Context,
+	token string,
+	privilegeCodes []string,
+) ([]Decision, error) {
+	serviceToken, err := a.tokenSource(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service token | %w", err)
+	}
+
+	body, err := json.Marshal(authorizeRequest{
+		Token:      token,
+		Privileges: privilegeCodes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request | %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request | %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+serviceToken)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request | %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to authorize %d | %w", resp.StatusCode, ErrUnexpectedStatus)
+	}
+
+	var result authorizeResponse
+
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response | %w", err)
+	}
+
+	return result.Data, nil
+}
+
+func (a *RemoteAuthorizer) cached(key decisionKey) (bool, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	d, ok := a.cache[key]
+	if !ok || time.Now().After(d.expiredAt) {
+		return false, false
+	}
+
+	return d.allowed, true
+}
+
+func (a *RemoteAuthorizer) store(key decisionKey, allowed bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Очистка устаревших решений при переполнении кеша
+	if len(a.cache) >= maxCacheEntries {
+		current := time.Now()
+
+		for k, d := range a.cache {
+			if current.After(d.expiredAt) {
+				delete(a.cache, k)
+			}
+		}
+
+		if len(a.cache) >= maxCacheEntries {
+			a.cache = map[decisionKey]decision{}
+		}
+	}
+
+	a.cache[key] = decision{
+		allowed:   allowed,
+		expiredAt: time.Now().Add(a.cacheTTL),
+	}
+}
+
+// Источник неизменного токена сервиса
+func StaticToken(token string) func(ctx context.Context) (string, error) {
+	return func(_ context.Context) (string, error) {
+		return token, nil
+	}
+}
@@ -0,0 +1,103 @@
+// Пакет authz позволяет сервисам проверять токены auth-id локально
+// и делегировать проверку привилегий сервису auth-id
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+type ctxKey struct{}
+
+// Владелец проверенного токена
+type Identity struct {
+	Login     string
+	SessionID string
+	Token     string
+}
+
+// Проверка доступа сессии к привилегии на стороне auth-id
+type Authorizer interface {
+	Allowed(ctx context.Context, token, privilegeCode string) (bool, error)
+}
+
+type Opts struct {
+	Keys       authidjwt.KeySet // Общий секрет (authidjwt.NewHMACKey) или JWKS (NewRemoteKeySet)
+	Authorizer Authorizer       // Необязателен, требуется для RequirePrivilege
+}
+
+type Authz struct {
+	keys       authidjwt.KeySet
+	authorizer Authorizer
+}
+
+func New(opts *Opts) *Authz {
+	return &Authz{
+		keys:       opts.Keys,
+		authorizer: opts.Authorizer,
+	}
+}
+
+// Проверка подписи и срока действия access-токена из заголовка Authorization
+func (a *Authz) Verify(ctx context.Context, header string) (*Identity, error) {
+	const op = "Authz.Verify"
+
+	value, found := strings.CutPrefix(header, "Bearer ")
+	if !found || value == "" {
+		return nil, fmt.Errorf("failed to extract token | %s:%w", op, ErrTokenNotFound)
+	}
+
+	token, err := authidjwt.ParseTokenContext(ctx, a.keys, []byte(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token | %s:%w", op, err)
+	}
+
+	// Refresh-токен предназначен только для продления сессии
+	if !token.Valid || !token.AccessOnly {
+		return nil, fmt.Errorf("failed to validate token | %s:%w", op, ErrTokenInvalid)
+	}
+
+	return &Identity{
+		Login:     token.Subject,
+		SessionID: token.SessionID,
+		Token:     value,
+	}, nil
+}
+
+// Проверка привилегии владельца токена, сохраненного в контексте
+func (a *Authz) Check(ctx context.Context, privilegeCode string) error {
+	const op = "Authz.Check"
+
+	if a.authorizer == nil {
+		return fmt.Errorf("failed to check privilege | %s:%w", op, ErrAuthorizerNotSet)
+	}
+
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("failed to check privilege | %s:%w", op, ErrIdentityNotFound)
+	}
+
+	allowed, err := a.authorizer.Allowed(ctx, identity.Token, privilegeCode)
+	if err != nil {
+		return fmt.Errorf("failed to check privilege | %s:%w", op, err)
+	}
+
+	if !allowed {
+		return fmt.Errorf("failed to check privilege %s | %s:%w", privilegeCode, op, ErrPrivilegeNotFound)
+	}
+
+	return nil
+}
+
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, ctxKey{}, identity)
+}
+
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(ctxKey{}).(*Identity)
+
+	return identity, ok
+}
@@ -0,0 +1,284 @@
+package authz_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vtievsky/auth-id/pkg/authz"
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+// Сервис auth-id, публикующий JWKS и решения о доступе
+type server struct {
+	*httptest.Server
+
+	jwksHits      atomic.Int32
+	authorizeHits atomic.Int32
+	granted       map[string]bool
+}
+
+func newServer(t *testing.T, keys ...*authidjwt.Key) *server {
+	t.Helper()
+
+	s := &server{granted: map[string]bool{"report_read": true}} //nolint:exhaustruct
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /.well-known/jwks.json", func(w http.ResponseWriter, _ *http.Request) {
+		s.jwksHits.Add(1)
+
+		_ = json.NewEncoder(w).Encode(authidjwt.NewJWKSet(keys...))
+	})
+
+	mux.HandleFunc("POST /v1/authorize", func(w http.ResponseWriter, r *http.Request) {
+		s.authorizeHits.Add(1)
+
+		if r.Header.Get("Authorization") != "Bearer service" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var request struct {
+			Token      string   `json:"token"`
+			Privileges []string `json:"privileges"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		decisions := make([]authz.Decision, 0, len(request.Privileges))
+
+		for _, code := range request.Privileges {
+			decision := authz.Decision{Privilege: code, Allowed: true, Reason: ""}
+
+			if !s.granted[code] {
+				decision.Allowed = false
+				decision.Reason = authz.ReasonPrivilegeNotFound
+			}
+
+			decisions = append(decisions, decision)
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": decisions})
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func generateKey(t *testing.T) *authidjwt.Key {
+	t.Helper()
+
+	key, err := authidjwt.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	return key
+}
+
+func tokenOpts(ttl time.Duration) *authidjwt.TokenOpts {
+	return &authidjwt.TokenOpts{
+		ID:        "1",
+		Subject:   "ivan",
+		SessionID: "session",
+		ExpiredAt: time.Now().Add(ttl),
+	}
+}
+
+type signer func(key *authidjwt.Key, opts *authidjwt.TokenOpts) ([]byte, error)
+
+func bearer(t *testing.T, sign signer, key *authidjwt.Key, ttl time.Duration) string {
+	t.Helper()
+
+	token, err := sign(key, tokenOpts(ttl))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return "Bearer " + string(token)
+}
+
+func TestVerify(t *testing.T) {
+	key := generateKey(t)
+	srv := newServer(t, key)
+
+	a := authz.New(&authz.Opts{
+		Keys:       authz.NewRemoteKeySet(&authz.RemoteKeySetOpts{URL: srv.URL, Client: nil, MinRefreshInterval: 0}),
+		Authorizer: nil,
+	})
+
+	identity, err := a.Verify(context.Background(), bearer(t, authidjwt.NewAccessToken, key, time.Minute))
+	if err != nil {
+		t.Fatalf("failed to verify access token: %v", err)
+	}
+
+	if identity.Login != "ivan" || identity.SessionID != "session" {
+		t.Fatalf("unexpected identity %+v", identity)
+	}
+
+	t.Run("expired token", func(t *testing.T) {
+		_, err := a.Verify(context.Background(), bearer(t, authidjwt.NewAccessToken, key, -time.Minute))
+		if !errors.Is(err, authidjwt.ErrTokenExpired) {
+			t.Fatalf("expected ErrTokenExpired, got %v", err)
+		}
+	})
+
+	// Refresh-токен подписан тем же ключом, но не дает доступа к ресурсам
+	t.Run("refresh token", func(t *testing.T) {
+		_, err := a.Verify(context.Background(), bearer(t, authidjwt.NewRefreshToken, key, time.Minute))
+		if !errors.Is(err, authz.ErrTokenInvalid) {
+			t.Fatalf("expected ErrTokenInvalid, got %v", err)
+		}
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := a.Verify(context.Background(), "")
+		if !errors.Is(err, authz.ErrTokenNotFound) {
+			t.Fatalf("expected ErrTokenNotFound, got %v", err)
+		}
+	})
+}
+
+// Неизвестный kid загружает JWKS один раз, повторные промахи ограничены интервалом
+func TestRemoteKeySetRefresh(t *testing.T) {
+	var (
+		active  = generateKey(t)
+		retired = generateKey(t)
+		unknown = generateKey(t)
+		srv     = newServer(t, active, retired)
+	)
+
+	a := authz.New(&authz.Opts{
+		Keys:       authz.NewRemoteKeySet(&authz.RemoteKeySetOpts{URL: srv.URL, Client: nil, MinRefreshInterval: 0}),
+		Authorizer: nil,
+	})
+
+	if _, err := a.Verify(context.Background(), bearer(t, authidjwt.NewAccessToken, active, time.Minute)); err != nil {
+		t.Fatalf("failed to verify token: %v", err)
+	}
+
+	if _, err := a.Verify(context.Background(), bearer(t, authidjwt.NewAccessToken, retired, time.Minute)); err != nil {
+		t.Fatalf("failed to verify token signed by retired key: %v", err)
+	}
+
+	for range 3 {
+		if _, err := a.Verify(context.Background(), bearer(t, authidjwt.NewAccessToken, unknown, time.Minute)); err == nil {
+			t.Fatal("token signed by unknown key must be rejected")
+		}
+	}
+
+	if hits := srv.jwksHits.Load(); hits != 1 {
+		t.Fatalf("expected exactly one jwks fetch, got %d", hits)
+	}
+}
+
+// Загрузка JWKS выполняется в контексте запроса
+func TestRemoteKeySetContext(t *testing.T) {
+	key := generateKey(t)
+	srv := newServer(t, key)
+	keys := authz.NewRemoteKeySet(&authz.RemoteKeySetOpts{URL: srv.URL, Client: nil, MinRefreshInterval: 0})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := keys.LookupContext(ctx, key.ID); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if hits := srv.jwksHits.Load(); hits != 0 {
+		t.Fatalf("canceled request must not reach the server, got %d fetches", hits)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	srv := newServer(t)
+
+	a := authz.New(&authz.Opts{
+		Keys: nil,
+		Authorizer: authz.NewRemoteAuthorizer(&authz.RemoteAuthorizerOpts{
+			URL:         srv.URL,
+			TokenSource: authz.StaticToken("service"),
+			Client:      nil,
+			CacheTTL:    0,
+		}),
+	})
+
+	if err := a.Check(context.Background(), "report_read"); !errors.Is(err, authz.ErrIdentityNotFound) {
+		t.Fatalf("expected ErrIdentityNotFound, got %v", err)
+	}
+
+	ctx := authz.NewContext(context.Background(), &authz.Identity{Login: "ivan", SessionID: "session", Token: "token"})
+
+	if err := a.Check(ctx, "report_read"); err != nil {
+		t.Fatalf("failed to check granted privilege: %v", err)
+	}
+
+	if err := a.Check(ctx, "report_delete"); !errors.Is(err, authz.ErrPrivilegeNotFound) {
+		t.Fatalf("expected ErrPrivilegeNotFound, got %v", err)
+	}
+}
+
+func TestRemoteAuthorizerCache(t *testing.T) {
+	srv := newServer(t)
+
+	authorizer := authz.NewRemoteAuthorizer(&authz.RemoteAuthorizerOpts{
+		URL:         srv.URL,
+		TokenSource: authz.StaticToken("service"),
+		Client:      nil,
+		CacheTTL:    time.Millisecond * 200,
+	})
+
+	allowed := func(token, code string) bool {
+		t.Helper()
+
+		ok, err := authorizer.Allowed(context.Background(), token, code)
+		if err != nil {
+			t.Fatalf("failed to authorize: %v", err)
+		}
+
+		return ok
+	}
+
+	// Повторный запрос, в том числе отказ, берется из кеша
+	for range 2 {
+		if !allowed("token", "report_read") {
+			t.Fatal("expected report_read to be allowed")
+		}
+
+		if allowed("token", "report_delete") {
+			t.Fatal("expected report_delete to be denied")
+		}
+	}
+
+	if hits := srv.authorizeHits.Load(); hits != 2 {
+		t.Fatalf("expected 2 authorize requests, got %d", hits)
+	}
+
+	// Решение кешируется для пары токен-привилегия
+	allowed("another", "report_read")
+
+	if hits := srv.authorizeHits.Load(); hits != 3 {
+		t.Fatalf("expected 3 authorize requests, got %d", hits)
+	}
+
+	time.Sleep(time.Millisecond * 250)
+
+	allowed("token", "report_read")
+
+	if hits := srv.authorizeHits.Load(); hits != 4 {
+		t.Fatalf("expired decision must be requested again, got %d requests", hits)
+	}
+}
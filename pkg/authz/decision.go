@@ -0,0 +1,27 @@
+package authz
+
+// Причина отказа в доступе к привилегии
+type Reason string
+
+const (
+	ReasonTokenExpired      Reason = "token_expired"
+	ReasonTokenInvalid      Reason = "token_invalid"
+	ReasonSessionNotFound   Reason = "session_not_found"
+	ReasonPrivilegeNotFound Reason = "privilege_not_found"
+)
+
+// Решение о доступе сессии к привилегии, пустая причина - доступ разрешен
+type Decision struct {
+	Privilege string `json:"privilege"`
+	Allowed   bool   `json:"allowed"`
+	Reason    Reason `json:"reason,omitempty"`
+}
+
+type authorizeRequest struct {
+	Token      string   `json:"token"`
+	Privileges []string `json:"privileges"`
+}
+
+type authorizeResponse struct {
+	Data []Decision `json:"data"`
+}
@@ -0,0 +1,36 @@
+package authz
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware echo: проверка токена и сохранение владельца в контексте запроса
+func (a *Authz) EchoMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			identity, err := a.Verify(c.Request().Context(), c.Request().Header.Get("Authorization"))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+
+			c.SetRequest(c.Request().WithContext(NewContext(c.Request().Context(), identity)))
+
+			return next(c)
+		}
+	}
+}
+
+// Middleware echo: проверка привилегии. Используется после EchoMiddleware
+func (a *Authz) EchoRequirePrivilege(privilegeCode string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := a.Check(c.Request().Context(), privilegeCode); err != nil {
+				return echo.NewHTTPError(statusCode(err), err.Error())
+			}
+
+			return next(c)
+		}
+	}
+}
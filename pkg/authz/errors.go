@@ -0,0 +1,13 @@
+package authz
+
+import "errors"
+
+var (
+	ErrTokenNotFound       = errors.New("token not found")
+	ErrTokenInvalid        = errors.New("token invalid")
+	ErrIdentityNotFound    = errors.New("identity not found")
+	ErrPrivilegeNotFound   = errors.New("privilege not found")
+	ErrAuthorizerNotSet    = errors.New("authorizer not set")
+	ErrUnexpectedStatus    = errors.New("unexpected response status")
+	ErrJWKSetFetchThrottle = errors.New("jwk set fetch throttled")
+)
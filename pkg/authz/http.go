@@ -0,0 +1,48 @@
+package authz
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Middleware net/http: проверка токена и сохранение владельца в контексте запроса
+func (a *Authz) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, err := a.Verify(r.Context(), r.Header.Get("Authorization"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), identity)))
+		})
+	}
+}
+
+// Middleware net/http: проверка привилегии. Используется после Middleware
+func (a *Authz) RequirePrivilege(privilegeCode string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := a.Check(r.Context(), privilegeCode); err != nil {
+				http.Error(w, err.Error(), statusCode(err))
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrIdentityNotFound):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrPrivilegeNotFound):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,160 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+const (
+	jwksPath                  = "/.well-known/jwks.json"
+	defaultMinRefreshInterval = time.Second * 30
+	defaultRequestTimeout     = time.Second * 5
+)
+
+type RemoteKeySetOpts struct {
+	URL                string        // Адрес сервиса auth-id
+	Client             *http.Client  // По умолчанию http.Client с таймаутом 5s
+	MinRefreshInterval time.Duration // Минимальный интервал между запросами JWKS
+}
+
+// Открытые ключи auth-id, загружаемые из JWKS.
+// Набор обновляется при появлении токена с неизвестным kid (ротация ключа)
+type RemoteKeySet struct {
+	url                string
+	client             *http.Client
+	minRefreshInterval time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]*authidjwt.Key
+	fetchedAt time.Time
+}
+
+func NewRemoteKeySet(opts *RemoteKeySetOpts) *RemoteKeySet {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultRequestTimeout} //nolint:exhaustruct
+	}
+
+	minRefreshInterval := opts.MinRefreshInterval
+	if minRefreshInterval <= 0 {
+		minRefreshInterval = defaultMinRefreshInterval
+	}
+
+	return &RemoteKeySet{
+		url:                opts.URL + jwksPath,
+		client:             client,
+		minRefreshInterval: minRefreshInterval,
+		mu:                 sync.RWMutex{},
+		keys:               map[string]*authidjwt.Key{},
+		fetchedAt:          time.Time{},
+	}
+}
+
+func (s *RemoteKeySet) Lookup(kid string) (*authidjwt.Key, error) {
+	return s.LookupContext(context.Background(), kid)
+}
+
+// Поиск ключа с загрузкой JWKS в рамках контекста запроса
+func (s *RemoteKeySet) LookupContext(ctx context.Context, kid string) (*authidjwt.Key, error) {
+	if key, ok := s.find(kid); ok {
+		return key, nil
+	}
+
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.find(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w | kid %q", authidjwt.ErrKeyNotFound, kid)
+}
+
+// Загрузка JWKS не чаще MinRefreshInterval
+func (s *RemoteKeySet) Refresh(ctx context.Context) error {
+	const op = "RemoteKeySet.Refresh"
+
+	if !s.acquire() {
+		return fmt.Errorf("failed to fetch jwk set | %s:%w", op, ErrJWKSetFetchThrottle)
+	}
+
+	// Запрос выполняется без блокировки, чтобы не задерживать поиск известных ключей
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch jwk set | %s:%w", op, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = keys
+
+	return nil
+}
+
+// Резервирование загрузки: true, если интервал с прошлой загрузки истек
+func (s *RemoteKeySet) acquire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.fetchedAt) < s.minRefreshInterval {
+		return false
+	}
+
+	s.fetchedAt = time.Now()
+
+	return true
+}
+
+func (s *RemoteKeySet) fetch(ctx context.Context) (map[string]*authidjwt.Key, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request | %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request | %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwk set %d | %w", resp.StatusCode, ErrUnexpectedStatus)
+	}
+
+	var set authidjwt.JWKSet
+
+	if err = json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode jwk set | %w", err)
+	}
+
+	keys := make(map[string]*authidjwt.Key, len(set.Keys))
+
+	for _, jwk := range set.Keys {
+		key, err := jwk.Key()
+		if err != nil {
+			// Неподдерживаемые ключи пропускаются
+			continue
+		}
+
+		keys[key.ID] = key
+	}
+
+	return keys, nil
+}
+
+func (s *RemoteKeySet) find(kid string) (*authidjwt.Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[kid]
+
+	return key, ok
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// Сообщение о сбросе ключей кеша
+type Message struct {
+	Name   string   `json:"name"`            // Имя кеша
+	Origin string   `json:"origin"`          // Экземпляр кеша, отправивший сообщение
+	Keys   []string `json:"keys"`            // Сбрасываемые ключи
+	Flush  bool     `json:"flush,omitempty"` // Сброс всех ключей, когда сообщения рассылки могли быть потеряны
+}
+
+// Рассылка сброса ключей кеша между экземплярами приложения.
+// Ошибки доставки обрабатываются реализацией: несброшенный ключ
+// устареет не позднее срока годности кеша
+type Bus interface {
+	Publish(ctx context.Context, msg Message)
+	Subscribe(name string, handler func(msg Message))
+}
+
+// Рассылка в пределах процесса, например, для проверки нескольких кешей в тестах
+type MemoryBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]func(msg Message)
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		mu:       sync.RWMutex{},
+		handlers: map[string][]func(msg Message){},
+	}
+}
+
+func (s *MemoryBus) Publish(_ context.Context, msg Message) {
+	s.mu.RLock()
+	handlers := s.handlers[msg.Name]
+	s.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(msg)
+	}
+}
+
+// Сброс всех подписанных кешей, как после переподключения рассылки
+func (s *MemoryBus) Flush() {
+	s.mu.RLock()
+	handlers := maps.Clone(s.handlers)
+	s.mu.RUnlock()
+
+	for name, nameHandlers := range handlers {
+		for _, handler := range nameHandlers {
+			handler(Message{Name: name, Origin: "", Keys: nil, Flush: true})
+		}
+	}
+}
+
+func (s *MemoryBus) Subscribe(name string, handler func(msg Message)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[name] = append(s.handlers[name], handler)
+}
@@ -1,94 +1,296 @@
 package cache
 
 import (
+	"container/list"
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
-	cacheTTL = time.Second * 60
+	defaultSize        = 10000
+	defaultTTL         = time.Second * 60
+	defaultNegativeTTL = time.Second * 5
 )
 
 var (
 	ErrValueNotFound = errors.New("value not found")
+	ErrLoaderPanic   = errors.New("cache loader panic")
 )
 
+// Загрузка значения ключа из хранилища при промахе кеша.
+// Отсутствие ключа в результате означает, что значение не найдено.
+// Значения других ключей, полученные тем же запросом, дополняют кеш
+type Loader[K comparable, V any] func(ctx context.Context, key K) (map[K]V, error)
+
+type Opts struct {
+	Name        string        // Имя кеша, общее для всех экземпляров приложения
+	Bus         Bus           // Рассылка сброса ключей, при отсутствии кеш остается локальным
+	Size        int           // Максимальное количество значений, по умолчанию 10000
+	TTL         time.Duration // Срок годности значения, по умолчанию 60 секунд
+	NegativeTTL time.Duration // Срок годности отсутствия значения, по умолчанию 5 секунд
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	found     bool
+	expiredAt time.Time
+}
+
+// Ограниченный по размеру кеш с вытеснением давно не использованных значений
 type Cache[K comparable, V any] struct {
-	m        map[K]V
-	mu       sync.Mutex
-	lastTime time.Time
+	mu          sync.Mutex
+	m           map[K]*list.Element
+	lru         *list.List
+	epoch       uint64 // Изменяется при сбросе ключей, чтобы не сохранять устаревшие загрузки
+	flight      flight[K, V]
+	name        string
+	origin      string
+	bus         Bus
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
 }
 
-func New[K comparable, V any]() Cache[K, V] {
-	return Cache[K, V]{
-		m:        map[K]V{},
-		mu:       sync.Mutex{},
-		lastTime: time.Time{},
+func New[K comparable, V any](opts *Opts) *Cache[K, V] {
+	c := &Cache[K, V]{
+		mu:          sync.Mutex{},
+		m:           map[K]*list.Element{},
+		lru:         list.New(),
+		epoch:       0,
+		flight:      flight[K, V]{mu: sync.Mutex{}, calls: map[K]*call[V]{}},
+		name:        opts.Name,
+		origin:      uuid.NewString(),
+		bus:         opts.Bus,
+		size:        valueOf(opts.Size, defaultSize),
+		ttl:         valueOf(opts.TTL, defaultTTL),
+		negativeTTL: valueOf(opts.NegativeTTL, defaultNegativeTTL),
 	}
+
+	if c.bus != nil {
+		c.bus.Subscribe(c.name, c.invalidate)
+	}
+
+	return c
 }
 
-func (s *Cache[K, V]) Get(ctx context.Context, key K, cacheSyncFunc func(ctx context.Context) (map[K]V, error)) (V, error) {
-	copyValues := func(values map[K]V) {
-		for key, value := range values {
-			s.m[key] = value
+func (s *Cache[K, V]) Get(ctx context.Context, key K, load Loader[K, V]) (V, error) {
+	if value, found, ok := s.lookup(ctx, key); ok {
+		incrHit(ctx, s.name, found)
+
+		if !found {
+			return value, ErrValueNotFound
 		}
 
-		s.lastTime = time.Now()
+		return value, nil
 	}
 
+	incrMiss(ctx, s.name)
+
+	// Одновременные промахи по одному ключу обслуживаются одной загрузкой.
+	// Отмена контекста одного из ожидающих не должна прерывать загрузку для остальных
+	return s.flight.do(key, func() (V, error) {
+		return s.load(context.WithoutCancel(ctx), key, load)
+	})
+}
+
+// Добавление значения сбрасывает прежнее значение ключа в кешах остальных экземпляров приложения
+func (s *Cache[K, V]) Add(ctx context.Context, key K, value V) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.epoch++
+	s.store(ctx, key, value, true)
+	s.mu.Unlock()
 
-	var (
-		ok    bool
-		value V
-	)
+	s.publish(ctx, key)
+}
 
-	// Синхронизация кеша с истекшим сроком годности
-	if cacheTTL < time.Since(s.lastTime) {
-		values, err := cacheSyncFunc(ctx)
-		if err != nil {
-			return value, err
-		}
+func (s *Cache[K, V]) Del(ctx context.Context, keys ...K) {
+	s.mu.Lock()
+	s.epoch++
 
-		copyValues(values)
+	for _, key := range keys {
+		s.remove(key)
 	}
 
-	// Поиск значения в актуальном кеше
-	if value, ok = s.m[key]; ok {
-		return value, nil
+	s.mu.Unlock()
+
+	s.publish(ctx, keys...)
+}
+
+func (s *Cache[K, V]) lookup(ctx context.Context, key K) (V, bool, bool) {
+	var value V
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.m[key]
+	if !ok {
+		return value, false, false
 	}
 
-	// Синхронизация кеша в случае, когда кеш еще актуален,
-	// а значение могло быть добавлено в хранилище другим экземпляром приложения
-	values, err := cacheSyncFunc(ctx)
+	e := el.Value.(*entry[K, V]) //nolint:forcetypeassert
+
+	if !time.Now().Before(e.expiredAt) {
+		s.remove(key)
+
+		incrEviction(ctx, s.name, EvictionReasonExpired)
+
+		return value, false, false
+	}
+
+	s.lru.MoveToFront(el)
+
+	return e.value, e.found, true
+}
+
+func (s *Cache[K, V]) load(ctx context.Context, key K, load Loader[K, V]) (V, error) {
+	var value V
+
+	s.mu.Lock()
+	epoch := s.epoch
+	s.mu.Unlock()
+
+	values, err := load(ctx, key)
 	if err != nil {
 		return value, err
 	}
 
-	copyValues(values)
+	value, found := values[key]
 
-	if value, ok = s.m[key]; ok {
-		return value, nil
+	s.mu.Lock()
+
+	// Значения, загруженные до сброса ключей, могут быть устаревшими
+	if epoch == s.epoch {
+		for k, v := range values {
+			s.store(ctx, k, v, true)
+		}
+
+		if !found {
+			s.store(ctx, key, value, false)
+		}
+	}
+
+	s.mu.Unlock()
+
+	if !found {
+		return value, ErrValueNotFound
+	}
+
+	return value, nil
+}
+
+// Сохранение значения под блокировкой с вытеснением давно не использованных значений
+func (s *Cache[K, V]) store(ctx context.Context, key K, value V, found bool) {
+	ttl := s.ttl
+
+	if !found {
+		ttl = s.negativeTTL
+	}
+
+	e := &entry[K, V]{
+		key:       key,
+		value:     value,
+		found:     found,
+		expiredAt: time.Now().Add(ttl),
+	}
+
+	if el, ok := s.m[key]; ok {
+		el.Value = e
+		s.lru.MoveToFront(el)
+
+		return
+	}
+
+	s.m[key] = s.lru.PushFront(e)
+
+	for s.lru.Len() > s.size {
+		el := s.lru.Back()
+
+		s.remove(el.Value.(*entry[K, V]).key) //nolint:forcetypeassert
+
+		incrEviction(ctx, s.name, EvictionReasonSize)
+	}
+}
+
+func (s *Cache[K, V]) remove(key K) {
+	if el, ok := s.m[key]; ok {
+		s.lru.Remove(el)
+
+		delete(s.m, key)
+	}
+}
+
+func (s *Cache[K, V]) publish(ctx context.Context, keys ...K) {
+	if s.bus == nil || len(keys) < 1 {
+		return
+	}
+
+	msg := Message{
+		Name:   s.name,
+		Origin: s.origin,
+		Keys:   make([]string, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		msg.Keys = append(msg.Keys, fmt.Sprint(key))
 	}
 
-	return value, ErrValueNotFound
+	s.bus.Publish(ctx, msg)
 }
 
-func (s *Cache[K, V]) Add(key K, value V) {
+// Сброс ключей по сообщению от другого экземпляра приложения
+func (s *Cache[K, V]) invalidate(msg Message) {
+	if msg.Origin == s.origin {
+		return
+	}
+
+	if msg.Flush {
+		s.flush()
+
+		return
+	}
+
+	keys := make(map[string]struct{}, len(msg.Keys))
+
+	for _, key := range msg.Keys {
+		keys[key] = struct{}{}
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.m[key] = value
+	s.epoch++
 
-	s.lastTime = time.Now()
+	// Ключи передаются строками, поэтому сопоставляются по строковому представлению
+	for key := range s.m {
+		if _, ok := keys[fmt.Sprint(key)]; ok {
+			s.remove(key)
+		}
+	}
 }
 
-func (s *Cache[K, V]) Del(key K) {
+// Сброс всех ключей: пропущенные сообщения рассылки не позволяют определить устаревшие значения
+func (s *Cache[K, V]) flush() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.m, key)
+	s.epoch++
+
+	clear(s.m)
+	s.lru.Init()
+}
+
+func valueOf[T comparable](value, def T) T {
+	var zero T
+
+	if value == zero {
+		return def
+	}
+
+	return value
 }
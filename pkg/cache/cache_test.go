@@ -0,0 +1,504 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vtievsky/auth-id/pkg/cache"
+)
+
+var errStorage = errors.New("storage unavailable")
+
+// Хранилище с подсчетом загрузок
+type storage struct {
+	mu     sync.Mutex
+	values map[string]string
+	loads  atomic.Int64
+}
+
+func newStorage(values map[string]string) *storage {
+	return &storage{values: values}
+}
+
+func (s *storage) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.values[key] = value
+}
+
+func (s *storage) load(_ context.Context, key string) (map[string]string, error) {
+	s.loads.Add(1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.values[key]
+	if !ok {
+		return map[string]string{}, nil
+	}
+
+	return map[string]string{key: value}, nil
+}
+
+func get(t *testing.T, c *cache.Cache[string, string], key string, load cache.Loader[string, string]) string {
+	t.Helper()
+
+	value, err := c.Get(context.Background(), key, load)
+	if err != nil {
+		t.Fatalf("failed to get %q: %v", key, err)
+	}
+
+	return value
+}
+
+func TestGetCachesValue(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+	s := newStorage(map[string]string{"a": "1"})
+
+	for range 3 {
+		if value := get(t, c, "a", s.load); value != "1" {
+			t.Fatalf("unexpected value %q", value)
+		}
+	}
+
+	if loads := s.loads.Load(); loads != 1 {
+		t.Fatalf("expected 1 load, got %d", loads)
+	}
+}
+
+func TestGetStoresExtraValues(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+
+	var loads atomic.Int64
+
+	load := func(_ context.Context, _ string) (map[string]string, error) {
+		loads.Add(1)
+
+		return map[string]string{"a": "1", "b": "2"}, nil
+	}
+
+	get(t, c, "a", load)
+
+	if value := get(t, c, "b", load); value != "2" || loads.Load() != 1 {
+		t.Fatalf("extra value not cached: %q, loads %d", value, loads.Load())
+	}
+}
+
+func TestGetLoaderError(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+
+	var loads atomic.Int64
+
+	load := func(_ context.Context, _ string) (map[string]string, error) {
+		loads.Add(1)
+
+		return nil, errStorage
+	}
+
+	for range 2 {
+		if _, err := c.Get(context.Background(), "a", load); !errors.Is(err, errStorage) {
+			t.Fatalf("expected storage error, got %v", err)
+		}
+	}
+
+	// Ошибки загрузки не кешируются
+	if loads.Load() != 2 {
+		t.Fatalf("expected 2 loads, got %d", loads.Load())
+	}
+}
+
+func TestNegativeCaching(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test", NegativeTTL: 50 * time.Millisecond})
+	s := newStorage(map[string]string{})
+
+	for range 3 {
+		if _, err := c.Get(context.Background(), "a", s.load); !errors.Is(err, cache.ErrValueNotFound) {
+			t.Fatalf("expected ErrValueNotFound, got %v", err)
+		}
+	}
+
+	if loads := s.loads.Load(); loads != 1 {
+		t.Fatalf("absence not cached: %d loads", loads)
+	}
+
+	s.set("a", "1")
+
+	// Отсутствие хранится не дольше своего срока
+	time.Sleep(60 * time.Millisecond)
+
+	if value := get(t, c, "a", s.load); value != "1" {
+		t.Fatalf("unexpected value %q", value)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test", TTL: 50 * time.Millisecond})
+	s := newStorage(map[string]string{"a": "1"})
+
+	get(t, c, "a", s.load)
+	s.set("a", "2")
+
+	if value := get(t, c, "a", s.load); value != "1" {
+		t.Fatalf("value expired too early: %q", value)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if value := get(t, c, "a", s.load); value != "2" {
+		t.Fatalf("expired value returned: %q", value)
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test", Size: 2})
+	s := newStorage(map[string]string{"a": "1", "b": "2", "c": "3"})
+
+	get(t, c, "a", s.load)
+	get(t, c, "b", s.load)
+	get(t, c, "a", s.load) // "b" становится давно не использованным
+	get(t, c, "c", s.load) // вытесняет "b"
+
+	loads := s.loads.Load()
+
+	get(t, c, "a", s.load)
+	get(t, c, "c", s.load)
+
+	if s.loads.Load() != loads {
+		t.Fatal("recently used values evicted")
+	}
+
+	get(t, c, "b", s.load)
+
+	if s.loads.Load() != loads+1 {
+		t.Fatal("least recently used value not evicted")
+	}
+}
+
+func TestAddAndDel(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+	s := newStorage(map[string]string{"a": "1"})
+
+	c.Add(context.Background(), "a", "added")
+
+	if value := get(t, c, "a", s.load); value != "added" || s.loads.Load() != 0 {
+		t.Fatalf("added value not used: %q", value)
+	}
+
+	c.Del(context.Background(), "a")
+
+	if value := get(t, c, "a", s.load); value != "1" || s.loads.Load() != 1 {
+		t.Fatalf("deleted value used: %q", value)
+	}
+}
+
+func TestSingleflight(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+
+	var (
+		loads   atomic.Int64
+		release = make(chan struct{})
+	)
+
+	load := func(_ context.Context, key string) (map[string]string, error) {
+		loads.Add(1)
+		<-release
+
+		return map[string]string{key: "1"}, nil
+	}
+
+	const callers = 16
+
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Int64
+	)
+
+	for range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if value, err := c.Get(context.Background(), "a", load); err != nil || value != "1" {
+				failed.Add(1)
+			}
+		}()
+	}
+
+	// Ожидающие успевают присоединиться к загрузке
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if failed.Load() != 0 {
+		t.Fatalf("%d callers failed", failed.Load())
+	}
+
+	if loads.Load() != 1 {
+		t.Fatalf("expected 1 load, got %d", loads.Load())
+	}
+}
+
+func TestSingleflightCancelledCaller(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+
+	release := make(chan struct{})
+
+	load := func(ctx context.Context, key string) (map[string]string, error) {
+		<-release
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		return map[string]string{key: "1"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := c.Get(ctx, "a", load)
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	close(release)
+
+	// Отмена контекста инициатора не прерывает загрузку
+	if err := <-done; err != nil {
+		t.Fatalf("load cancelled with caller context: %v", err)
+	}
+}
+
+func TestLoaderPanic(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+
+	var (
+		calls   atomic.Int64
+		release = make(chan struct{})
+	)
+
+	load := func(_ context.Context, key string) (map[string]string, error) {
+		if calls.Add(1) == 1 {
+			<-release
+
+			panic("loader failure")
+		}
+
+		return map[string]string{key: "1"}, nil
+	}
+
+	const callers = 4
+
+	errs := make(chan error, callers)
+
+	for range callers {
+		go func() {
+			_, err := c.Get(context.Background(), "a", load)
+			errs <- err
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	// Все ожидающие освобождаются с ошибкой
+	for range callers {
+		select {
+		case err := <-errs:
+			if !errors.Is(err, cache.ErrLoaderPanic) {
+				t.Fatalf("expected ErrLoaderPanic, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("waiters blocked after loader panic")
+		}
+	}
+
+	// Ключ снова загружается
+	if value := get(t, c, "a", load); value != "1" {
+		t.Fatalf("unexpected value %q", value)
+	}
+}
+
+// Значение, загруженное до сброса ключа, не сохраняется
+func TestEpochDiscardsStaleLoad(t *testing.T) {
+	c := cache.New[string, string](&cache.Opts{Name: "test"})
+	s := newStorage(map[string]string{"a": "stale"})
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		first   atomic.Bool
+	)
+
+	load := func(ctx context.Context, key string) (map[string]string, error) {
+		values, err := s.load(ctx, key)
+
+		if first.CompareAndSwap(false, true) {
+			close(started)
+			<-release
+		}
+
+		return values, err
+	}
+
+	done := make(chan string, 1)
+
+	go func() {
+		value, _ := c.Get(context.Background(), "a", load)
+		done <- value
+	}()
+
+	<-started
+
+	// Изменение во время загрузки
+	s.set("a", "fresh")
+	c.Del(context.Background(), "a")
+	close(release)
+
+	if value := <-done; value != "stale" {
+		t.Fatalf("unexpected loaded value %q", value)
+	}
+
+	if value := get(t, c, "a", load); value != "fresh" {
+		t.Fatalf("stale value cached: %q", value)
+	}
+}
+
+func TestBusInvalidation(t *testing.T) {
+	bus := cache.NewMemoryBus()
+	s := newStorage(map[string]string{"1": "a"})
+
+	first := cache.New[string, string](&cache.Opts{Name: "users", Bus: bus})
+	second := cache.New[string, string](&cache.Opts{Name: "users", Bus: bus})
+	other := cache.New[string, string](&cache.Opts{Name: "roles", Bus: bus})
+
+	get(t, first, "1", s.load)
+	get(t, second, "1", s.load)
+	get(t, other, "1", s.load)
+
+	s.set("1", "b")
+	first.Del(context.Background(), "1")
+
+	if value := get(t, second, "1", s.load); value != "b" {
+		t.Fatalf("key not invalidated by bus: %q", value)
+	}
+
+	// Сброс касается только кешей с тем же именем
+	if value := get(t, other, "1", s.load); value != "a" {
+		t.Fatalf("foreign cache invalidated: %q", value)
+	}
+
+	// Добавление значения сбрасывает его в других экземплярах
+	first.Add(context.Background(), "1", "c")
+	s.set("1", "c")
+
+	if value := get(t, second, "1", s.load); value != "c" {
+		t.Fatalf("key not invalidated on add: %q", value)
+	}
+
+	if value := get(t, first, "1", s.load); value != "c" {
+		t.Fatalf("sender lost added value: %q", value)
+	}
+}
+
+func TestBusInvalidationNonStringKeys(t *testing.T) {
+	bus := cache.NewMemoryBus()
+
+	load := func(_ context.Context, key uint64) (map[uint64]string, error) {
+		return map[uint64]string{key: fmt.Sprint("v", key)}, nil
+	}
+
+	first := cache.New[uint64, string](&cache.Opts{Name: "ids", Bus: bus})
+	second := cache.New[uint64, string](&cache.Opts{Name: "ids", Bus: bus})
+
+	second.Add(context.Background(), 42, "stale") //nolint:mnd
+
+	first.Del(context.Background(), 42) //nolint:mnd
+
+	value, err := second.Get(context.Background(), 42, load) //nolint:mnd
+	if err != nil || value != "v42" {
+		t.Fatalf("numeric key not invalidated: %q, %v", value, err)
+	}
+}
+
+// После переподключения рассылки сбрасываются все ключи, включая отсутствующие значения
+func TestBusFlush(t *testing.T) {
+	bus := cache.NewMemoryBus()
+	s := newStorage(map[string]string{"a": "1"})
+
+	users := cache.New[string, string](&cache.Opts{Name: "users", Bus: bus})
+	roles := cache.New[string, string](&cache.Opts{Name: "roles", Bus: bus, NegativeTTL: time.Hour})
+
+	get(t, users, "a", s.load)
+
+	if _, err := roles.Get(context.Background(), "b", s.load); !errors.Is(err, cache.ErrValueNotFound) {
+		t.Fatalf("expected ErrValueNotFound, got %v", err)
+	}
+
+	// Изменения, сообщения о которых потеряны за время разрыва
+	s.set("a", "2")
+	s.set("b", "3")
+
+	bus.Flush()
+
+	if value := get(t, users, "a", s.load); value != "2" {
+		t.Fatalf("stale value after flush: %q", value)
+	}
+
+	if value := get(t, roles, "b", s.load); value != "3" {
+		t.Fatalf("stale absence after flush: %q", value)
+	}
+}
+
+// Загрузка, начатая до переподключения, не сохраняется
+func TestBusFlushDiscardsStaleLoad(t *testing.T) {
+	bus := cache.NewMemoryBus()
+	c := cache.New[string, string](&cache.Opts{Name: "users", Bus: bus})
+	s := newStorage(map[string]string{"a": "stale"})
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		first   atomic.Bool
+	)
+
+	load := func(ctx context.Context, key string) (map[string]string, error) {
+		values, err := s.load(ctx, key)
+
+		if first.CompareAndSwap(false, true) {
+			close(started)
+			<-release
+		}
+
+		return values, err
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, _ = c.Get(context.Background(), "a", load)
+	}()
+
+	<-started
+
+	s.set("a", "fresh")
+	bus.Flush()
+	close(release)
+	<-done
+
+	if value := get(t, c, "a", load); value != "fresh" {
+		t.Fatalf("stale value cached: %q", value)
+	}
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+)
+
+type call[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+// Объединение одновременных загрузок одного ключа
+type flight[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+func (s *flight[K, V]) do(key K, fn func() (V, error)) (V, error) {
+	s.mu.Lock()
+
+	if c, ok := s.calls[key]; ok {
+		s.mu.Unlock()
+		c.wg.Wait()
+
+		return c.value, c.err
+	}
+
+	c := &call[V]{} //nolint:exhaustruct
+	c.wg.Add(1)
+	s.calls[key] = c
+	s.mu.Unlock()
+
+	s.call(key, c, fn)
+
+	return c.value, c.err
+}
+
+// Паника загрузки возвращается ошибкой всем ожидающим, иначе они остались бы заблокированы,
+// а ключ - недоступен до перезапуска
+func (s *flight[K, V]) call(key K, c *call[V], fn func() (V, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero V
+
+			c.value, c.err = zero, fmt.Errorf("%w: %v", ErrLoaderPanic, r)
+		}
+
+		s.mu.Lock()
+		delete(s.calls, key)
+		s.mu.Unlock()
+
+		c.wg.Done()
+	}()
+
+	c.value, c.err = fn()
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	EvictionReasonSize    = "size"
+	EvictionReasonExpired = "expired"
+)
+
+var (
+	meter           = otel.Meter("cache") //nolint:gochecknoglobals
+	hitCounter      metric.Int64Counter   //nolint:gochecknoglobals
+	missCounter     metric.Int64Counter   //nolint:gochecknoglobals
+	evictionCounter metric.Int64Counter   //nolint:gochecknoglobals
+)
+
+func init() { //nolint:gochecknoinits
+	hits, err := meter.Int64Counter(
+		"cache_hits",
+		metric.WithDescription("The count of cache hits"),
+		metric.WithUnit(""),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error while create cache_hits metric | %w", err))
+	}
+
+	hitCounter = hits
+
+	misses, err := meter.Int64Counter(
+		"cache_misses",
+		metric.WithDescription("The count of cache misses"),
+		metric.WithUnit(""),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error while create cache_misses metric | %w", err))
+	}
+
+	missCounter = misses
+
+	evictions, err := meter.Int64Counter(
+		"cache_evictions",
+		metric.WithDescription("The count of cache evictions"),
+		metric.WithUnit(""),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error while create cache_evictions metric | %w", err))
+	}
+
+	evictionCounter = evictions
+}
+
+// Инкремент счетчика попаданий, в том числе в закешированное отсутствие значения
+func incrHit(ctx context.Context, name string, found bool) {
+	hitCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.String("cache", name),
+				attribute.Bool("found", found),
+			),
+		),
+	)
+}
+
+// Инкремент счетчика промахов
+func incrMiss(ctx context.Context, name string) {
+	missCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.String("cache", name),
+			),
+		),
+	)
+}
+
+// Инкремент счетчика вытеснений
+func incrEviction(ctx context.Context, name, reason string) {
+	evictionCounter.Add(
+		ctx,
+		1,
+		metric.WithAttributeSet(
+			attribute.NewSet(
+				attribute.String("cache", name),
+				attribute.String("reason", reason),
+			),
+		),
+	)
+}
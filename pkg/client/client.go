@@ -0,0 +1,308 @@
+// Пакет client - типизированный клиент REST API сервиса auth-id
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+const (
+	defaultRefreshSkew    = time.Second * 30
+	defaultRequestTimeout = time.Second * 10
+)
+
+type (
+	User          = clienthttp.User
+	UserRole      = clienthttp.UserRole
+	UserPrivilege = clienthttp.UserPrivilege
+	Role          = clienthttp.Role
+	RoleUser      = clienthttp.RoleUser
+	RolePrivilege = clienthttp.RolePrivilege
+	Privilege     = clienthttp.Privilege
+	Session       = clienthttp.Session
+
+	WebAuthnCredential = clienthttp.WebAuthnCredential
+	PasswordViolation  = clienthttp.PasswordViolation
+)
+
+type Opts struct {
+	URL         string                     // Адрес сервиса auth-id
+	HTTPClient  clienthttp.HttpRequestDoer // По умолчанию http.Client с таймаутом 10s
+	Login       string                     // Учетные данные для автоматического входа (необязательны)
+	Password    string
+	Device      string        // Название устройства в списке сессий пользователя (необязательно)
+	RefreshSkew time.Duration // Запас времени до истечения access-токена, после которого он обновляется
+}
+
+type Client struct {
+	api         *clienthttp.ClientWithResponses
+	raw         *clienthttp.ClientWithResponses // Без авторизации, для входа и обновления токенов
+	login       string
+	password    string
+	device      *string
+	refreshSkew time.Duration
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiredAt    time.Time
+}
+
+func New(opts *Opts) (*Client, error) {
+	const op = "client.New"
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultRequestTimeout} //nolint:exhaustruct
+	}
+
+	refreshSkew := opts.RefreshSkew
+	if refreshSkew <= 0 {
+		refreshSkew = defaultRefreshSkew
+	}
+
+	var device *string
+	if opts.Device != "" {
+		device = &opts.Device
+	}
+
+	c := &Client{
+		api:          nil,
+		raw:          nil,
+		login:        opts.Login,
+		password:     opts.Password,
+		device:       device,
+		refreshSkew:  refreshSkew,
+		mu:           sync.Mutex{},
+		accessToken:  "",
+		refreshToken: "",
+		expiredAt:    time.Time{},
+	}
+
+	raw, err := clienthttp.NewClientWithResponses(opts.URL, clienthttp.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client | %s:%w", op, err)
+	}
+
+	api, err := clienthttp.NewClientWithResponses(opts.URL,
+		clienthttp.WithHTTPClient(httpClient),
+		clienthttp.WithRequestEditorFn(c.authorize),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client | %s:%w", op, err)
+	}
+
+	c.raw, c.api = raw, api
+
+	return c, nil
+}
+
+// Сгенерированный клиент для вызовов, не покрытых оберткой. Токен подставляется автоматически
+func (c *Client) API() *clienthttp.ClientWithResponses {
+	return c.api
+}
+
+// Вход пользователя. Полученные токены используются для последующих запросов
+func (c *Client) Login(ctx context.Context, login, password string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.doLogin(ctx, login, password)
+}
+
+// Завершение входа кодом второго фактора или кодом восстановления
+func (c *Client) CompleteMFA(ctx context.Context, challenge, code string) error {
+	const op = "Client.CompleteMFA"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp, err := c.raw.CompleteMFAWithResponse(ctx, clienthttp.CompleteMFARequest{
+		Challenge: challenge,
+		Code:      code,
+		Device:    c.device,
+	})
+	if err == nil && resp.JSON403 != nil {
+		err = &PasswordExpiredError{
+			Challenge: resp.JSON403.Data.Challenge,
+		}
+	}
+
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to complete mfa | %s:%w", op, err)
+	}
+
+	c.setTokens(resp.JSON200.Data.AccessToken, resp.JSON200.Data.RefreshToken)
+
+	return nil
+}
+
+// Завершение входа сменой пароля с истекшим сроком действия
+func (c *Client) CompletePasswordChange(ctx context.Context, challenge, password string) error {
+	const op = "Client.CompletePasswordChange"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp, err := c.raw.CompletePasswordChangeWithResponse(ctx, clienthttp.CompletePasswordChangeRequest{
+		Challenge: challenge,
+		Password:  password,
+		Device:    c.device,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to complete password change | %s:%w", op, err)
+	}
+
+	c.setTokens(resp.JSON200.Data.AccessToken, resp.JSON200.Data.RefreshToken)
+
+	return nil
+}
+
+// Использование ранее полученной пары токенов
+func (c *Client) SetTokens(accessToken, refreshToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setTokens(accessToken, refreshToken)
+}
+
+func (c *Client) Tokens() (string, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.accessToken, c.refreshToken
+}
+
+// Действующий access-токен. Истекающий токен обновляется по refresh-токену,
+// а при невозможности обновления выполняется повторный вход, если заданы учетные данные
+func (c *Client) AccessToken(ctx context.Context) (string, error) {
+	const op = "Client.AccessToken"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && c.refreshSkew < time.Until(c.expiredAt) {
+		return c.accessToken, nil
+	}
+
+	var err error
+
+	if c.refreshToken != "" {
+		if err = c.doRefresh(ctx); err == nil {
+			return c.accessToken, nil
+		}
+	}
+
+	if c.login == "" {
+		if err == nil {
+			err = ErrNotAuthenticated
+		}
+
+		return "", fmt.Errorf("failed to get access token | %s:%w", op, err)
+	}
+
+	if err = c.doLogin(ctx, c.login, c.password); err != nil {
+		return "", fmt.Errorf("failed to get access token | %s:%w", op, err)
+	}
+
+	return c.accessToken, nil
+}
+
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	token, err := c.AccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return nil
+}
+
+func (c *Client) doLogin(ctx context.Context, login, password string) error {
+	const op = "Client.Login"
+
+	resp, err := c.raw.LoginWithResponse(ctx, login, clienthttp.LoginRequest{
+		Password: password,
+		Device:   c.device,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to login | %s:%w", op, err)
+	}
+
+	// Вход требует подтверждения вторым фактором через CompleteMFA
+	if resp.JSON202 != nil {
+		return fmt.Errorf("failed to login | %s:%w", op, &MFARequiredError{
+			Challenge: resp.JSON202.Data.Challenge,
+		})
+	}
+
+	// Вход требует смены пароля через CompletePasswordChange
+	if resp.JSON403 != nil {
+		return fmt.Errorf("failed to login | %s:%w", op, &PasswordExpiredError{
+			Challenge: resp.JSON403.Data.Challenge,
+		})
+	}
+
+	if err = check(resp.StatusCode(), resp.Body); err != nil {
+		return fmt.Errorf("failed to login | %s:%w", op, err)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("failed to login | %s:%w", op, ErrUnexpectedResponse)
+	}
+
+	c.setTokens(resp.JSON200.Data.AccessToken, resp.JSON200.Data.RefreshToken)
+
+	return nil
+}
+
+func (c *Client) doRefresh(ctx context.Context) error {
+	const op = "Client.Refresh"
+
+	resp, err := c.raw.RefreshSessionWithResponse(ctx, clienthttp.RefreshSessionRequest{
+		RefreshToken: c.refreshToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refresh session | %s:%w", op, err)
+	}
+
+	if err = check(resp.StatusCode(), resp.Body); err != nil {
+		return fmt.Errorf("failed to refresh session | %s:%w", op, err)
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("failed to refresh session | %s:%w", op, ErrUnexpectedResponse)
+	}
+
+	c.setTokens(resp.JSON200.Data.AccessToken, resp.JSON200.Data.RefreshToken)
+
+	return nil
+}
+
+func (c *Client) setTokens(accessToken, refreshToken string) {
+	c.accessToken = accessToken
+	c.refreshToken = refreshToken
+	c.expiredAt = time.Time{}
+
+	// Подпись проверяется сервисом, клиенту нужен только срок действия
+	var claims jwt.RegisteredClaims
+
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, &claims); err == nil && claims.ExpiresAt != nil {
+		c.expiredAt = claims.ExpiresAt.Time
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	ErrNotAuthenticated   = errors.New("client not authenticated")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+)
+
+// Ошибка, возвращенная сервисом auth-id в поле status ответа
+type Error struct {
+	StatusCode  int
+	Code        string
+	Description string
+	Violations  []PasswordViolation // Нарушения правил выбора пароля при ответе 422
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("auth-id: %d %s: %s", e.StatusCode, e.Code, e.Description)
+}
+
+// Пароль принят, для получения токенов нужен код второго фактора
+type MFARequiredError struct {
+	Challenge string
+}
+
+func (e *MFARequiredError) Error() string {
+	return "auth-id: mfa code required"
+}
+
+// Срок действия пароля истек, для получения токенов нужна смена пароля
+type PasswordExpiredError struct {
+	Challenge string
+}
+
+func (e *PasswordExpiredError) Error() string {
+	return "auth-id: password expired"
+}
+
+// Извлечение ошибки сервиса из цепочки ошибок
+func AsError(err error) (*Error, bool) {
+	var e *Error
+
+	ok := errors.As(err, &e)
+
+	return e, ok
+}
+
+func check(statusCode int, body []byte) error {
+	if statusCode == http.StatusOK {
+		return nil
+	}
+
+	var resp struct {
+		Status struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+		} `json:"status"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Status.Code == "" {
+		return &Error{
+			StatusCode:  statusCode,
+			Code:        "",
+			Description: http.StatusText(statusCode),
+			Violations:  nil,
+		}
+	}
+
+	var violations []PasswordViolation
+
+	if statusCode == http.StatusUnprocessableEntity {
+		var data struct {
+			Data []PasswordViolation `json:"data"`
+		}
+
+		if err := json.Unmarshal(body, &data); err == nil {
+			violations = data.Data
+		}
+	}
+
+	return &Error{
+		StatusCode:  statusCode,
+		Code:        resp.Status.Code,
+		Description: resp.Status.Description,
+		Violations:  violations,
+	}
+}
+
+// Проверка статуса ответа и наличия разобранного тела
+func verify(statusCode int, body []byte, parsed bool) error {
+	if err := check(statusCode, body); err != nil {
+		return err
+	}
+
+	if !parsed {
+		return ErrUnexpectedResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+// Пользователь, от имени которого выполняются запросы
+func (c *Client) GetMe(ctx context.Context) (*User, error) {
+	const op = "Client.GetMe"
+
+	resp, err := c.api.GetMeWithResponse(ctx)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get me | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+// Собственные сессии, текущая сессия клиента отмечена признаком Current
+func (c *Client) GetMySessions(ctx context.Context, pageSize, offset uint32) ([]Session, error) {
+	const op = "Client.GetMySessions"
+
+	resp, err := c.api.GetMySessionsWithResponse(ctx, &clienthttp.GetMySessionsParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my sessions | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) MySessions(ctx context.Context, pageSize uint32) iter.Seq2[Session, error] {
+	return paginate(ctx, pageSize, c.GetMySessions)
+}
+
+func (c *Client) DeleteMySession(ctx context.Context, sessionID string) error {
+	const op = "Client.DeleteMySession"
+
+	resp, err := c.api.DeleteMySessionWithResponse(ctx, sessionID)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete my session | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Выход: завершение текущей сессии. Токены и учетные данные клиента забываются,
+// чтобы последующие запросы не открыли новую сессию повторным входом
+func (c *Client) Logout(ctx context.Context) error {
+	const op = "Client.Logout"
+
+	resp, err := c.api.DeleteCurrentSessionWithResponse(ctx)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to logout | %s:%w", op, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.login, c.password = "", ""
+	c.setTokens("", "")
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+const defaultPageSize = 100
+
+type pageFunc[T any] func(ctx context.Context, pageSize, offset uint32) ([]T, error)
+
+// Последовательный обход всех страниц списка
+func paginate[T any](ctx context.Context, pageSize uint32, fetch pageFunc[T]) iter.Seq2[T, error] {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		var offset uint32
+
+		for {
+			page, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if uint32(len(page)) < pageSize { //nolint:gosec
+				return
+			}
+
+			offset += pageSize
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+func (c *Client) GetPrivileges(ctx context.Context, pageSize, offset uint32) ([]Privilege, error) {
+	const op = "Client.GetPrivileges"
+
+	resp, err := c.api.GetPrivilegesWithResponse(ctx, &clienthttp.GetPrivilegesParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get privileges | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) Privileges(ctx context.Context, pageSize uint32) iter.Seq2[Privilege, error] {
+	return paginate(ctx, pageSize, c.GetPrivileges)
+}
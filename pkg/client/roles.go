@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+func (c *Client) GetRole(ctx context.Context, code string) (*Role, error) {
+	const op = "Client.GetRole"
+
+	resp, err := c.api.GetRoleWithResponse(ctx, code)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) GetRoles(ctx context.Context, pageSize, offset uint32) ([]Role, error) {
+	const op = "Client.GetRoles"
+
+	resp, err := c.api.GetRolesWithResponse(ctx, &clienthttp.GetRolesParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+// Обход всех ролей постранично
+func (c *Client) Roles(ctx context.Context, pageSize uint32) iter.Seq2[Role, error] {
+	return paginate(ctx, pageSize, c.GetRoles)
+}
+
+func (c *Client) CreateRole(ctx context.Context, role clienthttp.CreateRoleRequest) (*Role, error) {
+	const op = "Client.CreateRole"
+
+	resp, err := c.api.CreateRoleWithResponse(ctx, role)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) UpdateRole(ctx context.Context, code string, role clienthttp.UpdateRoleRequest) (*Role, error) {
+	const op = "Client.UpdateRole"
+
+	resp, err := c.api.UpdateRoleWithResponse(ctx, code, role)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update role | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) DeleteRole(ctx context.Context, code string) error {
+	const op = "Client.DeleteRole"
+
+	resp, err := c.api.DeleteRoleWithResponse(ctx, code)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete role | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) GetRoleUsers(ctx context.Context, code string, pageSize, offset uint32) ([]RoleUser, error) {
+	const op = "Client.GetRoleUsers"
+
+	resp, err := c.api.GetRoleUsersWithResponse(ctx, code, &clienthttp.GetRoleUsersParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role users | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) RoleUsers(ctx context.Context, code string, pageSize uint32) iter.Seq2[RoleUser, error] {
+	return paginate(ctx, pageSize, func(ctx context.Context, pageSize, offset uint32) ([]RoleUser, error) {
+		return c.GetRoleUsers(ctx, code, pageSize, offset)
+	})
+}
+
+func (c *Client) AddRoleUser(ctx context.Context, code, login string, dateIn, dateOut time.Time) error {
+	const op = "Client.AddRoleUser"
+
+	resp, err := c.api.AddRoleUserWithResponse(ctx, code, login, clienthttp.AddRoleUserRequest{
+		DateIn:  openapitypes.Date{Time: dateIn},
+		DateOut: openapitypes.Date{Time: dateOut},
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to add role user | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateRoleUser(ctx context.Context, code, login string, dateIn, dateOut time.Time) error {
+	const op = "Client.UpdateRoleUser"
+
+	resp, err := c.api.UpdateRoleUserWithResponse(ctx, code, login, clienthttp.UpdateRoleUserRequest{
+		DateIn:  openapitypes.Date{Time: dateIn},
+		DateOut: openapitypes.Date{Time: dateOut},
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update role user | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteRoleUser(ctx context.Context, code, login string) error {
+	const op = "Client.DeleteRoleUser"
+
+	resp, err := c.api.DeleteRoleUserWithResponse(ctx, code, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete role user | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) GetRolePrivileges(ctx context.Context, code string, pageSize, offset uint32) ([]RolePrivilege, error) {
+	const op = "Client.GetRolePrivileges"
+
+	resp, err := c.api.GetRolePrivilegesWithResponse(ctx, code, &clienthttp.GetRolePrivilegesParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role privileges | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) RolePrivileges(ctx context.Context, code string, pageSize uint32) iter.Seq2[RolePrivilege, error] {
+	return paginate(ctx, pageSize, func(ctx context.Context, pageSize, offset uint32) ([]RolePrivilege, error) {
+		return c.GetRolePrivileges(ctx, code, pageSize, offset)
+	})
+}
+
+func (c *Client) AddRolePrivilege(ctx context.Context, code, privilegeCode string, allowed bool) error {
+	const op = "Client.AddRolePrivilege"
+
+	resp, err := c.api.AddRolePrivilegeWithResponse(ctx, code, privilegeCode, clienthttp.AddRolePrivilegeRequest{
+		Allowed: allowed,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to add role privilege | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateRolePrivilege(ctx context.Context, code, privilegeCode string, allowed bool) error {
+	const op = "Client.UpdateRolePrivilege"
+
+	resp, err := c.api.UpdateRolePrivilegeWithResponse(ctx, code, privilegeCode, clienthttp.UpdateRolePrivilegeRequest{
+		Allowed: allowed,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update role privilege | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteRolePrivilege(ctx context.Context, code, privilegeCode string) error {
+	const op = "Client.DeleteRolePrivilege"
+
+	resp, err := c.api.DeleteRolePrivilegeWithResponse(ctx, code, privilegeCode)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete role privilege | %s:%w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+func (c *Client) GetUserSessions(ctx context.Context, login string, pageSize, offset uint32) ([]Session, error) {
+	const op = "Client.GetUserSessions"
+
+	resp, err := c.api.GetUserSessionsWithResponse(ctx, login, &clienthttp.GetUserSessionsParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user sessions | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) UserSessions(ctx context.Context, login string, pageSize uint32) iter.Seq2[Session, error] {
+	return paginate(ctx, pageSize, func(ctx context.Context, pageSize, offset uint32) ([]Session, error) {
+		return c.GetUserSessions(ctx, login, pageSize, offset)
+	})
+}
+
+func (c *Client) DeleteUserSession(ctx context.Context, login, sessionID string) error {
+	const op = "Client.DeleteUserSession"
+
+	resp, err := c.api.DeleteUserSessionWithResponse(ctx, login, sessionID)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete user session | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Отзыв всех сессий пользователя. С keepCurrent текущая сессия клиента сохраняется
+func (c *Client) DeleteUserSessions(ctx context.Context, login string, keepCurrent bool) error {
+	const op = "Client.DeleteUserSessions"
+
+	resp, err := c.api.DeleteUserSessionsWithResponse(ctx, login, &clienthttp.DeleteUserSessionsParams{
+		KeepCurrent: &keepCurrent,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete user sessions | %s:%w", op, err)
+	}
+
+	return nil
+}
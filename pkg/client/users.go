@@ -0,0 +1,312 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	clienthttp "github.com/vtievsky/auth-id/gen/httpclient/auth-id"
+)
+
+func (c *Client) GetUser(ctx context.Context, login string) (*User, error) {
+	const op = "Client.GetUser"
+
+	resp, err := c.api.GetUserWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) GetUsers(ctx context.Context, pageSize, offset uint32) ([]User, error) {
+	const op = "Client.GetUsers"
+
+	resp, err := c.api.GetUsersWithResponse(ctx, &clienthttp.GetUsersParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+// Обход всех пользователей постранично
+func (c *Client) Users(ctx context.Context, pageSize uint32) iter.Seq2[User, error] {
+	return paginate(ctx, pageSize, c.GetUsers)
+}
+
+func (c *Client) CreateUser(ctx context.Context, user clienthttp.CreateUserRequest) (*User, error) {
+	const op = "Client.CreateUser"
+
+	resp, err := c.api.CreateUserWithResponse(ctx, user)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, login string, user clienthttp.UpdateUserRequest) (*User, error) {
+	const op = "Client.UpdateUser"
+
+	resp, err := c.api.UpdateUserWithResponse(ctx, login, user)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user | %s:%w", op, err)
+	}
+
+	return &resp.JSON200.Data, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, login string) error {
+	const op = "Client.DeleteUser"
+
+	resp, err := c.api.DeleteUserWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete user | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Смена собственного пароля. Текущая сессия клиента сохраняется, остальные сессии пользователя отзываются
+func (c *Client) ChangePass(ctx context.Context, login, current, changed string) error {
+	const op = "Client.ChangePass"
+
+	keepCurrent := true
+
+	resp, err := c.api.ChangePassWithResponse(ctx, login, &clienthttp.ChangePassParams{
+		KeepCurrent: &keepCurrent,
+	}, clienthttp.ChangePassRequest{
+		Current: current,
+		Changed: changed,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to change password | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Сброс пароля администратором, все сессии пользователя отзываются
+func (c *Client) ResetPass(ctx context.Context, login, changed string) error {
+	const op = "Client.ResetPass"
+
+	resp, err := c.api.ResetPassWithResponse(ctx, login, nil, clienthttp.ResetPassRequest{
+		Changed: changed,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to reset password | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Запрос сброса забытого пароля: токен отправляется на адрес электронной почты пользователя
+func (c *Client) RequestPassReset(ctx context.Context, login string) error {
+	const op = "Client.RequestPassReset"
+
+	resp, err := c.api.RequestPassResetWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to request password reset | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Установка нового пароля токеном из письма, все сессии пользователя отзываются
+func (c *Client) CompletePassReset(ctx context.Context, login, token, changed string) error {
+	const op = "Client.CompletePassReset"
+
+	resp, err := c.api.CompletePassResetWithResponse(ctx, login, clienthttp.CompletePassResetRequest{
+		Token:   token,
+		Changed: changed,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to complete password reset | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Снятие временной блокировки входа после неудачных попыток
+func (c *Client) UnlockUser(ctx context.Context, login string) error {
+	const op = "Client.UnlockUser"
+
+	resp, err := c.api.UnlockUserWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to unlock user | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Выпуск секрета TOTP, возвращает секрет для ручного ввода и ссылку otpauth://
+func (c *Client) EnrollTOTP(ctx context.Context, login string) (string, string, error) {
+	const op = "Client.EnrollTOTP"
+
+	resp, err := c.api.EnrollTOTPWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return "", "", fmt.Errorf("failed to enroll totp | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data.Secret, resp.JSON200.Data.Uri, nil
+}
+
+// Подтверждение подключения TOTP, возвращает одноразовые коды восстановления
+func (c *Client) ConfirmTOTP(ctx context.Context, login, code string) ([]string, error) {
+	const op = "Client.ConfirmTOTP"
+
+	resp, err := c.api.ConfirmTOTPWithResponse(ctx, login, clienthttp.ConfirmTOTPRequest{
+		Code: code,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm totp | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data.RecoveryCodes, nil
+}
+
+func (c *Client) DisableTOTP(ctx context.Context, login string) error {
+	const op = "Client.DisableTOTP"
+
+	resp, err := c.api.DisableTOTPWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to disable totp | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+// Ключи WebAuthn пользователя. Регистрация и вход ключом выполняются в браузере
+func (c *Client) GetWebAuthnCredentials(ctx context.Context, login string) ([]WebAuthnCredential, error) {
+	const op = "Client.GetWebAuthnCredentials"
+
+	resp, err := c.api.GetWebAuthnCredentialsWithResponse(ctx, login)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webauthn credentials | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) DeleteWebAuthnCredential(ctx context.Context, login, credentialID string) error {
+	const op = "Client.DeleteWebAuthnCredential"
+
+	resp, err := c.api.DeleteWebAuthnCredentialWithResponse(ctx, login, credentialID)
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to delete webauthn credential | %s:%w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) GetUserRoles(ctx context.Context, login string, pageSize, offset uint32) ([]UserRole, error) {
+	const op = "Client.GetUserRoles"
+
+	resp, err := c.api.GetUserRolesWithResponse(ctx, login, &clienthttp.GetUserRolesParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) UserRoles(ctx context.Context, login string, pageSize uint32) iter.Seq2[UserRole, error] {
+	return paginate(ctx, pageSize, func(ctx context.Context, pageSize, offset uint32) ([]UserRole, error) {
+		return c.GetUserRoles(ctx, login, pageSize, offset)
+	})
+}
+
+func (c *Client) GetUserPrivileges(ctx context.Context, login string, pageSize, offset uint32) ([]UserPrivilege, error) {
+	const op = "Client.GetUserPrivileges"
+
+	resp, err := c.api.GetUserPrivilegesWithResponse(ctx, login, &clienthttp.GetUserPrivilegesParams{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err == nil {
+		err = verify(resp.StatusCode(), resp.Body, resp.JSON200 != nil)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user privileges | %s:%w", op, err)
+	}
+
+	return resp.JSON200.Data, nil
+}
+
+func (c *Client) UserPrivileges(ctx context.Context, login string, pageSize uint32) iter.Seq2[UserPrivilege, error] {
+	return paginate(ctx, pageSize, func(ctx context.Context, pageSize, offset uint32) ([]UserPrivilege, error) {
+		return c.GetUserPrivileges(ctx, login, pageSize, offset)
+	})
+}
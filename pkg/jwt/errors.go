@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrTokenParse         = errors.New("there was an error in parsing")
 	ErrTokenClaimsInvalid = errors.New("token claims invalid")
+	ErrTokenExpired       = errors.New("token is expired")
+	ErrKeyParse           = errors.New("there was an error in parsing key")
+	ErrKeyUnsupported     = errors.New("key unsupported")
+	ErrKeyNotFound        = errors.New("key not found")
 )
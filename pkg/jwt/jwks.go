@@ -0,0 +1,171 @@
+package authidjwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Открытый ключ в формате JWK (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Набор открытых ключей. Симметричные ключи в набор не попадают
+func NewJWKSet(keys ...*Key) JWKSet {
+	set := JWKSet{
+		Keys: make([]JWK, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		if jwk, ok := key.JWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+
+	return set
+}
+
+func (k *Key) JWK() (JWK, bool) {
+	jwk := JWK{
+		Use: "sig",
+		Alg: k.Method.Alg(),
+		Kid: k.ID,
+	}
+
+	switch v := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeBase64(v.N.Bytes())
+		jwk.E = encodeBase64(big.NewInt(int64(v.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (v.Curve.Params().BitSize + 7) / 8 //nolint:mnd
+
+		jwk.Kty = "EC"
+		jwk.Crv = v.Curve.Params().Name
+		jwk.X = encodeBase64(v.X.FillBytes(make([]byte, size)))
+		jwk.Y = encodeBase64(v.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encodeBase64(v)
+	default:
+		return JWK{}, false
+	}
+
+	return jwk, true
+}
+
+// Ключ проверки подписи по открытому ключу JWK. Подписывать токены таким ключом нельзя
+func (j JWK) Key() (*Key, error) {
+	key := &Key{
+		ID:        j.Kid,
+		Method:    nil,
+		signKey:   nil,
+		verifyKey: nil,
+	}
+
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBase64(j.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBase64(j.E)
+		if err != nil {
+			return nil, err
+		}
+
+		key.Method = jwt.SigningMethodRS256
+		key.verifyKey = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	case "EC":
+		var curve elliptic.Curve
+
+		switch j.Crv {
+		case "P-256":
+			curve, key.Method = elliptic.P256(), jwt.SigningMethodES256
+		case "P-384":
+			curve, key.Method = elliptic.P384(), jwt.SigningMethodES384
+		case "P-521":
+			curve, key.Method = elliptic.P521(), jwt.SigningMethodES512
+		default:
+			return nil, fmt.Errorf("%w | ecdsa curve %s", ErrKeyUnsupported, j.Crv)
+		}
+
+		x, err := decodeBase64(j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBase64(j.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		key.verifyKey = &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w | okp curve %s", ErrKeyUnsupported, j.Crv)
+		}
+
+		x, err := decodeBase64(j.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w | ed25519 key size %d", ErrKeyParse, len(x))
+		}
+
+		key.Method = jwt.SigningMethodEdDSA
+		key.verifyKey = ed25519.PublicKey(x)
+	default:
+		return nil, fmt.Errorf("%w | kty %s", ErrKeyUnsupported, j.Kty)
+	}
+
+	// Алгоритм из JWK должен соответствовать типу ключа
+	if j.Alg != "" && j.Alg != key.Method.Alg() {
+		return nil, fmt.Errorf("%w | alg %s for kty %s", ErrKeyUnsupported, j.Alg, j.Kty)
+	}
+
+	return key, nil
+}
+
+func encodeBase64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w | %v", ErrKeyParse, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,155 @@
+package authidjwt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Набор ключей для проверки подписи токена
+type KeySet interface {
+	Lookup(kid string) (*Key, error)
+}
+
+// Набор ключей, поиск в котором может потребовать запроса к удаленному сервису
+type ContextKeySet interface {
+	KeySet
+	LookupContext(ctx context.Context, kid string) (*Key, error)
+}
+
+// Ключ как набор из одного элемента
+func (k *Key) Lookup(kid string) (*Key, error) {
+	// Токены, выпущенные до появления kid, проверяются текущим ключом
+	if kid != "" && kid != k.ID {
+		return nil, fmt.Errorf("%w | kid %q", ErrKeyNotFound, kid)
+	}
+
+	return k, nil
+}
+
+// Связка ключей: один действующий ключ подписи и выведенные из оборота ключи,
+// которые используются только для проверки ранее выпущенных токенов
+type KeyRing struct {
+	mu     sync.RWMutex
+	active *Key
+	keys   []*Key
+}
+
+func NewKeyRing(active *Key, retired ...*Key) *KeyRing {
+	r := &KeyRing{
+		mu:     sync.RWMutex{},
+		active: active,
+		keys:   make([]*Key, 0, len(retired)+1),
+	}
+
+	r.keys = append(r.keys, active)
+
+	for _, key := range retired {
+		if r.find(key.ID) == nil {
+			r.keys = append(r.keys, key)
+		}
+	}
+
+	return r
+}
+
+// Действующий ключ подписи
+func (r *KeyRing) Active() *Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.active
+}
+
+// Все ключи связки, действующий ключ первый
+func (r *KeyRing) Keys() []*Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]*Key, 0, len(r.keys))
+	keys = append(keys, r.active)
+
+	for _, key := range r.keys {
+		if key != r.active {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (r *KeyRing) Lookup(kid string) (*Key, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if kid == "" {
+		return r.active, nil
+	}
+
+	if key := r.find(kid); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%w | kid %q", ErrKeyNotFound, kid)
+}
+
+// Назначение ключа действующим. Предыдущий ключ остается в связке
+// и продолжает проверять выпущенные им токены
+func (r *KeyRing) Promote(kid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := r.find(kid)
+	if key == nil {
+		return fmt.Errorf("%w | kid %q", ErrKeyNotFound, kid)
+	}
+
+	r.active = key
+
+	return nil
+}
+
+// Добавление ключа для проверки токенов. Ключ с тем же идентификатором не заменяется
+func (r *KeyRing) Add(key *Key) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.find(key.ID) != nil {
+		return false
+	}
+
+	r.keys = append(r.keys, key)
+
+	return true
+}
+
+// Удаление выведенного из оборота ключа. Действующий ключ не удаляется
+func (r *KeyRing) Remove(kid string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active.ID == kid {
+		return false
+	}
+
+	for i, key := range r.keys {
+		if key.ID == kid {
+			r.keys = slices.Delete(r.keys, i, i+1)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *KeyRing) find(kid string) *Key {
+	for _, key := range r.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package authidjwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	DefaultHMACKeyID = "default"
+	minRSAKeyBits    = 2048
+)
+
+// Ключ подписи токенов.
+// Для HMAC ключ подписи и проверки совпадают и не публикуются,
+// для RSA, ECDSA и Ed25519 публикуется только открытая часть
+type Key struct {
+	ID        string
+	Method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+func NewHMACKey(id string, secret []byte) *Key {
+	if id == "" {
+		id = DefaultHMACKeyID
+	}
+
+	return &Key{
+		ID:        id,
+		Method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}
+}
+
+// Создает ключ по закрытому ключу RSA, ECDSA или Ed25519.
+// Если идентификатор не указан, используется отпечаток открытого ключа (RFC 7638)
+func NewKey(id string, private crypto.PrivateKey) (*Key, error) {
+	var (
+		method jwt.SigningMethod
+		public crypto.PublicKey
+	)
+
+	switch v := private.(type) {
+	case *rsa.PrivateKey:
+		if v.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("%w | rsa key size %d", ErrKeyUnsupported, v.N.BitLen())
+		}
+
+		method, public = jwt.SigningMethodRS256, &v.PublicKey
+	case *ecdsa.PrivateKey:
+		switch v.Curve {
+		case elliptic.P256():
+			method = jwt.SigningMethodES256
+		case elliptic.P384():
+			method = jwt.SigningMethodES384
+		case elliptic.P521():
+			method = jwt.SigningMethodES512
+		default:
+			return nil, fmt.Errorf("%w | ecdsa curve %s", ErrKeyUnsupported, v.Curve.Params().Name)
+		}
+
+		public = &v.PublicKey
+	case ed25519.PrivateKey:
+		method, public = jwt.SigningMethodEdDSA, v.Public()
+	default:
+		return nil, fmt.Errorf("%w | %T", ErrKeyUnsupported, private)
+	}
+
+	key := &Key{
+		ID:        id,
+		Method:    method,
+		signKey:   private,
+		verifyKey: public,
+	}
+
+	if key.ID == "" {
+		thumbprint, err := key.Thumbprint()
+		if err != nil {
+			return nil, err
+		}
+
+		key.ID = thumbprint
+	}
+
+	return key, nil
+}
+
+// Новый ключ ECDSA P-256 (ES256) с отпечатком в качестве идентификатора
+func GenerateKey() (*Key, error) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key | %w", err)
+	}
+
+	return NewKey("", private)
+}
+
+// Закрытый ключ в формате PEM (PKCS #8) для передачи другим экземплярам приложения
+func (k *Key) MarshalPrivateKeyPEM() ([]byte, error) {
+	if k.Symmetric() {
+		return nil, fmt.Errorf("%w | marshal of symmetric key", ErrKeyUnsupported)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(k.signKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w | %v", ErrKeyParse, err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Headers: nil, Bytes: der}), nil
+}
+
+// Разбор закрытого ключа в формате PEM (PKCS #8, PKCS #1 или SEC 1)
+func ParsePrivateKeyPEM(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w | pem block not found", ErrKeyParse)
+	}
+
+	var (
+		err     error
+		private crypto.PrivateKey
+	)
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w | unexpected pem block %q", ErrKeyParse, block.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w | %v", ErrKeyParse, err)
+	}
+
+	return NewKey(id, private)
+}
+
+func LoadPrivateKeyFile(id, path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w | %v", ErrKeyParse, err)
+	}
+
+	return ParsePrivateKeyPEM(id, data)
+}
+
+// Признак симметричного ключа, который нельзя публиковать
+func (k *Key) Symmetric() bool {
+	_, ok := k.verifyKey.([]byte)
+
+	return ok
+}
+
+// Отпечаток открытого ключа (RFC 7638)
+func (k *Key) Thumbprint() (string, error) {
+	jwk, ok := k.JWK()
+	if !ok {
+		return "", fmt.Errorf("%w | thumbprint of symmetric key", ErrKeyUnsupported)
+	}
+
+	// Обязательные члены JWK в лексикографическом порядке
+	var members any
+
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{jwk.Crv, jwk.Kty, jwk.X, jwk.Y}
+	default:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("%w | %v", ErrKeyParse, err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
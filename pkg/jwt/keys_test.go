@@ -0,0 +1,91 @@
+package authidjwt_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	authidjwt "github.com/vtievsky/auth-id/pkg/jwt"
+)
+
+// Созданный ключ передается через PEM и проверяет токены, подписанные исходным ключом
+func TestGenerateKey(t *testing.T) {
+	key, err := authidjwt.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	encoded, err := key.MarshalPrivateKeyPEM()
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	loaded, err := authidjwt.ParsePrivateKeyPEM(key.ID, encoded)
+	if err != nil {
+		t.Fatalf("failed to parse key: %v", err)
+	}
+
+	if loaded.ID != key.ID || loaded.Method.Alg() != "ES256" {
+		t.Fatalf("unexpected key %s %s", loaded.ID, loaded.Method.Alg())
+	}
+
+	token, err := authidjwt.NewAccessToken(key, &authidjwt.TokenOpts{
+		ID:        "1",
+		Subject:   "ivan",
+		SessionID: "session",
+		ExpiredAt: time.Now().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	if _, err = authidjwt.ParseToken(loaded, token); err != nil {
+		t.Fatalf("failed to verify token with loaded key: %v", err)
+	}
+}
+
+func TestMarshalSymmetricKey(t *testing.T) {
+	_, err := authidjwt.NewHMACKey("1", []byte("secret")).MarshalPrivateKeyPEM()
+	if !errors.Is(err, authidjwt.ErrKeyUnsupported) {
+		t.Fatalf("expected ErrKeyUnsupported, got %v", err)
+	}
+}
+
+func TestKeyRingAdd(t *testing.T) {
+	ring := authidjwt.NewKeyRing(authidjwt.NewHMACKey("1", []byte("secret")))
+
+	key, err := authidjwt.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if !ring.Add(key) || ring.Add(key) {
+		t.Fatal("key must be added once")
+	}
+
+	// Добавленный ключ не становится действующим до назначения
+	if ring.Active().ID != "1" {
+		t.Fatalf("unexpected active key %s", ring.Active().ID)
+	}
+
+	if err = ring.Promote(key.ID); err != nil || ring.Active() != key {
+		t.Fatalf("failed to promote added key: %v", err)
+	}
+}
+
+func TestKeyRingRemove(t *testing.T) {
+	ring := authidjwt.NewKeyRing(authidjwt.NewHMACKey("1", []byte("secret")), authidjwt.NewHMACKey("2", []byte("old")))
+
+	// Действующий ключ остается в связке
+	if ring.Remove("1") {
+		t.Fatal("active key must not be removed")
+	}
+
+	if !ring.Remove("2") || ring.Remove("2") {
+		t.Fatal("retired key must be removed once")
+	}
+
+	if _, err := ring.Lookup("2"); !errors.Is(err, authidjwt.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package authidjwt
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,6 +11,8 @@ import (
 )
 
 type Token struct {
+	ID         string
+	Subject    string
 	SessionID  string
 	IssuedAt   time.Time
 	ExpiredAt  time.Time
@@ -16,20 +20,43 @@ type Token struct {
 	Valid      bool
 }
 
-func ParseToken(signingKey []byte, signedString []byte) (*Token, error) {
+func ParseToken(keys KeySet, signedString []byte) (*Token, error) {
+	return ParseTokenContext(context.Background(), keys, signedString)
+}
+
+// Разбор токена с контекстом запроса для наборов, загружающих ключи по сети
+func ParseTokenContext(ctx context.Context, keys KeySet, signedString []byte) (*Token, error) {
 	var (
 		claims  Claims
 		keyFunc = func(t *jwt.Token) (any, error) {
-			return signingKey, nil
+			kid, _ := t.Header["kid"].(string)
+
+			key, err := lookup(ctx, keys, kid)
+			if err != nil {
+				return nil, err
+			}
+
+			// Алгоритм определяется ключом, а не заголовком токена
+			if t.Method.Alg() != key.Method.Alg() {
+				return nil, fmt.Errorf("%w | unexpected alg %q", ErrTokenParse, t.Method.Alg())
+			}
+
+			return key.verifyKey, nil
 		}
 	)
 
 	token, err := jwt.ParseWithClaims(string(signedString), &claims, keyFunc)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, fmt.Errorf("%w | %v", ErrTokenExpired, err)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("%w | %v", ErrTokenParse, err)
 	}
 
 	return &Token{
+		ID:         claims.ID,
+		Subject:    claims.Subject,
 		SessionID:  claims.Session,
 		IssuedAt:   claims.IssuedAt.Time,
 		ExpiredAt:  claims.ExpiresAt.Time,
@@ -51,3 +78,11 @@ func ExtractToken(values []string) (string, error) {
 
 	return ul[1], nil
 }
+
+func lookup(ctx context.Context, keys KeySet, kid string) (*Key, error) {
+	if keys, ok := keys.(ContextKeySet); ok {
+		return keys.LookupContext(ctx, kid)
+	}
+
+	return keys.Lookup(kid)
+}
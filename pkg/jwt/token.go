@@ -7,39 +7,43 @@ import (
 )
 
 type TokenOpts struct {
+	ID        string
+	Subject   string // Логин пользователя
 	SessionID string
 	ExpiredAt time.Time
 }
 
-func NewAccessToken(signingKey []byte, opts *TokenOpts) ([]byte, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+func NewAccessToken(key *Key, opts *TokenOpts) ([]byte, error) {
+	return newToken(key, Claims{
 		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			ID:        opts.ID,
+			Subject:   opts.Subject,
 			IssuedAt:  &jwt.NumericDate{Time: time.Now()},
 			ExpiresAt: &jwt.NumericDate{Time: opts.ExpiredAt},
 		},
 		Session:    opts.SessionID,
 		AccessOnly: true,
 	})
-
-	s, err := token.SignedString(signingKey)
-	if err != nil {
-		return nil, err //nolint:wrapcheck
-	}
-
-	return []byte(s), nil
 }
 
-func NewRefreshToken(signingKey []byte, opts *TokenOpts) ([]byte, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+func NewRefreshToken(key *Key, opts *TokenOpts) ([]byte, error) {
+	return newToken(key, Claims{
 		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			ID:        opts.ID,
+			Subject:   opts.Subject,
 			IssuedAt:  &jwt.NumericDate{Time: time.Now()},
 			ExpiresAt: &jwt.NumericDate{Time: opts.ExpiredAt},
 		},
 		Session:    opts.SessionID,
 		AccessOnly: false,
 	})
+}
+
+func newToken(key *Key, claims Claims) ([]byte, error) {
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
 
-	s, err := token.SignedString(signingKey)
+	s, err := token.SignedString(key.signKey)
 	if err != nil {
 		return nil, err //nolint:wrapcheck
 	}
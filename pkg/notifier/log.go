@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// Запись уведомлений в журнал вместо отправки.
+// Текст может содержать одноразовые токены, поэтому в рабочем окружении не используется
+type Log struct {
+	logger *zap.Logger
+}
+
+func NewLog(logger *zap.Logger) *Log {
+	return &Log{
+		logger: logger,
+	}
+}
+
+func (s *Log) Notify(_ context.Context, msg Message) error {
+	s.logger.Info("notification",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("body", msg.Body),
+	)
+
+	return nil
+}
@@ -0,0 +1,17 @@
+// Доставка уведомлений пользователям: письма через SMTP
+// или запись в журнал на стендах разработки
+package notifier
+
+import (
+	"context"
+)
+
+type Message struct {
+	To      string // Адрес электронной почты получателя
+	Subject string
+	Body    string // Текст без разметки
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,214 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const (
+	TLSNone     = "none"     // Без шифрования, только для локальной заглушки SMTP
+	TLSStartTLS = "starttls" // Переход на шифрование командой STARTTLS, обычно порт 587
+	TLSImplicit = "tls"      // Шифрование с момента подключения, обычно порт 465
+
+	defaultTimeout = 10 * time.Second
+)
+
+var (
+	ErrTLSModeUnsupported  = errors.New("unsupported smtp tls mode")
+	ErrStartTLSUnsupported = errors.New("smtp server does not support starttls")
+)
+
+var headerReplacer = strings.NewReplacer("\r", "", "\n", " ") //nolint:gochecknoglobals
+
+type SMTPOpts struct {
+	Addr     string // Адрес сервера host:port
+	Username string // Пусто - без аутентификации
+	Password string
+	From     string // Адрес отправителя, можно с именем: auth-id <noreply@example.com>
+	TLS      string // none, starttls или tls
+	Timeout  time.Duration
+}
+
+// Отправка писем через SMTP-сервер, новое подключение на каждое письмо
+type SMTP struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     *mail.Address
+	tls      string
+	timeout  time.Duration
+}
+
+func NewSMTP(opts *SMTPOpts) (*SMTP, error) {
+	host, _, err := net.SplitHostPort(opts.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp address | %w", err)
+	}
+
+	from, err := mail.ParseAddress(opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address | %w", err)
+	}
+
+	switch opts.TLS {
+	case TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrTLSModeUnsupported, opts.TLS)
+	}
+
+	timeout := opts.Timeout
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &SMTP{
+		addr:     opts.Addr,
+		host:     host,
+		username: opts.Username,
+		password: opts.Password,
+		from:     from,
+		tls:      opts.TLS,
+		timeout:  timeout,
+	}, nil
+}
+
+func (s *SMTP) Notify(ctx context.Context, msg Message) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address | %w", err)
+	}
+
+	data, err := s.compose(to, msg)
+	if err != nil {
+		return fmt.Errorf("failed to compose message | %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server | %w", err)
+	}
+
+	// Ограничение всего обмена с сервером сроком контекста
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+
+		return fmt.Errorf("failed to start smtp session | %w", err)
+	}
+	defer client.Close()
+
+	if err = s.send(client, to, data); err != nil {
+		return fmt.Errorf("failed to send message | %w", err)
+	}
+
+	return nil
+}
+
+func (s *SMTP) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{} //nolint:exhaustruct
+
+	if s.tls == TLSImplicit {
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config:    s.tlsConfig(),
+		}
+
+		return tlsDialer.DialContext(ctx, "tcp", s.addr) //nolint:wrapcheck
+	}
+
+	return dialer.DialContext(ctx, "tcp", s.addr) //nolint:wrapcheck
+}
+
+func (s *SMTP) send(client *smtp.Client, to *mail.Address, data []byte) error {
+	if s.tls == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return ErrStartTLSUnsupported
+		}
+
+		if err := client.StartTLS(s.tlsConfig()); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	// PlainAuth не передает пароль без шифрования, кроме подключения к localhost
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if err := client.Rcpt(to.Address); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if err = w.Close(); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return client.Quit() //nolint:wrapcheck
+}
+
+func (s *SMTP) tlsConfig() *tls.Config {
+	return &tls.Config{ //nolint:exhaustruct
+		ServerName: s.host,
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// Письмо в UTF-8: заголовок кодируется по RFC 2047, текст - quoted-printable
+func (s *SMTP) compose(to *mail.Address, msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", headerReplacer.Replace(msg.Subject)))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+
+	if _, err := w.Write([]byte(msg.Body)); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,349 @@
+package notifier_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vtievsky/auth-id/pkg/notifier"
+)
+
+// Письмо, принятое заглушкой SMTP
+type envelope struct {
+	from string
+	to   []string
+	data string
+}
+
+// Локальная заглушка SMTP-сервера: принимает письма без шифрования и аутентификации
+type smtpStandIn struct {
+	listener   net.Listener
+	extensions []string
+	mu         sync.Mutex
+	received   []envelope
+	wg         sync.WaitGroup
+}
+
+func newSMTPStandIn(t *testing.T, extensions ...string) *smtpStandIn {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	srv := &smtpStandIn{
+		listener:   listener,
+		extensions: extensions,
+	}
+
+	srv.wg.Add(1)
+
+	go srv.serve()
+
+	t.Cleanup(func() {
+		listener.Close()
+		srv.wg.Wait()
+	})
+
+	return srv
+}
+
+func (s *smtpStandIn) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *smtpStandIn) messages() []envelope {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]envelope(nil), s.received...)
+}
+
+func (s *smtpStandIn) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+
+			s.session(conn)
+		}()
+	}
+}
+
+func (s *smtpStandIn) session(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	reply := func(lines ...string) {
+		for _, line := range lines {
+			_, _ = w.WriteString(line + "\r\n")
+		}
+
+		_ = w.Flush()
+	}
+
+	reply("220 localhost stand-in")
+
+	var current envelope
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		cmd := strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(cmd, " ", 2)[0]) //nolint:mnd
+
+		switch verb {
+		case "EHLO":
+			lines := []string{"250-localhost"}
+
+			for _, ext := range s.extensions {
+				lines = append(lines, "250-"+ext)
+			}
+
+			reply(append(lines, "250 8BITMIME")...)
+		case "HELO", "RSET", "NOOP":
+			reply("250 OK")
+		case "MAIL":
+			current = envelope{from: address(cmd)}
+
+			reply("250 OK")
+		case "RCPT":
+			current.to = append(current.to, address(cmd))
+
+			reply("250 OK")
+		case "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				line, err = r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if line == ".\r\n" {
+					break
+				}
+
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+
+			current.data = data.String()
+
+			s.mu.Lock()
+			s.received = append(s.received, current)
+			s.mu.Unlock()
+
+			reply("250 OK queued")
+		case "QUIT":
+			reply("221 bye")
+
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+// Адрес из команды MAIL FROM:<...> или RCPT TO:<...>
+func address(cmd string) string {
+	start, end := strings.Index(cmd, "<"), strings.LastIndex(cmd, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+
+	return cmd[start+1 : end]
+}
+
+func newSender(t *testing.T, addr, tlsMode string) *notifier.SMTP {
+	t.Helper()
+
+	sender, err := notifier.NewSMTP(&notifier.SMTPOpts{
+		Addr:     addr,
+		Username: "",
+		Password: "",
+		From:     "auth-id <noreply@example.com>",
+		TLS:      tlsMode,
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("failed to create smtp sender: %v", err)
+	}
+
+	return sender
+}
+
+func TestSMTPNotify(t *testing.T) {
+	srv := newSMTPStandIn(t)
+	sender := newSender(t, srv.addr(), notifier.TLSNone)
+
+	body := "Здравствуйте!\n\nТокен для сброса пароля: abc=def\n"
+
+	if err := sender.Notify(context.Background(), notifier.Message{
+		To:      "Иван <ivan@example.com>",
+		Subject: "Сброс пароля",
+		Body:    body,
+	}); err != nil {
+		t.Fatalf("failed to notify: %v", err)
+	}
+
+	messages := srv.messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(messages))
+	}
+
+	got := messages[0]
+
+	if got.from != "noreply@example.com" {
+		t.Errorf("unexpected envelope sender %q", got.from)
+	}
+
+	if len(got.to) != 1 || got.to[0] != "ivan@example.com" {
+		t.Errorf("unexpected envelope recipients %v", got.to)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("failed to decode subject: %v", err)
+	}
+
+	if subject != "Сброс пароля" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+
+	if ct := msg.Header.Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	decoded, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+
+	// При передаче переводы строк заменяются на CRLF
+	if strings.ReplaceAll(string(decoded), "\r\n", "\n") != body {
+		t.Errorf("unexpected body %q", decoded)
+	}
+}
+
+func TestSMTPNotifyHeaderInjection(t *testing.T) {
+	srv := newSMTPStandIn(t)
+	sender := newSender(t, srv.addr(), notifier.TLSNone)
+
+	if err := sender.Notify(context.Background(), notifier.Message{
+		To:      "ivan@example.com",
+		Subject: "Сброс\r\nBcc: attacker@example.com",
+		Body:    "text",
+	}); err != nil {
+		t.Fatalf("failed to notify: %v", err)
+	}
+
+	messages := srv.messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(messages))
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(messages[0].data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+
+	if bcc := msg.Header.Get("Bcc"); bcc != "" {
+		t.Errorf("header injected: Bcc %q", bcc)
+	}
+
+	if len(messages[0].to) != 1 {
+		t.Errorf("unexpected envelope recipients %v", messages[0].to)
+	}
+}
+
+func TestSMTPNotifyInvalidRecipient(t *testing.T) {
+	srv := newSMTPStandIn(t)
+	sender := newSender(t, srv.addr(), notifier.TLSNone)
+
+	if err := sender.Notify(context.Background(), notifier.Message{
+		To:      "not an address",
+		Subject: "subject",
+		Body:    "text",
+	}); err == nil {
+		t.Fatal("expected error for invalid recipient")
+	}
+
+	if messages := srv.messages(); len(messages) != 0 {
+		t.Errorf("expected no messages, got %d", len(messages))
+	}
+}
+
+func TestSMTPNotifyStartTLSUnsupported(t *testing.T) {
+	srv := newSMTPStandIn(t)
+	sender := newSender(t, srv.addr(), notifier.TLSStartTLS)
+
+	err := sender.Notify(context.Background(), notifier.Message{
+		To:      "ivan@example.com",
+		Subject: "subject",
+		Body:    "text",
+	})
+	if !errors.Is(err, notifier.ErrStartTLSUnsupported) {
+		t.Fatalf("expected ErrStartTLSUnsupported, got %v", err)
+	}
+
+	if messages := srv.messages(); len(messages) != 0 {
+		t.Errorf("message sent without starttls: %d", len(messages))
+	}
+}
+
+func TestNewSMTPInvalidOpts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts notifier.SMTPOpts
+	}{
+		{
+			name: "address without port",
+			opts: notifier.SMTPOpts{Addr: "localhost", From: "noreply@example.com", TLS: notifier.TLSNone},
+		},
+		{
+			name: "invalid sender",
+			opts: notifier.SMTPOpts{Addr: "localhost:25", From: "noreply", TLS: notifier.TLSNone},
+		},
+		{
+			name: "unsupported tls mode",
+			opts: notifier.SMTPOpts{Addr: "localhost:25", From: "noreply@example.com", TLS: "ssl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := notifier.NewSMTP(&tt.opts); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
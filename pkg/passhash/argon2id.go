@@ -0,0 +1,146 @@
+package passhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	idArgon2id = "argon2id"
+
+	// Ограничения RFC 9106
+	minArgon2idSaltLen = 8
+	minArgon2idKeyLen  = 4
+	minArgon2idMemory  = 8 // Память на поток в КиБ
+)
+
+// Параметры argon2id, по умолчанию - рекомендация OWASP
+type Argon2id struct {
+	Memory  uint32 // Память в КиБ
+	Time    uint32 // Количество проходов
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+func DefaultArgon2id() *Argon2id {
+	return &Argon2id{
+		Memory:  19456, //nolint:mnd
+		Time:    2,     //nolint:mnd
+		Threads: 1,
+		SaltLen: 16, //nolint:mnd
+		KeyLen:  32, //nolint:mnd
+	}
+}
+
+func (a *Argon2id) ID() string {
+	return idArgon2id
+}
+
+func (a *Argon2id) Validate() error {
+	switch {
+	case a.Time < 1:
+		return fmt.Errorf("%w: argon2id time must be positive", ErrParamsInvalid)
+	case a.Threads < 1:
+		return fmt.Errorf("%w: argon2id threads must be positive", ErrParamsInvalid)
+	case a.Memory < minArgon2idMemory*uint32(a.Threads):
+		return fmt.Errorf("%w: argon2id memory must be at least %d KiB per thread", ErrParamsInvalid, minArgon2idMemory)
+	case a.SaltLen < minArgon2idSaltLen:
+		return fmt.Errorf("%w: argon2id salt must be at least %d bytes", ErrParamsInvalid, minArgon2idSaltLen)
+	case a.KeyLen < minArgon2idKeyLen:
+		return fmt.Errorf("%w: argon2id key must be at least %d bytes", ErrParamsInvalid, minArgon2idKeyLen)
+	}
+
+	return nil
+}
+
+func (a *Argon2id) Hash(password []byte) (*PHC, error) {
+	salt := make([]byte, a.SaltLen)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	key := argon2.IDKey(password, salt, a.Time, a.Memory, a.Threads, a.KeyLen)
+
+	return &PHC{
+		ID:      idArgon2id,
+		Version: strconv.Itoa(argon2.Version),
+		Params: []Param{
+			{Name: "m", Value: strconv.FormatUint(uint64(a.Memory), 10)},
+			{Name: "t", Value: strconv.FormatUint(uint64(a.Time), 10)},
+			{Name: "p", Value: strconv.FormatUint(uint64(a.Threads), 10)},
+		},
+		Salt:   base64.RawStdEncoding.EncodeToString(salt),
+		Hash:   base64.RawStdEncoding.EncodeToString(key),
+		legacy: false,
+	}, nil
+}
+
+func (a *Argon2id) Verify(phc *PHC, password []byte) (bool, error) {
+	params, salt, key, err := a.decode(phc)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, uint32(len(key))) //nolint:gosec
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (a *Argon2id) Current(phc *PHC) bool {
+	params, salt, key, err := a.decode(phc)
+	if err != nil {
+		return false
+	}
+
+	return params.Memory == a.Memory &&
+		params.Time == a.Time &&
+		params.Threads == a.Threads &&
+		len(salt) == int(a.SaltLen) &&
+		len(key) == int(a.KeyLen)
+}
+
+func (a *Argon2id) decode(phc *PHC) (*Argon2id, []byte, []byte, error) {
+	if phc.Version != strconv.Itoa(argon2.Version) {
+		return nil, nil, nil, ErrFormatInvalid
+	}
+
+	memory, err := phc.uintParam("m", 32) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	time, err := phc.uintParam("t", 32) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	threads, err := phc.uintParam("p", 8) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(phc.Salt)
+	if err != nil {
+		return nil, nil, nil, ErrFormatInvalid
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(phc.Hash)
+	if err != nil || len(key) == 0 || memory == 0 || time == 0 || threads == 0 {
+		return nil, nil, nil, ErrFormatInvalid
+	}
+
+	return &Argon2id{
+		Memory:  uint32(memory),    //nolint:gosec
+		Time:    uint32(time),      //nolint:gosec
+		Threads: uint8(threads),    //nolint:gosec
+		SaltLen: uint32(len(salt)), //nolint:gosec
+		KeyLen:  uint32(len(key)),  //nolint:gosec
+	}, salt, key, nil
+}
@@ -0,0 +1,114 @@
+package passhash
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	idBcrypt = "bcrypt"
+
+	bcryptSaltLen = 22 // Соль в алфавите bcrypt
+	bcryptHashLen = 31
+	bcryptMCFLen  = 7 + bcryptSaltLen + bcryptHashLen // $2a$10$ + соль + хеш
+)
+
+// Параметры bcrypt. Пароль длиннее 72 байт без перца не принимается
+type Bcrypt struct {
+	Cost int
+}
+
+func DefaultBcrypt() *Bcrypt {
+	return &Bcrypt{
+		Cost: bcrypt.DefaultCost,
+	}
+}
+
+func (b *Bcrypt) ID() string {
+	return idBcrypt
+}
+
+// Стоимость вне допустимого диапазона bcrypt заменил бы стоимостью по умолчанию,
+// и хеши пересчитывались бы при каждом входе
+func (b *Bcrypt) Validate() error {
+	if b.Cost < bcrypt.MinCost || b.Cost > bcrypt.MaxCost {
+		return fmt.Errorf("%w: bcrypt cost must be between %d and %d", ErrParamsInvalid, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	return nil
+}
+
+func (b *Bcrypt) Hash(password []byte) (*PHC, error) {
+	hash, err := bcrypt.GenerateFromPassword(password, b.Cost)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	phc, err := parseBcryptMCF(string(hash))
+	if err != nil {
+		return nil, err
+	}
+
+	phc.legacy = false
+
+	return phc, nil
+}
+
+func (b *Bcrypt) Verify(phc *PHC, password []byte) (bool, error) {
+	cost, err := phc.uintParam("r", 8) //nolint:mnd
+	if err != nil {
+		return false, err
+	}
+
+	if len(phc.Salt) != bcryptSaltLen || len(phc.Hash) != bcryptHashLen {
+		return false, ErrFormatInvalid
+	}
+
+	hash := fmt.Sprintf("$2a$%02d$%s%s", cost, phc.Salt, phc.Hash)
+
+	err = bcrypt.CompareHashAndPassword([]byte(hash), password)
+
+	switch {
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		return false, nil
+	case err != nil:
+		return false, err //nolint:wrapcheck
+	}
+
+	return true, nil
+}
+
+func (b *Bcrypt) Current(phc *PHC) bool {
+	return phc.Param("r") == strconv.Itoa(b.Cost)
+}
+
+// Хеш в формате $2a$10$<соль><хеш>, в котором хранились пароли до перехода на PHC
+func isBcryptMCF(encoded string) bool {
+	return len(encoded) == bcryptMCFLen && strings.HasPrefix(encoded, "$2") && encoded[6] == '$'
+}
+
+func parseBcryptMCF(encoded string) (*PHC, error) {
+	if !isBcryptMCF(encoded) {
+		return nil, ErrFormatInvalid
+	}
+
+	cost, err := strconv.Atoi(encoded[4:6])
+	if err != nil {
+		return nil, ErrFormatInvalid
+	}
+
+	return &PHC{
+		ID:      idBcrypt,
+		Version: "",
+		Params: []Param{
+			{Name: "r", Value: strconv.Itoa(cost)},
+		},
+		Salt:   encoded[7 : 7+bcryptSaltLen],
+		Hash:   encoded[7+bcryptSaltLen:],
+		legacy: true,
+	}, nil
+}
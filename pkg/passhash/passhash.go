@@ -0,0 +1,144 @@
+// Хеширование паролей в формате PHC алгоритмами argon2id, scrypt и bcrypt
+// с необязательным перцем - секретом сервера, который не хранится рядом с хешами
+package passhash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const (
+	paramPepper = "k" // Идентификатор перца, которым обработан пароль
+)
+
+var (
+	ErrMismatch             = errors.New("password does not match")
+	ErrAlgorithmUnsupported = errors.New("unsupported password hash algorithm")
+	ErrPepperUnknown        = errors.New("unknown password pepper")
+	ErrParamsInvalid        = errors.New("invalid password hash parameters")
+)
+
+// Алгоритм хеширования с параметрами
+type Algorithm interface {
+	ID() string
+	// Хеширование со случайной солью
+	Hash(password []byte) (*PHC, error)
+	// Проверка пароля с параметрами из хеша
+	Verify(phc *PHC, password []byte) (bool, error)
+	// Совпадают ли параметры хеша с параметрами алгоритма
+	Current(phc *PHC) bool
+	// Проверка параметров алгоритма до первого хеширования
+	Validate() error
+}
+
+type Opts struct {
+	Algorithm Algorithm         // Алгоритм новых хешей
+	PepperID  string            // Идентификатор текущего перца, пусто - без перца
+	Peppers   map[string][]byte // Текущий и выведенные из использования перцы по идентификаторам
+}
+
+type Hasher struct {
+	current    Algorithm
+	algorithms map[string]Algorithm
+	pepperID   string
+	peppers    map[string][]byte
+}
+
+func New(opts *Opts) *Hasher {
+	h := &Hasher{
+		current: opts.Algorithm,
+		algorithms: map[string]Algorithm{
+			idArgon2id: DefaultArgon2id(),
+			idScrypt:   DefaultScrypt(),
+			idBcrypt:   DefaultBcrypt(),
+		},
+		pepperID: opts.PepperID,
+		peppers:  opts.Peppers,
+	}
+
+	h.algorithms[opts.Algorithm.ID()] = opts.Algorithm
+
+	return h
+}
+
+func (h *Hasher) Hash(password []byte) (string, error) {
+	peppered, err := h.pepper(h.pepperID, password)
+	if err != nil {
+		return "", err
+	}
+
+	phc, err := h.current.Hash(peppered)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password | %w", err)
+	}
+
+	if h.pepperID != "" {
+		phc.SetParam(paramPepper, h.pepperID)
+	}
+
+	return phc.String(), nil
+}
+
+// Проверка пароля по хешу любого поддерживаемого алгоритма
+func (h *Hasher) Verify(encoded string, password []byte) error {
+	phc, err := ParsePHC(encoded)
+	if err != nil {
+		return err
+	}
+
+	algorithm, ok := h.algorithms[phc.ID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrAlgorithmUnsupported, phc.ID)
+	}
+
+	peppered, err := h.pepper(phc.Param(paramPepper), password)
+	if err != nil {
+		return err
+	}
+
+	matched, err := algorithm.Verify(phc, peppered)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if !matched {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// Нужно ли пересчитать хеш после успешной проверки: устаревший алгоритм,
+// параметры или перец, а также хеш bcrypt в исходном формате
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	phc, err := ParsePHC(encoded)
+	if err != nil {
+		return true
+	}
+
+	return phc.legacy ||
+		phc.ID != h.current.ID() ||
+		!h.current.Current(phc) ||
+		phc.Param(paramPepper) != h.pepperID
+}
+
+// Пароль обрабатывается HMAC-SHA256 с перцем. Результат кодируется в base64,
+// чтобы не содержать нулевых байтов и укладываться в ограничение bcrypt
+func (h *Hasher) pepper(pepperID string, password []byte) ([]byte, error) {
+	if pepperID == "" {
+		return password, nil
+	}
+
+	pepper, ok := h.peppers[pepperID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPepperUnknown, pepperID)
+	}
+
+	mac := hmac.New(sha256.New, pepper)
+	mac.Write(password)
+
+	return []byte(base64.StdEncoding.EncodeToString(mac.Sum(nil))), nil
+}
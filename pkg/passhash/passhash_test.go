@@ -0,0 +1,78 @@
+package passhash_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vtievsky/auth-id/pkg/passhash"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm passhash.Algorithm
+		valid     bool
+	}{
+		{name: "argon2id default", algorithm: passhash.DefaultArgon2id(), valid: true},
+		{name: "argon2id zero time", algorithm: &passhash.Argon2id{Memory: 19456, Time: 0, Threads: 1, SaltLen: 16, KeyLen: 32}},
+		{name: "argon2id zero threads", algorithm: &passhash.Argon2id{Memory: 19456, Time: 2, Threads: 0, SaltLen: 16, KeyLen: 32}},
+		{name: "argon2id low memory", algorithm: &passhash.Argon2id{Memory: 8, Time: 2, Threads: 4, SaltLen: 16, KeyLen: 32}},
+		{name: "scrypt default", algorithm: passhash.DefaultScrypt(), valid: true},
+		{name: "scrypt zero log n", algorithm: &passhash.Scrypt{LogN: 0, R: 8, P: 1, SaltLen: 16, KeyLen: 32}},
+		{name: "scrypt large log n", algorithm: &passhash.Scrypt{LogN: 64, R: 8, P: 1, SaltLen: 16, KeyLen: 32}},
+		{name: "scrypt zero p", algorithm: &passhash.Scrypt{LogN: 17, R: 8, P: 0, SaltLen: 16, KeyLen: 32}},
+		{name: "scrypt large r*p", algorithm: &passhash.Scrypt{LogN: 17, R: 1 << 16, P: 1 << 14, SaltLen: 16, KeyLen: 32}},
+		{name: "bcrypt default", algorithm: passhash.DefaultBcrypt(), valid: true},
+		{name: "bcrypt low cost", algorithm: &passhash.Bcrypt{Cost: 3}},
+		{name: "bcrypt high cost", algorithm: &passhash.Bcrypt{Cost: 32}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.algorithm.Validate()
+
+			if tt.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, passhash.ErrParamsInvalid) {
+				t.Fatalf("expected ErrParamsInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+// Хеш, созданный с выведенным из использования перцем, проверяется и пересчитывается
+func TestRetiredPepper(t *testing.T) {
+	algorithm := &passhash.Bcrypt{Cost: 4}
+	password := []byte("secret:with,separators")
+
+	retired := passhash.New(&passhash.Opts{
+		Algorithm: algorithm,
+		PepperID:  "1",
+		Peppers:   map[string][]byte{"1": []byte("old:pepper,1")},
+	})
+
+	encoded, err := retired.Hash(password)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	current := passhash.New(&passhash.Opts{
+		Algorithm: algorithm,
+		PepperID:  "2",
+		Peppers:   map[string][]byte{"1": []byte("old:pepper,1"), "2": []byte("new")},
+	})
+
+	if err = current.Verify(encoded, password); err != nil {
+		t.Fatalf("failed to verify retired pepper hash: %v", err)
+	}
+
+	if !current.NeedsRehash(encoded) {
+		t.Error("retired pepper hash must be rehashed")
+	}
+
+	if err = current.Verify(encoded, []byte("wrong")); !errors.Is(err, passhash.ErrMismatch) {
+		t.Errorf("expected ErrMismatch, got %v", err)
+	}
+}
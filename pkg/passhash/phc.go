@@ -0,0 +1,138 @@
+package passhash
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrFormatInvalid = errors.New("invalid password hash format")
+)
+
+// Хеш в формате PHC: $<id>[$v=<version>][$<param>=<value>[,...]]$<salt>$<hash>
+type PHC struct {
+	ID      string
+	Version string
+	Params  []Param
+	Salt    string
+	Hash    string
+	legacy  bool // Хеш bcrypt в исходном формате $2a$<cost>$..., без параметров PHC
+}
+
+type Param struct {
+	Name  string
+	Value string
+}
+
+func ParsePHC(encoded string) (*PHC, error) {
+	if isBcryptMCF(encoded) {
+		return parseBcryptMCF(encoded)
+	}
+
+	parts := strings.Split(encoded, "$")
+
+	if len(parts) < 4 || parts[0] != "" || parts[1] == "" { //nolint:mnd
+		return nil, ErrFormatInvalid
+	}
+
+	phc := &PHC{
+		ID:      parts[1],
+		Version: "",
+		Params:  nil,
+		Salt:    parts[len(parts)-2],
+		Hash:    parts[len(parts)-1],
+		legacy:  false,
+	}
+
+	fields := parts[2 : len(parts)-2]
+
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "v=") {
+		phc.Version = strings.TrimPrefix(fields[0], "v=")
+		fields = fields[1:]
+	}
+
+	switch len(fields) {
+	case 0:
+	case 1:
+		for _, field := range strings.Split(fields[0], ",") {
+			name, value, ok := strings.Cut(field, "=")
+			if !ok || name == "" {
+				return nil, ErrFormatInvalid
+			}
+
+			phc.Params = append(phc.Params, Param{
+				Name:  name,
+				Value: value,
+			})
+		}
+	default:
+		return nil, ErrFormatInvalid
+	}
+
+	if phc.Salt == "" || phc.Hash == "" {
+		return nil, ErrFormatInvalid
+	}
+
+	return phc, nil
+}
+
+func (p *PHC) String() string {
+	var b strings.Builder
+
+	b.WriteString("$" + p.ID)
+
+	if p.Version != "" {
+		b.WriteString("$v=" + p.Version)
+	}
+
+	if len(p.Params) > 0 {
+		params := make([]string, 0, len(p.Params))
+
+		for _, param := range p.Params {
+			params = append(params, param.Name+"="+param.Value)
+		}
+
+		b.WriteString("$" + strings.Join(params, ","))
+	}
+
+	b.WriteString("$" + p.Salt + "$" + p.Hash)
+
+	return b.String()
+}
+
+func (p *PHC) Param(name string) string {
+	for _, param := range p.Params {
+		if param.Name == name {
+			return param.Value
+		}
+	}
+
+	return ""
+}
+
+func (p *PHC) SetParam(name, value string) {
+	for idx := range p.Params {
+		if p.Params[idx].Name == name {
+			p.Params[idx].Value = value
+
+			return
+		}
+	}
+
+	p.Params = append(p.Params, Param{
+		Name:  name,
+		Value: value,
+	})
+}
+
+// Числовой параметр в пределах разрядности
+func (p *PHC) uintParam(name string, bitSize int) (uint64, error) {
+	value, err := strconv.ParseUint(p.Param(name), 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: parameter %s", ErrFormatInvalid, name)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,144 @@
+package passhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	idScrypt = "scrypt"
+
+	maxScryptLogN = 30
+	maxScryptRP   = 1 << 30 // Ограничение произведения r·p
+)
+
+// Параметры scrypt, по умолчанию - рекомендация OWASP
+type Scrypt struct {
+	LogN    uint8 // Двоичный логарифм стоимости N
+	R       uint32
+	P       uint32
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+func DefaultScrypt() *Scrypt {
+	return &Scrypt{
+		LogN:    17, //nolint:mnd
+		R:       8,  //nolint:mnd
+		P:       1,
+		SaltLen: 16, //nolint:mnd
+		KeyLen:  32, //nolint:mnd
+	}
+}
+
+func (s *Scrypt) ID() string {
+	return idScrypt
+}
+
+func (s *Scrypt) Validate() error {
+	switch {
+	case s.LogN < 1 || s.LogN > maxScryptLogN:
+		return fmt.Errorf("%w: scrypt log n must be between 1 and %d", ErrParamsInvalid, maxScryptLogN)
+	case s.R < 1 || s.P < 1:
+		return fmt.Errorf("%w: scrypt r and p must be positive", ErrParamsInvalid)
+	case uint64(s.R)*uint64(s.P) >= maxScryptRP:
+		return fmt.Errorf("%w: scrypt r*p must be less than 2^30", ErrParamsInvalid)
+	case s.KeyLen < 1:
+		return fmt.Errorf("%w: scrypt key length must be positive", ErrParamsInvalid)
+	}
+
+	return nil
+}
+
+func (s *Scrypt) Hash(password []byte) (*PHC, error) {
+	salt := make([]byte, s.SaltLen)
+
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	key, err := scrypt.Key(password, salt, 1<<s.LogN, int(s.R), int(s.P), int(s.KeyLen))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &PHC{
+		ID:      idScrypt,
+		Version: "",
+		Params: []Param{
+			{Name: "ln", Value: strconv.FormatUint(uint64(s.LogN), 10)},
+			{Name: "r", Value: strconv.FormatUint(uint64(s.R), 10)},
+			{Name: "p", Value: strconv.FormatUint(uint64(s.P), 10)},
+		},
+		Salt:   base64.RawStdEncoding.EncodeToString(salt),
+		Hash:   base64.RawStdEncoding.EncodeToString(key),
+		legacy: false,
+	}, nil
+}
+
+func (s *Scrypt) Verify(phc *PHC, password []byte) (bool, error) {
+	params, salt, key, err := s.decode(phc)
+	if err != nil {
+		return false, err
+	}
+
+	other, err := scrypt.Key(password, salt, 1<<params.LogN, int(params.R), int(params.P), len(key))
+	if err != nil {
+		return false, err //nolint:wrapcheck
+	}
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (s *Scrypt) Current(phc *PHC) bool {
+	params, salt, key, err := s.decode(phc)
+	if err != nil {
+		return false
+	}
+
+	return params.LogN == s.LogN &&
+		params.R == s.R &&
+		params.P == s.P &&
+		len(salt) == int(s.SaltLen) &&
+		len(key) == int(s.KeyLen)
+}
+
+func (s *Scrypt) decode(phc *PHC) (*Scrypt, []byte, []byte, error) {
+	logN, err := phc.uintParam("ln", 8) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	r, err := phc.uintParam("r", 32) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	p, err := phc.uintParam("p", 32) //nolint:mnd
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(phc.Salt)
+	if err != nil {
+		return nil, nil, nil, ErrFormatInvalid
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(phc.Hash)
+	if err != nil || len(key) == 0 || logN < 1 || logN > maxScryptLogN || r == 0 || p == 0 {
+		return nil, nil, nil, ErrFormatInvalid
+	}
+
+	return &Scrypt{
+		LogN:    uint8(logN),       //nolint:gosec
+		R:       uint32(r),         //nolint:gosec
+		P:       uint32(p),         //nolint:gosec
+		SaltLen: uint32(len(salt)), //nolint:gosec
+		KeyLen:  uint32(len(key)),  //nolint:gosec
+	}, salt, key, nil
+}
@@ -0,0 +1,108 @@
+package passpolicy
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Наиболее распространенные пароли, проверяются всегда
+var common = []string{ //nolint:gochecknoglobals
+	"123456", "123456789", "12345678", "1234567890", "12345", "1234567", "111111", "123123",
+	"000000", "654321", "666666", "121212", "112233", "123321", "1q2w3e4r", "1q2w3e4r5t",
+	"qwerty", "qwerty123", "qwertyuiop", "1qaz2wsx", "zaq12wsx", "asdfgh", "asdfghjkl",
+	"password", "password1", "password123", "passw0rd", "p@ssw0rd", "admin", "admin123",
+	"administrator", "root", "toor", "welcome", "welcome1", "letmein", "iloveyou", "monkey",
+	"dragon", "master", "sunshine", "princess", "football", "baseball", "superman", "trustno1",
+	"changeme", "default", "secret", "test", "test123", "guest", "login", "abc123", "abcdef",
+	"pa$$w0rd", "qazwsx", "zxcvbnm", "ytrewq", "parol", "parol123",
+}
+
+// Множество запрещенных паролей. Хранятся хеши SHA-1, что позволяет загружать
+// как списки паролей, так и выгрузки скомпрометированных паролей в формате HASH:count
+type Denylist struct {
+	hashes map[[sha1.Size]byte]struct{}
+}
+
+// Встроенный список распространенных паролей и дополнительные пароли
+func NewDenylist(passwords ...string) *Denylist {
+	d := &Denylist{
+		hashes: make(map[[sha1.Size]byte]struct{}, len(common)+len(passwords)),
+	}
+
+	for _, password := range slices.Concat(common, passwords) {
+		d.add(password)
+	}
+
+	return d
+}
+
+// Загрузка списка из файла: по одному паролю или хешу SHA-1 в шестнадцатеричном виде на строку
+func LoadDenylist(path string) (*Denylist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open denylist | %w", err)
+	}
+	defer file.Close()
+
+	d := NewDenylist()
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if hash, ok := parseHash(line); ok {
+			d.hashes[hash] = struct{}{}
+
+			continue
+		}
+
+		d.add(line)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read denylist | %w", err)
+	}
+
+	return d, nil
+}
+
+// Пароль проверяется как есть и без учета регистра
+func (d *Denylist) Contains(password string) bool {
+	for _, value := range []string{password, strings.ToLower(password)} {
+		if _, ok := d.hashes[sha1.Sum([]byte(value))]; ok { //nolint:gosec
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Denylist) add(password string) {
+	d.hashes[sha1.Sum([]byte(strings.ToLower(password)))] = struct{}{} //nolint:gosec
+}
+
+// Строка выгрузки скомпрометированных паролей: хеш и необязательное количество утечек
+func parseHash(line string) ([sha1.Size]byte, bool) {
+	var hash [sha1.Size]byte
+
+	value, _, _ := strings.Cut(line, ":")
+
+	if len(value) != hex.EncodedLen(sha1.Size) {
+		return hash, false
+	}
+
+	if _, err := hex.Decode(hash[:], []byte(value)); err != nil {
+		return hash, false
+	}
+
+	return hash, true
+}
@@ -0,0 +1,142 @@
+// Правила сложности паролей с перечнем нарушений для отображения пользователю
+package passpolicy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	CodeTooShort         = "too_short"
+	CodeTooLong          = "too_long"
+	CodeNoLower          = "no_lower"
+	CodeNoUpper          = "no_upper"
+	CodeNoDigit          = "no_digit"
+	CodeNoSpecial        = "no_special"
+	CodeContainsPersonal = "contains_personal"
+	CodeCommon           = "common"
+	CodeReused           = "reused"
+	CodeExpired          = "expired"
+
+	minPersonalLength = 3 // Более короткие части имени встречаются в паролях случайно
+)
+
+// Нарушение правила с кодом для клиента и описанием для пользователя
+type Violation struct {
+	Code    string
+	Message string
+}
+
+type Policy struct {
+	MinLength      int       // Минимальная длина в символах
+	MaxLength      int       // Максимальная длина в байтах, 0 - без ограничения
+	RequireLower   bool      // Строчная буква
+	RequireUpper   bool      // Прописная буква
+	RequireDigit   bool      // Цифра
+	RequireSpecial bool      // Символ, не являющийся буквой или цифрой
+	RejectPersonal bool      // Запрет логина и частей имени внутри пароля
+	Denylist       *Denylist // Распространенные и скомпрометированные пароли, nil - без проверки
+}
+
+// Проверка пароля. Логин и имя пользователя передаются для запрета их использования в пароле
+func (p *Policy) Check(password string, personal ...string) []Violation {
+	var violations []Violation
+
+	if length := utf8.RuneCountInString(password); length < p.MinLength {
+		violations = append(violations, Violation{
+			Code:    CodeTooShort,
+			Message: fmt.Sprintf("password must be at least %d characters long", p.MinLength),
+		})
+	}
+
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		violations = append(violations, Violation{
+			Code:    CodeTooLong,
+			Message: fmt.Sprintf("password must be at most %d bytes long", p.MaxLength),
+		})
+	}
+
+	violations = append(violations, p.checkClasses(password)...)
+
+	if p.RejectPersonal && containsPersonal(password, personal) {
+		violations = append(violations, Violation{
+			Code:    CodeContainsPersonal,
+			Message: "password must not contain login or name",
+		})
+	}
+
+	if p.Denylist != nil && p.Denylist.Contains(password) {
+		violations = append(violations, Violation{
+			Code:    CodeCommon,
+			Message: "password is too common or has been exposed in a data breach",
+		})
+	}
+
+	return violations
+}
+
+func (p *Policy) checkClasses(password string) []Violation {
+	var (
+		violations                               []Violation
+		hasLower, hasUpper, hasDigit, hasSpecial bool
+	)
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+
+	rules := []struct {
+		required bool
+		present  bool
+		code     string
+		message  string
+	}{
+		{p.RequireLower, hasLower, CodeNoLower, "password must contain a lowercase letter"},
+		{p.RequireUpper, hasUpper, CodeNoUpper, "password must contain an uppercase letter"},
+		{p.RequireDigit, hasDigit, CodeNoDigit, "password must contain a digit"},
+		{p.RequireSpecial, hasSpecial, CodeNoSpecial, "password must contain a special character"},
+	}
+
+	for _, rule := range rules {
+		if rule.required && !rule.present {
+			violations = append(violations, Violation{
+				Code:    rule.code,
+				Message: rule.message,
+			})
+		}
+	}
+
+	return violations
+}
+
+// Логин проверяется целиком, имя - по словам
+func containsPersonal(password string, personal []string) bool {
+	password = strings.ToLower(password)
+
+	for _, value := range personal {
+		parts := strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		for _, part := range append(parts, value) {
+			part = strings.ToLower(strings.TrimSpace(part))
+
+			if utf8.RuneCountInString(part) >= minPersonalLength && strings.Contains(password, part) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package totp_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vtievsky/auth-id/pkg/totp"
+)
+
+// Секрет тестовых векторов SHA1 из приложения B RFC 6238
+var secret = []byte("12345678901234567890") //nolint:gochecknoglobals
+
+// Коды RFC приведены из 8 цифр, здесь - последние 6
+func TestCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tt := range tests {
+		at := time.Unix(tt.unix, 0)
+
+		if code := totp.Code(secret, totp.Step(at)); code != tt.code {
+			t.Errorf("%d: expected code %s, got %s", tt.unix, tt.code, code)
+		}
+
+		step, err := totp.Validate(secret, tt.code, at, 0)
+		if err != nil {
+			t.Errorf("%d: failed to validate code: %v", tt.unix, err)
+		}
+
+		if step != totp.Step(at) {
+			t.Errorf("%d: expected step %d, got %d", tt.unix, totp.Step(at), step)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	var (
+		at      = time.Unix(1234567890, 0)
+		current = totp.Step(at)
+	)
+
+	tests := []struct {
+		name  string
+		step  uint64
+		skew  uint64
+		valid bool
+	}{
+		{name: "current step without skew", step: current, skew: 0, valid: true},
+		{name: "previous step without skew", step: current - 1, skew: 0, valid: false},
+		{name: "next step without skew", step: current + 1, skew: 0, valid: false},
+		{name: "previous step", step: current - 1, skew: 1, valid: true},
+		{name: "next step", step: current + 1, skew: 1, valid: true},
+		{name: "beyond previous step", step: current - 2, skew: 1, valid: false},
+		{name: "beyond next step", step: current + 2, skew: 1, valid: false},
+		{name: "wider skew", step: current - 2, skew: 2, valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := totp.Validate(secret, totp.Code(secret, tt.step), at, tt.skew)
+
+			if !tt.valid {
+				if !errors.Is(err, totp.ErrInvalidCode) {
+					t.Fatalf("expected ErrInvalidCode, got step %d and error %v", step, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to validate code: %v", err)
+			}
+
+			// Возвращается шаг кода, а не текущий шаг, чтобы отклонить его повтор
+			if step != tt.step {
+				t.Fatalf("expected step %d, got %d", tt.step, step)
+			}
+		})
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	at := time.Unix(59, 0)
+
+	if _, err := totp.Validate(secret, " 287082 ", at, 0); err != nil {
+		t.Fatalf("failed to validate code with spaces: %v", err)
+	}
+
+	for _, code := range []string{"", "28708", "2870820", "94287082", "000000"} {
+		if _, err := totp.Validate(secret, code, at, 1); !errors.Is(err, totp.ErrInvalidCode) {
+			t.Errorf("%q: expected ErrInvalidCode, got %v", code, err)
+		}
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := totp.URI("auth-id", "ivan", secret)
+
+	if !strings.HasPrefix(uri, "otpauth://totp/auth-id:ivan?") ||
+		!strings.Contains(uri, "secret="+totp.EncodeSecret(secret)) {
+		t.Fatalf("unexpected uri %s", uri)
+	}
+}
@@ -0,0 +1,213 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	cborMaxDepth = 16
+
+	cborUnsigned = 0
+	cborNegative = 1
+	cborBytes    = 2
+	cborText     = 3
+	cborArray    = 4
+	cborMap      = 5
+	cborSimple   = 7
+)
+
+// Минимальный разбор CBOR в объеме, достаточном для WebAuthn: целые, строки,
+// массивы, словари и простые значения. Неопределенная длина, теги и числа
+// с плавающей точкой аутентификаторы не используют и считаются ошибкой.
+// Возвращает значение и количество прочитанных байт
+func decodeCBOR(data []byte) (any, int, error) {
+	d := &cborDecoder{
+		data: data,
+		pos:  0,
+	}
+
+	value, err := d.decode(0)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", ErrCBORInvalid, err)
+	}
+
+	return value, d.pos, nil
+}
+
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *cborDecoder) decode(depth int) (any, error) {
+	if depth > cborMaxDepth {
+		return nil, fmt.Errorf("nesting too deep")
+	}
+
+	if d.pos >= len(d.data) {
+		return nil, fmt.Errorf("unexpected end of data")
+	}
+
+	initial := d.data[d.pos]
+	d.pos++
+
+	major, info := initial>>5, initial&0x1f //nolint:mnd
+
+	if major == cborSimple {
+		return d.simple(info)
+	}
+
+	arg, err := d.argument(info)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborUnsigned:
+		if arg > math.MaxInt64 {
+			return nil, fmt.Errorf("integer overflow")
+		}
+
+		return int64(arg), nil
+	case cborNegative:
+		if arg > math.MaxInt64 {
+			return nil, fmt.Errorf("integer overflow")
+		}
+
+		return -1 - int64(arg), nil
+	case cborBytes, cborText:
+		raw, err := d.read(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if major == cborText {
+			return string(raw), nil
+		}
+
+		return raw, nil
+	case cborArray:
+		return d.array(arg, depth)
+	case cborMap:
+		return d.dict(arg, depth)
+	}
+
+	return nil, fmt.Errorf("unsupported major type %d", major)
+}
+
+func (d *cborDecoder) argument(info byte) (uint64, error) {
+	switch {
+	case info < 24: //nolint:mnd
+		return uint64(info), nil
+	case info == 24: //nolint:mnd
+		raw, err := d.read(1)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(raw[0]), nil
+	case info == 25: //nolint:mnd
+		raw, err := d.read(2) //nolint:mnd
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(binary.BigEndian.Uint16(raw)), nil
+	case info == 26: //nolint:mnd
+		raw, err := d.read(4) //nolint:mnd
+		if err != nil {
+			return 0, err
+		}
+
+		return uint64(binary.BigEndian.Uint32(raw)), nil
+	case info == 27: //nolint:mnd
+		raw, err := d.read(8) //nolint:mnd
+		if err != nil {
+			return 0, err
+		}
+
+		return binary.BigEndian.Uint64(raw), nil
+	}
+
+	return 0, fmt.Errorf("unsupported additional information %d", info)
+}
+
+func (d *cborDecoder) simple(info byte) (any, error) {
+	switch info {
+	case 20: //nolint:mnd
+		return false, nil
+	case 21: //nolint:mnd
+		return true, nil
+	case 22: //nolint:mnd
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unsupported simple value %d", info)
+}
+
+func (d *cborDecoder) array(size uint64, depth int) ([]any, error) {
+	// Каждый элемент занимает хотя бы один байт
+	if size > uint64(len(d.data)-d.pos) {
+		return nil, fmt.Errorf("array length exceeds data")
+	}
+
+	values := make([]any, 0, size)
+
+	for range size {
+		value, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (d *cborDecoder) dict(size uint64, depth int) (map[any]any, error) {
+	if size > uint64(len(d.data)-d.pos)/2 { //nolint:mnd
+		return nil, fmt.Errorf("map length exceeds data")
+	}
+
+	values := make(map[any]any, size)
+
+	for range size {
+		key, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key.(type) {
+		case int64, string:
+		default:
+			return nil, fmt.Errorf("unsupported map key type %T", key)
+		}
+
+		if _, ok := values[key]; ok {
+			return nil, fmt.Errorf("duplicate map key %v", key)
+		}
+
+		value, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func (d *cborDecoder) read(size uint64) ([]byte, error) {
+	if size > uint64(len(d.data)-d.pos) {
+		return nil, fmt.Errorf("unexpected end of data")
+	}
+
+	raw := d.data[d.pos : d.pos+int(size)] //nolint:gosec
+	d.pos += int(size)                     //nolint:gosec
+
+	return raw, nil
+}
@@ -0,0 +1,154 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+)
+
+// Алгоритмы COSE, поддерживаемые для ключей аутентификаторов
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// Поддерживаемые алгоритмы в порядке предпочтения
+var Algorithms = []int64{AlgES256, AlgEdDSA, AlgRS256} //nolint:gochecknoglobals
+
+const (
+	coseKty = 1
+	coseAlg = 3
+	coseCrv = -1
+	coseX   = -2
+	coseY   = -3
+	coseN   = -1
+	coseE   = -2
+
+	coseKtyOKP = 1
+	coseKtyEC2 = 2
+	coseKtyRSA = 3
+
+	coseCrvP256    = 1
+	coseCrvEd25519 = 6
+
+	minRSABits = 2048
+)
+
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// Разбор открытого ключа COSE (RFC 9053)
+func parsePublicKey(raw []byte) (*publicKey, error) {
+	value, n, err := decodeCBOR(raw)
+	if err != nil || n != len(raw) {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	params, ok := value.(map[any]any)
+	if !ok {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	kty, _ := params[int64(coseKty)].(int64)
+	alg, _ := params[int64(coseAlg)].(int64)
+
+	switch {
+	case alg == AlgES256 && kty == coseKtyEC2:
+		return parseEC2(params)
+	case alg == AlgEdDSA && kty == coseKtyOKP:
+		return parseOKP(params)
+	case alg == AlgRS256 && kty == coseKtyRSA:
+		return parseRSA(params)
+	}
+
+	return nil, ErrAlgorithmUnsupported
+}
+
+func parseEC2(params map[any]any) (*publicKey, error) {
+	crv, _ := params[int64(coseCrv)].(int64)
+	x, _ := params[int64(coseX)].([]byte)
+	y, _ := params[int64(coseY)].([]byte)
+
+	if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	// Проверка принадлежности точки кривой
+	point := append(append([]byte{0x04}, x...), y...) //nolint:mnd
+
+	if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	return &publicKey{
+		alg: AlgES256,
+		key: &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		},
+	}, nil
+}
+
+func parseOKP(params map[any]any) (*publicKey, error) {
+	crv, _ := params[int64(coseCrv)].(int64)
+	x, _ := params[int64(coseX)].([]byte)
+
+	if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+		return nil, ErrPublicKeyInvalid
+	}
+
+	return &publicKey{
+		alg: AlgEdDSA,
+		key: ed25519.PublicKey(x),
+	}, nil
+}
+
+func parseRSA(params map[any]any) (*publicKey, error) {
+	n, _ := params[int64(coseN)].([]byte)
+	e, _ := params[int64(coseE)].([]byte)
+
+	if len(e) < 1 || len(e) > 4 { //nolint:mnd
+		return nil, ErrPublicKeyInvalid
+	}
+
+	modulus := new(big.Int).SetBytes(n)
+	exponent := int(new(big.Int).SetBytes(e).Int64())
+
+	if modulus.BitLen() < minRSABits || exponent < 3 || exponent%2 == 0 { //nolint:mnd
+		return nil, ErrPublicKeyInvalid
+	}
+
+	return &publicKey{
+		alg: AlgRS256,
+		key: &rsa.PublicKey{
+			N: modulus,
+			E: exponent,
+		},
+	}, nil
+}
+
+func (k *publicKey) verify(message, signature []byte) bool {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	return false
+}
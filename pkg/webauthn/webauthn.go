@@ -0,0 +1,302 @@
+// Проверка церемоний регистрации и входа WebAuthn (W3C Web Authentication Level 2)
+// на стороне проверяющей стороны. Аттестация не проверяется: ключи принимаются
+// без подтверждения производителя аутентификатора, что соответствует attestation "none"
+package webauthn
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	ChallengeSize = 32
+
+	TypeCreate = "webauthn.create"
+	TypeGet    = "webauthn.get"
+
+	flagUserPresent   = 0x01
+	flagUserVerified  = 0x04
+	flagAttestedData  = 0x40
+	flagExtensionData = 0x80
+
+	rpIDHashSize      = 32
+	authDataMinSize   = rpIDHashSize + 1 + 4 // Хеш идентификатора, флаги, счетчик
+	aaguidSize        = 16
+	maxCredentialSize = 1023
+)
+
+var (
+	ErrCBORInvalid              = errors.New("invalid cbor")
+	ErrClientDataInvalid        = errors.New("invalid client data")
+	ErrCeremonyTypeMismatch     = errors.New("ceremony type mismatch")
+	ErrChallengeMismatch        = errors.New("challenge mismatch")
+	ErrOriginMismatch           = errors.New("origin mismatch")
+	ErrRPIDMismatch             = errors.New("relying party id mismatch")
+	ErrAuthenticatorDataInvalid = errors.New("invalid authenticator data")
+	ErrAttestationInvalid       = errors.New("invalid attestation object")
+	ErrUserNotPresent           = errors.New("user not present")
+	ErrUserNotVerified          = errors.New("user not verified")
+	ErrPublicKeyInvalid         = errors.New("invalid public key")
+	ErrAlgorithmUnsupported     = errors.New("unsupported public key algorithm")
+	ErrSignatureInvalid         = errors.New("invalid signature")
+	ErrSignCountInvalid         = errors.New("sign count did not increase, authenticator may be cloned")
+)
+
+// Проверяющая сторона
+type RelyingParty struct {
+	ID      string   // Домен, к которому привязываются ключи
+	Name    string   // Название для отображения пользователю
+	Origins []string // Допустимые источники страниц, например https://id.example.com
+}
+
+// Ключ, созданный аутентификатором при регистрации
+type Registration struct {
+	CredentialID []byte
+	PublicKey    []byte // Открытый ключ COSE
+	SignCount    uint32
+	UserVerified bool
+}
+
+// Ответ аутентификатора при входе
+type Assertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+// Случайный вызов для новой церемонии
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, ChallengeSize)
+
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, fmt.Errorf("failed to generate challenge | %w", err)
+	}
+
+	return challenge, nil
+}
+
+// Кодирование двоичных полей WebAuthn в base64url без выравнивания
+func Encode(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+// Декодирование base64url, выравнивание допускается
+func Decode(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "=")) //nolint:wrapcheck
+}
+
+// Проверка ответа аутентификатора на церемонию регистрации
+func (rp *RelyingParty) VerifyRegistration(
+	challenge, clientDataJSON, attestationObject []byte,
+	requireUserVerification bool,
+) (*Registration, error) {
+	if err := rp.verifyClientData(clientDataJSON, TypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	value, n, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, err
+	}
+
+	attestation, ok := value.(map[any]any)
+	if !ok || n != len(attestationObject) {
+		return nil, ErrAttestationInvalid
+	}
+
+	// Формат и подпись аттестации не проверяются, но должны присутствовать
+	if _, ok = attestation["fmt"].(string); !ok {
+		return nil, ErrAttestationInvalid
+	}
+
+	if _, ok = attestation["attStmt"].(map[any]any); !ok {
+		return nil, ErrAttestationInvalid
+	}
+
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, ErrAttestationInvalid
+	}
+
+	authData, err := rp.verifyAuthenticatorData(rawAuthData, requireUserVerification)
+	if err != nil {
+		return nil, err
+	}
+
+	if authData.flags&flagAttestedData == 0 {
+		return nil, fmt.Errorf("%w: attested credential data missing", ErrAuthenticatorDataInvalid)
+	}
+
+	if _, err = parsePublicKey(authData.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &Registration{
+		CredentialID: authData.credentialID,
+		PublicKey:    authData.publicKey,
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// Проверка подписи аутентификатора при входе. Возвращает новое значение счетчика подписей
+func (rp *RelyingParty) VerifyAssertion(
+	challenge, credentialPublicKey []byte,
+	signCount uint32,
+	assertion *Assertion,
+	requireUserVerification bool,
+) (uint32, error) {
+	if err := rp.verifyClientData(assertion.ClientDataJSON, TypeGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := rp.verifyAuthenticatorData(assertion.AuthenticatorData, requireUserVerification)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parsePublicKey(credentialPublicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	message := slices.Concat(assertion.AuthenticatorData, clientDataHash[:])
+
+	if !key.verify(message, assertion.Signature) {
+		return 0, ErrSignatureInvalid
+	}
+
+	// Аутентификаторы без счетчика всегда возвращают 0
+	if (authData.signCount != 0 || signCount != 0) && authData.signCount <= signCount {
+		return 0, ErrSignCountInvalid
+	}
+
+	return authData.signCount, nil
+}
+
+func (rp *RelyingParty) verifyClientData(raw []byte, ceremonyType string, challenge []byte) error {
+	var data clientData
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("%w: %w", ErrClientDataInvalid, err)
+	}
+
+	if data.Type != ceremonyType {
+		return ErrCeremonyTypeMismatch
+	}
+
+	received, err := Decode(data.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(received, challenge) != 1 {
+		return ErrChallengeMismatch
+	}
+
+	if !slices.Contains(rp.Origins, data.Origin) {
+		return ErrOriginMismatch
+	}
+
+	return nil
+}
+
+func (rp *RelyingParty) verifyAuthenticatorData(raw []byte, requireUserVerification bool) (*authenticatorData, error) {
+	authData, err := parseAuthenticatorData(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+
+	if subtle.ConstantTimeCompare(authData.rpIDHash, rpIDHash[:]) != 1 {
+		return nil, ErrRPIDMismatch
+	}
+
+	if authData.flags&flagUserPresent == 0 {
+		return nil, ErrUserNotPresent
+	}
+
+	if requireUserVerification && authData.flags&flagUserVerified == 0 {
+		return nil, ErrUserNotVerified
+	}
+
+	return authData, nil
+}
+
+func parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < authDataMinSize {
+		return nil, ErrAuthenticatorDataInvalid
+	}
+
+	authData := &authenticatorData{
+		rpIDHash:     raw[:rpIDHashSize],
+		flags:        raw[rpIDHashSize],
+		signCount:    binary.BigEndian.Uint32(raw[rpIDHashSize+1 : authDataMinSize]),
+		credentialID: nil,
+		publicKey:    nil,
+	}
+
+	rest := raw[authDataMinSize:]
+
+	if authData.flags&flagAttestedData != 0 {
+		if len(rest) < aaguidSize+2 { //nolint:mnd
+			return nil, ErrAuthenticatorDataInvalid
+		}
+
+		rest = rest[aaguidSize:]
+		size := int(binary.BigEndian.Uint16(rest))
+		rest = rest[2:]
+
+		if size < 1 || size > maxCredentialSize || len(rest) < size {
+			return nil, ErrAuthenticatorDataInvalid
+		}
+
+		authData.credentialID = rest[:size]
+		rest = rest[size:]
+
+		_, n, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAuthenticatorDataInvalid, err)
+		}
+
+		authData.publicKey = rest[:n]
+		rest = rest[n:]
+	}
+
+	if authData.flags&flagExtensionData != 0 {
+		_, n, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAuthenticatorDataInvalid, err)
+		}
+
+		rest = rest[n:]
+	}
+
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w: trailing data", ErrAuthenticatorDataInvalid)
+	}
+
+	return authData, nil
+}
@@ -0,0 +1,285 @@
+package webauthn_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+	"github.com/vtievsky/auth-id/pkg/webauthn/webauthntest"
+)
+
+const (
+	rpID   = "id.example.com"
+	origin = "https://id.example.com"
+)
+
+var algorithms = []struct { //nolint:gochecknoglobals
+	name string
+	alg  int64
+}{
+	{name: "ES256", alg: webauthn.AlgES256},
+	{name: "EdDSA", alg: webauthn.AlgEdDSA},
+	{name: "RS256", alg: webauthn.AlgRS256},
+}
+
+func newRelyingParty() *webauthn.RelyingParty {
+	return &webauthn.RelyingParty{
+		ID:      rpID,
+		Name:    "auth-id",
+		Origins: []string{origin},
+	}
+}
+
+func newChallenge(t *testing.T) []byte {
+	t.Helper()
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		t.Fatalf("failed to create challenge: %v", err)
+	}
+
+	return challenge
+}
+
+func newAuthenticator(t *testing.T, alg int64) *webauthntest.Authenticator {
+	t.Helper()
+
+	authenticator, err := webauthntest.New(alg, rpID, origin)
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %v", err)
+	}
+
+	return authenticator
+}
+
+// Регистрация ключа аутентификатора, возвращает сохраняемые данные ключа
+func register(t *testing.T, rp *webauthn.RelyingParty, authenticator *webauthntest.Authenticator) *webauthn.Registration {
+	t.Helper()
+
+	challenge := newChallenge(t)
+	clientDataJSON, attestationObject := authenticator.Register(challenge)
+
+	registration, err := rp.VerifyRegistration(challenge, clientDataJSON, attestationObject, true)
+	if err != nil {
+		t.Fatalf("failed to verify registration: %v", err)
+	}
+
+	return registration
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp := newRelyingParty()
+
+	for _, tt := range algorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := newAuthenticator(t, tt.alg)
+
+			registration := register(t, rp, authenticator)
+
+			if string(registration.CredentialID) != string(authenticator.CredentialID()) {
+				t.Error("credential id mismatch")
+			}
+
+			if string(registration.PublicKey) != string(authenticator.PublicKey()) {
+				t.Error("public key mismatch")
+			}
+
+			if !registration.UserVerified {
+				t.Error("user verification flag lost")
+			}
+
+			signCount := registration.SignCount
+
+			for range 3 {
+				challenge := newChallenge(t)
+
+				next, err := rp.VerifyAssertion(challenge, registration.PublicKey, signCount, authenticator.Assert(challenge), true)
+				if err != nil {
+					t.Fatalf("failed to verify assertion: %v", err)
+				}
+
+				if next <= signCount {
+					t.Fatalf("sign count did not increase: %d -> %d", signCount, next)
+				}
+
+				signCount = next
+			}
+		})
+	}
+}
+
+func TestAssertionReplay(t *testing.T) {
+	rp := newRelyingParty()
+
+	for _, tt := range algorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := newAuthenticator(t, tt.alg)
+			registration := register(t, rp, authenticator)
+
+			challenge := newChallenge(t)
+			assertion := authenticator.Assert(challenge)
+
+			signCount, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, assertion, true)
+			if err != nil {
+				t.Fatalf("failed to verify assertion: %v", err)
+			}
+
+			// Повтор того же ответа с сохраненным счетчиком
+			_, err = rp.VerifyAssertion(challenge, registration.PublicKey, signCount, assertion, true)
+			if !errors.Is(err, webauthn.ErrSignCountInvalid) {
+				t.Errorf("expected ErrSignCountInvalid on replay, got %v", err)
+			}
+
+			// Повтор ответа на другой вызов
+			_, err = rp.VerifyAssertion(newChallenge(t), registration.PublicKey, registration.SignCount, assertion, true)
+			if !errors.Is(err, webauthn.ErrChallengeMismatch) {
+				t.Errorf("expected ErrChallengeMismatch on replay, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAssertionSignCountRegression(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := newAuthenticator(t, webauthn.AlgES256)
+	registration := register(t, rp, authenticator)
+
+	authenticator.SignCount = 10
+
+	challenge := newChallenge(t)
+
+	signCount, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, authenticator.Assert(challenge), true)
+	if err != nil {
+		t.Fatalf("failed to verify assertion: %v", err)
+	}
+
+	// Копия ключа с отставшим счетчиком
+	authenticator.SignCount = 5
+	challenge = newChallenge(t)
+
+	_, err = rp.VerifyAssertion(challenge, registration.PublicKey, signCount, authenticator.Assert(challenge), true)
+	if !errors.Is(err, webauthn.ErrSignCountInvalid) {
+		t.Fatalf("expected ErrSignCountInvalid, got %v", err)
+	}
+}
+
+func TestAssertionWithoutSignCounter(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := newAuthenticator(t, webauthn.AlgEdDSA)
+	registration := register(t, rp, authenticator)
+
+	// Аутентификаторы без счетчика всегда передают 0
+	for range 2 {
+		authenticator.SignCount = ^uint32(0) // Увеличение при подписи дает 0
+
+		challenge := newChallenge(t)
+
+		if _, err := rp.VerifyAssertion(challenge, registration.PublicKey, 0, authenticator.Assert(challenge), true); err != nil {
+			t.Fatalf("failed to verify assertion without counter: %v", err)
+		}
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(a *webauthntest.Authenticator)
+		err    error
+	}{
+		{
+			name:   "origin",
+			modify: func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example.com" },
+			err:    webauthn.ErrOriginMismatch,
+		},
+		{
+			name:   "rp id",
+			modify: func(a *webauthntest.Authenticator) { a.RPID = "evil.example.com" },
+			err:    webauthn.ErrRPIDMismatch,
+		},
+		{
+			name:   "user verification",
+			modify: func(a *webauthntest.Authenticator) { a.UserVerified = false },
+			err:    webauthn.ErrUserNotVerified,
+		},
+	}
+
+	rp := newRelyingParty()
+
+	for _, tt := range tests {
+		t.Run(tt.name+" registration", func(t *testing.T) {
+			authenticator := newAuthenticator(t, webauthn.AlgES256)
+			tt.modify(authenticator)
+
+			challenge := newChallenge(t)
+			clientDataJSON, attestationObject := authenticator.Register(challenge)
+
+			if _, err := rp.VerifyRegistration(challenge, clientDataJSON, attestationObject, true); !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+
+		t.Run(tt.name+" assertion", func(t *testing.T) {
+			authenticator := newAuthenticator(t, webauthn.AlgES256)
+			registration := register(t, rp, authenticator)
+			tt.modify(authenticator)
+
+			challenge := newChallenge(t)
+
+			if _, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, authenticator.Assert(challenge), true); !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestCeremonyTypeMismatch(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := newAuthenticator(t, webauthn.AlgES256)
+	registration := register(t, rp, authenticator)
+
+	challenge := newChallenge(t)
+	clientDataJSON, _ := authenticator.Register(challenge)
+
+	assertion := authenticator.Assert(challenge)
+	assertion.ClientDataJSON = clientDataJSON
+
+	_, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, assertion, true)
+	if !errors.Is(err, webauthn.ErrCeremonyTypeMismatch) {
+		t.Fatalf("expected ErrCeremonyTypeMismatch, got %v", err)
+	}
+}
+
+func TestSignatureOfAnotherKey(t *testing.T) {
+	rp := newRelyingParty()
+
+	for _, tt := range algorithms {
+		t.Run(tt.name, func(t *testing.T) {
+			registration := register(t, rp, newAuthenticator(t, tt.alg))
+			other := newAuthenticator(t, tt.alg)
+
+			challenge := newChallenge(t)
+
+			_, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, other.Assert(challenge), true)
+			if !errors.Is(err, webauthn.ErrSignatureInvalid) {
+				t.Fatalf("expected ErrSignatureInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTamperedAuthenticatorData(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := newAuthenticator(t, webauthn.AlgRS256)
+	registration := register(t, rp, authenticator)
+
+	challenge := newChallenge(t)
+	assertion := authenticator.Assert(challenge)
+
+	// Подмена счетчика после подписи
+	assertion.AuthenticatorData[len(assertion.AuthenticatorData)-1]++
+
+	_, err := rp.VerifyAssertion(challenge, registration.PublicKey, registration.SignCount, assertion, true)
+	if !errors.Is(err, webauthn.ErrSignatureInvalid) {
+		t.Fatalf("expected ErrSignatureInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+// Программный аутентификатор WebAuthn для тестов: создает ключи ES256, EdDSA и RS256,
+// формирует ответы на церемонии регистрации и входа с аттестацией "none"
+package webauthntest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/vtievsky/auth-id/pkg/webauthn"
+)
+
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+
+	credentialIDSize = 32
+	rsaBits          = 2048
+)
+
+type Authenticator struct {
+	RPID         string // Домен, для которого подписываются ответы
+	Origin       string // Источник страницы в данных клиента
+	SignCount    uint32 // Счетчик подписей, увеличивается при каждом входе
+	UserVerified bool   // Подтверждение пользователя PIN-кодом или биометрией
+
+	signer       crypto.Signer
+	credentialID []byte
+}
+
+func New(alg int64, rpID, origin string) (*Authenticator, error) {
+	var (
+		signer crypto.Signer
+		err    error
+	)
+
+	switch alg {
+	case webauthn.AlgES256:
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case webauthn.AlgEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	case webauthn.AlgRS256:
+		signer, err = rsa.GenerateKey(rand.Reader, rsaBits)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %d", alg)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key | %w", err)
+	}
+
+	credentialID := make([]byte, credentialIDSize)
+
+	if _, err = rand.Read(credentialID); err != nil {
+		return nil, fmt.Errorf("failed to generate credential id | %w", err)
+	}
+
+	return &Authenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		SignCount:    0,
+		UserVerified: true,
+		signer:       signer,
+		credentialID: credentialID,
+	}, nil
+}
+
+func (a *Authenticator) CredentialID() []byte {
+	return a.credentialID
+}
+
+// Открытый ключ COSE
+func (a *Authenticator) PublicKey() []byte {
+	switch key := a.signer.Public().(type) {
+	case *ecdsa.PublicKey:
+		return encodeMap(
+			pair{1, 2},                 // kty: EC2
+			pair{3, webauthn.AlgES256}, // alg
+			pair{-1, 1},                // crv: P-256
+			pair{-2, pad(key.X, 32)},   // x
+			pair{-3, pad(key.Y, 32)},   // y
+		)
+	case ed25519.PublicKey:
+		return encodeMap(
+			pair{1, 1},                 // kty: OKP
+			pair{3, webauthn.AlgEdDSA}, // alg
+			pair{-1, 6},                // crv: Ed25519
+			pair{-2, []byte(key)},      // x
+		)
+	case *rsa.PublicKey:
+		return encodeMap(
+			pair{1, 3},                                 // kty: RSA
+			pair{3, webauthn.AlgRS256},                 // alg
+			pair{-1, key.N.Bytes()},                    // n
+			pair{-2, big.NewInt(int64(key.E)).Bytes()}, // e
+		)
+	}
+
+	return nil
+}
+
+// Ответ на navigator.credentials.create(): данные клиента и объект аттестации
+func (a *Authenticator) Register(challenge []byte) ([]byte, []byte) {
+	authData := a.authData(flagAttestedData)
+
+	authData = append(authData, make([]byte, 16)...) //nolint:mnd // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, a.PublicKey()...)
+
+	attestation := encodeMap(
+		pair{"fmt", "none"},
+		pair{"attStmt", rawCBOR(encodeMap())},
+		pair{"authData", authData},
+	)
+
+	return a.clientData(webauthn.TypeCreate, challenge), attestation
+}
+
+// Ответ на navigator.credentials.get() с увеличением счетчика подписей
+func (a *Authenticator) Assert(challenge []byte) *webauthn.Assertion {
+	a.SignCount++
+
+	clientDataJSON := a.clientData(webauthn.TypeGet, challenge)
+	authData := a.authData(0)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+
+	return &webauthn.Assertion{
+		CredentialID:      a.credentialID,
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         a.sign(slices.Concat(authData, clientDataHash[:])),
+		UserHandle:        nil,
+	}
+}
+
+func (a *Authenticator) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+
+	flags |= flagUserPresent
+
+	if a.UserVerified {
+		flags |= flagUserVerified
+	}
+
+	authData := append(rpIDHash[:], flags)
+
+	return binary.BigEndian.AppendUint32(authData, a.SignCount)
+}
+
+func (a *Authenticator) clientData(ceremonyType string, challenge []byte) []byte {
+	raw, _ := json.Marshal(map[string]any{
+		"type":        ceremonyType,
+		"challenge":   webauthn.Encode(challenge),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+
+	return raw
+}
+
+func (a *Authenticator) sign(message []byte) []byte {
+	var (
+		signature []byte
+		err       error
+	)
+
+	switch key := a.signer.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, message)
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256(message)
+		signature, err = ecdsa.SignASN1(rand.Reader, key, digest[:])
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256(message)
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return signature
+}
+
+// Координата точки фиксированной длины с ведущими нулями
+func pad(value *big.Int, size int) []byte {
+	return value.FillBytes(make([]byte, size))
+}
@@ -0,0 +1,76 @@
+package webauthntest
+
+import (
+	"encoding/binary"
+)
+
+const (
+	cborUnsigned = 0
+	cborNegative = 1
+	cborBytes    = 2
+	cborText     = 3
+	cborMap      = 5
+)
+
+// Пара ключ-значение словаря CBOR, порядок пар сохраняется
+type pair struct {
+	key   any
+	value any
+}
+
+// Готовое значение CBOR, вставляемое без изменений
+type rawCBOR []byte
+
+// Минимальное кодирование CBOR: целые, байтовые и текстовые строки, словари
+func encodeMap(pairs ...pair) []byte {
+	out := appendHead(nil, cborMap, uint64(len(pairs)))
+
+	for _, p := range pairs {
+		out = appendValue(out, p.key)
+		out = appendValue(out, p.value)
+	}
+
+	return out
+}
+
+func appendValue(out []byte, value any) []byte {
+	switch v := value.(type) {
+	case int:
+		return appendInt(out, int64(v))
+	case int64:
+		return appendInt(out, v)
+	case []byte:
+		return append(appendHead(out, cborBytes, uint64(len(v))), v...)
+	case string:
+		return append(appendHead(out, cborText, uint64(len(v))), v...)
+	case rawCBOR:
+		return append(out, v...)
+	}
+
+	panic("unsupported cbor value")
+}
+
+func appendInt(out []byte, value int64) []byte {
+	if value < 0 {
+		return appendHead(out, cborNegative, uint64(-1-value))
+	}
+
+	return appendHead(out, cborUnsigned, uint64(value))
+}
+
+func appendHead(out []byte, major byte, n uint64) []byte {
+	major <<= 5
+
+	switch {
+	case n < 24: //nolint:mnd
+		return append(out, major|byte(n))
+	case n <= 0xff:
+		return append(out, major|24, byte(n)) //nolint:mnd
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(out, major|25), uint16(n)) //nolint:mnd
+	case n <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(out, major|26), uint32(n)) //nolint:mnd
+	}
+
+	return binary.BigEndian.AppendUint64(append(out, major|27), n) //nolint:mnd
+}
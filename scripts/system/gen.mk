@@ -1,3 +1,4 @@
 codegen-update:
 	@codegen-cli upload-http-server --service auth-id --source docs/openapi/auth-id.yaml
 	@codegen-cli gen-http-server --service auth-id
+	@codegen-cli gen-http-client --service auth-id